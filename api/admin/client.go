@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/corpetty/avalanchego/api"
+	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/utils/rpc"
 )
 
@@ -85,3 +86,22 @@ func (c *Client) Stacktrace() (bool, error) {
 	err := c.requester.SendRequest("stacktrace", struct{}{}, res)
 	return res.Success, err
 }
+
+// BackupDatabase writes a consistent copy of the node's database to [directory]
+// and returns the number of keys written and the backend they were written with
+func (c *Client) BackupDatabase(directory string) (uint64, string, error) {
+	res := &BackupDatabaseReply{}
+	err := c.requester.SendRequest("backupDatabase", &BackupDatabaseArgs{
+		Directory: directory,
+	}, res)
+	return uint64(res.NumKeys), res.DBType, err
+}
+
+// LoadVMs registers the VMs in the node's plugin directory that aren't
+// registered yet. Returns the IDs of the new VMs and, for each plugin that
+// couldn't be registered, the reason why.
+func (c *Client) LoadVMs() ([]ids.ID, map[string]string, error) {
+	res := &LoadVMsReply{}
+	err := c.requester.SendRequest("loadVMs", struct{}{}, res)
+	return res.NewVMs, res.FailedVMs, err
+}
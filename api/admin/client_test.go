@@ -61,6 +61,9 @@ func (mc *mockClient) SendRequest(method string, params interface{}, reply inter
 	case *GetChainAliasesReply:
 		response := mc.response.(*GetChainAliasesReply)
 		*p = *response
+	case *BackupDatabaseReply:
+		response := mc.response.(*BackupDatabaseReply)
+		*p = *response
 	default:
 		panic("illegal type")
 	}
@@ -221,3 +224,17 @@ func TestStacktrace(t *testing.T) {
 		}
 	}
 }
+
+func TestBackupDatabase(t *testing.T) {
+	expectedReply := &BackupDatabaseReply{NumKeys: 5, DBType: "leveldb"}
+	mockClient := Client{requester: NewMockClient(expectedReply, nil)}
+
+	numKeys, dbType, err := mockClient.BackupDatabase("backup")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), numKeys)
+	assert.Equal(t, "leveldb", dbType)
+
+	mockClient = Client{requester: NewMockClient(expectedReply, errors.New("some error"))}
+	_, _, err = mockClient.BackupDatabase("backup")
+	assert.Error(t, err)
+}
@@ -5,16 +5,21 @@ package admin
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 
 	"github.com/gorilla/rpc/v2"
 
 	"github.com/corpetty/avalanchego/api"
 	"github.com/corpetty/avalanchego/chains"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/backend"
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow/engine/common"
 	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/vms/registry"
 
 	cjson "github.com/corpetty/avalanchego/utils/json"
 )
@@ -24,10 +29,16 @@ const (
 
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
+
+	// Max number of bytes written to a batch before it is flushed during a
+	// backup
+	backupBatchSize = 4 * 1024 * 1024
 )
 
 var (
-	errAliasTooLong = errors.New("alias length is too long")
+	errAliasTooLong      = errors.New("alias length is too long")
+	errNoBackupDirectory = errors.New("backup directory must be provided")
+	errBackupDirExists   = errors.New("backup directory already exists")
 )
 
 // Admin is the API service for node admin management
@@ -36,10 +47,14 @@ type Admin struct {
 	performance  Performance
 	chainManager chains.Manager
 	httpServer   *api.Server
+	db           database.Database
+	dbType       string
+	vmRegistry   registry.VMRegistry
 }
 
 // NewService returns a new admin API service
-func NewService(log logging.Logger, chainManager chains.Manager, httpServer *api.Server) (*common.HTTPHandler, error) {
+// [dbType] is the backend of [db], which backups are written with.
+func NewService(log logging.Logger, chainManager chains.Manager, httpServer *api.Server, db database.Database, dbType string, vmRegistry registry.VMRegistry) (*common.HTTPHandler, error) {
 	newServer := rpc.NewServer()
 	codec := cjson.NewCodec()
 	newServer.RegisterCodec(codec, "application/json")
@@ -48,6 +63,9 @@ func NewService(log logging.Logger, chainManager chains.Manager, httpServer *api
 		log:          log,
 		chainManager: chainManager,
 		httpServer:   httpServer,
+		db:           db,
+		dbType:       dbType,
+		vmRegistry:   vmRegistry,
 	}, "admin"); err != nil {
 		return nil, err
 	}
@@ -160,3 +178,121 @@ func (service *Admin) Stacktrace(_ *http.Request, _ *struct{}, reply *api.Succes
 	stacktrace := []byte(logging.Stacktrace{Global: true}.String())
 	return ioutil.WriteFile(stacktraceFile, stacktrace, 0600)
 }
+
+// BackupDatabaseArgs are the arguments for calling BackupDatabase
+type BackupDatabaseArgs struct {
+	// Directory to write the backup to. Must not already exist.
+	Directory string `json:"directory"`
+}
+
+// BackupDatabaseReply is the result of calling BackupDatabase
+type BackupDatabaseReply struct {
+	// Number of key/value pairs written to the backup
+	NumKeys cjson.Uint64 `json:"numKeys"`
+	// Database backend the backup was written with. A node started with this
+	// db-type can use the backup as its database.
+	DBType string `json:"dbType"`
+}
+
+// BackupDatabase writes a consistent copy of the node's database to a new
+// database in the given directory, using the same backend as the node's
+// database. If the node's database is in memory, the backup is written with
+// leveldb. The node keeps running while the backup is written. Writes made
+// after the backup started aren't included.
+func (service *Admin) BackupDatabase(_ *http.Request, args *BackupDatabaseArgs, reply *BackupDatabaseReply) error {
+	service.log.Info("Admin: BackupDatabase called with Directory: %s", args.Directory)
+
+	if args.Directory == "" {
+		return errNoBackupDirectory
+	}
+	if _, err := os.Stat(args.Directory); err == nil {
+		return errBackupDirExists
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	snapshotter, ok := service.db.(database.Snapshotter)
+	if !ok {
+		return fmt.Errorf("couldn't snapshot the database: %w", database.ErrNotSupported)
+	}
+	snapshot, err := snapshotter.NewSnapshot()
+	if err != nil {
+		return fmt.Errorf("couldn't snapshot the database: %w", err)
+	}
+	defer snapshot.Release()
+
+	// An in-memory backup would be lost as soon as it was closed
+	dbType := service.dbType
+	if dbType == backend.MemDB {
+		dbType = backend.LevelDB
+	}
+	backupDB, err := backend.New(dbType, args.Directory)
+	if err != nil {
+		return fmt.Errorf("couldn't create backup database: %w", err)
+	}
+	numKeys, err := backup(snapshot, backupDB)
+	if closeErr := backupDB.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("backup failed after %d keys: %w", numKeys, err)
+	}
+
+	service.log.Info("Admin: BackupDatabase wrote %d keys to %s database %s", numKeys, dbType, args.Directory)
+	reply.NumKeys = cjson.Uint64(numKeys)
+	reply.DBType = dbType
+	return nil
+}
+
+// backup writes every key/value pair in [snapshot] to [dst] and returns the
+// number of pairs written
+func backup(snapshot database.Snapshot, dst database.Database) (uint64, error) {
+	iter := snapshot.NewIterator()
+	defer iter.Release()
+
+	numKeys := uint64(0)
+	batch := dst.NewBatch()
+	for iter.Next() {
+		if err := batch.Put(iter.Key(), iter.Value()); err != nil {
+			return numKeys, err
+		}
+		numKeys++
+
+		if batch.ValueSize() < backupBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return numKeys, err
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return numKeys, err
+	}
+	return numKeys, batch.Write()
+}
+
+// LoadVMsReply contains the response metadata for LoadVMs
+type LoadVMsReply struct {
+	// IDs of the VMs that were newly registered
+	NewVMs []ids.ID `json:"newVMs"`
+	// Plugin file name --> Reason the plugin couldn't be registered
+	FailedVMs map[string]string `json:"failedVMs"`
+}
+
+// LoadVMs registers the VMs in the plugin directory that aren't registered yet
+func (service *Admin) LoadVMs(_ *http.Request, _ *struct{}, reply *LoadVMsReply) error {
+	service.log.Info("Admin: LoadVMs called")
+
+	newVMs, failedVMs, err := service.vmRegistry.Reload()
+	if err != nil {
+		return err
+	}
+
+	reply.NewVMs = newVMs
+	reply.FailedVMs = make(map[string]string, len(failedVMs))
+	for name, err := range failedVMs {
+		reply.FailedVMs[name] = err.Error()
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/backend"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+func TestServiceBackupDatabase(t *testing.T) {
+	// dbType --> backend the backup should be written with
+	tests := map[string]string{
+		backend.LevelDB:  backend.LevelDB,
+		backend.PebbleDB: backend.PebbleDB,
+		backend.MemDB:    backend.LevelDB,
+	}
+	for dbType, expectedBackupType := range tests {
+		t.Run(dbType, func(t *testing.T) {
+			db, err := backend.New(dbType, filepath.Join(t.TempDir(), "db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			if err := db.Put([]byte("hello"), []byte("world")); err != nil {
+				t.Fatal(err)
+			}
+			service := &Admin{
+				log:    logging.NoLog{},
+				db:     db,
+				dbType: dbType,
+			}
+
+			dir := filepath.Join(t.TempDir(), "backup")
+			reply := BackupDatabaseReply{}
+			if err := service.BackupDatabase(nil, &BackupDatabaseArgs{Directory: dir}, &reply); err != nil {
+				t.Fatal(err)
+			}
+			if reply.NumKeys != 1 {
+				t.Fatalf("Expected 1 key to be backed up but got %d", reply.NumKeys)
+			}
+			if reply.DBType != expectedBackupType {
+				t.Fatalf("Expected the backup to be a %s database but it is a %s database", expectedBackupType, reply.DBType)
+			}
+
+			// Backing up over an existing directory should fail
+			if err := service.BackupDatabase(nil, &BackupDatabaseArgs{Directory: dir}, &reply); err != errBackupDirExists {
+				t.Fatalf("Expected %s but got %v", errBackupDirExists, err)
+			}
+
+			backupDB, err := backend.New(reply.DBType, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer backupDB.Close()
+			if value, err := backupDB.Get([]byte("hello")); err != nil {
+				t.Fatal(err)
+			} else if !bytes.Equal(value, []byte("world")) {
+				t.Fatalf("Expected backed up value 0x%x but got 0x%x", []byte("world"), value)
+			}
+		})
+	}
+}
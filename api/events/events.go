@@ -0,0 +1,111 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/bloom"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+)
+
+// Types of events that are streamed to subscribers
+const (
+	Accepted = "accepted"
+	Rejected = "rejected"
+)
+
+// Event is sent to a subscriber when a container of the chain it's subscribed
+// to is decided
+type Event struct {
+	// Either [Accepted] or [Rejected]
+	Type string `json:"type"`
+	// ID of the chain the container was decided on
+	ChainID ids.ID `json:"chainID"`
+	// ID of the container
+	ContainerID ids.ID `json:"containerID"`
+	// Parsed representation of the container. Only set if the chain's VM
+	// implements [Decoder].
+	Tx interface{} `json:"tx,omitempty"`
+	// UTXOs produced by the container. Only set if the chain's VM implements
+	// [Decoder].
+	UTXOs []*avax.UTXO `json:"utxos,omitempty"`
+}
+
+// Filter is sent by a subscriber to only receive the events that touch at
+// least one of the given addresses or assets. An empty filter matches every
+// event. A subscriber may replace its filter at any time by sending a new one.
+//
+// Rather than listing its addresses, a subscriber may send a bloom filter of
+// them so that the server doesn't learn exactly which addresses it is
+// interested in. The bloom filter only matches accepted containers, and is
+// checked against the bytes of each address the container touches.
+type Filter struct {
+	Addresses []string      `json:"addresses"`
+	AssetIDs  []string      `json:"assetIDs"`
+	Bloom     *bloom.Filter `json:"bloom,omitempty"`
+}
+
+// Decoded is a container along with the addresses and assets it touches
+type Decoded struct {
+	Tx        interface{}
+	UTXOs     []*avax.UTXO
+	Addresses ids.ShortSet
+	AssetIDs  ids.Set
+}
+
+// Decoder is optionally implemented by VMs whose containers can be described
+// in events. If a VM doesn't implement Decoder, its events only carry the ID of
+// the decided container and can't be filtered.
+type Decoder interface {
+	// DecodeEvent parses [container] and returns the UTXOs it produced and the
+	// addresses and assets that it touches. It is called while the chain's
+	// context lock is held.
+	DecodeEvent(container []byte) (*Decoded, error)
+
+	// ParseLocalAddress parses an address of this chain, as given in a
+	// [Filter]
+	ParseLocalAddress(addrStr string) (ids.ShortID, error)
+}
+
+// filter is the parsed form of a [Filter]
+type filter struct {
+	addrs    ids.ShortSet
+	assetIDs ids.Set
+	// nil if the subscriber didn't send a bloom filter
+	bloom *bloom.Filter
+}
+
+// empty returns true if this filter matches every event
+func (f *filter) empty() bool {
+	return f.addrs.Len() == 0 && f.assetIDs.Len() == 0 && f.bloom == nil
+}
+
+// matches returns true if an event of type [eventType] about [decoded] should
+// be sent to a subscriber with this filter. [decoded] is nil if the event
+// couldn't be decoded.
+func (f *filter) matches(eventType string, decoded *Decoded) bool {
+	switch {
+	case f.empty():
+		return true
+	case decoded == nil:
+		return false
+	}
+	for addr := range decoded.Addresses {
+		if f.addrs.Contains(addr) {
+			return true
+		}
+	}
+	if f.assetIDs.Overlaps(decoded.AssetIDs) {
+		return true
+	}
+	if f.bloom == nil || eventType != Accepted {
+		return false
+	}
+	for addr := range decoded.Addresses {
+		if f.bloom.Contains(addr[:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"io"
+	"sync"
+
+	"github.com/corpetty/avalanchego/chains"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/triggers"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+const (
+	eventsIdentifier = "events"
+
+	// Endpoint, under the chain's base URL, that the events are served at
+	eventsEndpoint = "/events"
+)
+
+var (
+	_ chains.Registrant = &Manager{}
+)
+
+// APIServer is the part of the API server that the manager needs to serve the
+// events of each chain
+type APIServer interface {
+	AddChainRoute(handler *common.HTTPHandler, ctx *snow.Context, base, endpoint string, loggingWriter io.Writer) error
+}
+
+// Config ...
+type Config struct {
+	Log                logging.Logger
+	HTTPLog            io.Writer
+	DecisionDispatcher *triggers.EventDispatcher
+	APIServer          APIServer
+}
+
+// Manager streams the decisions of every chain on this node to websocket
+// subscribers. The events of a chain are served at /ext/bc/[chainID]/events.
+//
+// A subscriber may send a [Filter] at any time to only receive the events that
+// touch the given addresses or assets.
+type Manager struct {
+	Config
+
+	lock    sync.Mutex
+	closed  bool
+	servers []*server
+}
+
+// NewManager returns a new Manager. It should be added as a registrant of the
+// chain manager so that it is notified of every chain that is created.
+func NewManager(config Config) *Manager {
+	return &Manager{Config: config}
+}
+
+// RegisterChain implements the chains.Registrant interface
+func (m *Manager) RegisterChain(_ string, ctx *snow.Context, vm interface{}) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	decoder, _ := vm.(Decoder)
+	s := newServer(m.Log, ctx.ChainID, decoder)
+	if err := m.DecisionDispatcher.RegisterChain(ctx.ChainID, eventsIdentifier, s); err != nil {
+		m.Log.Error("couldn't register events of chain %s: %s", ctx.ChainID, err)
+		return
+	}
+	m.servers = append(m.servers, s)
+
+	handler := &common.HTTPHandler{LockOptions: common.NoLock, Handler: s}
+	if err := m.APIServer.AddChainRoute(handler, ctx, "bc/"+ctx.ChainID.String(), eventsEndpoint, m.HTTPLog); err != nil {
+		m.Log.Error("couldn't serve events of chain %s: %s", ctx.ChainID, err)
+	}
+}
+
+// Close disconnects the subscribers of every chain
+func (m *Manager) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.closed = true
+	for _, s := range m.servers {
+		s.close()
+	}
+	m.servers = nil
+}
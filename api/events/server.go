@@ -0,0 +1,299 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+const (
+	// Size of the ws read buffer
+	readBufferSize = 1024
+
+	// Size of the ws write buffer
+	writeBufferSize = 1024
+
+	// Time allowed to write a message to the subscriber.
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the subscriber.
+	pongWait = 60 * time.Second
+
+	// Send pings to the subscriber with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 9) / 10
+
+	// Maximum size of a filter sent by a subscriber. Must be large enough to
+	// hold the base64 encoding of the largest bloom filter.
+	maxFilterSize = 256 * 1024 // bytes
+
+	// Maximum number of pending events to send to a subscriber.
+	maxPendingEvents = 1024 // events
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  readBufferSize,
+	WriteBufferSize: writeBufferSize,
+	CheckOrigin:     func(*http.Request) bool { return true },
+}
+
+// server streams the decisions of a chain to its websocket subscribers
+type server struct {
+	log     logging.Logger
+	chainID ids.ID
+	// nil if the chain's VM doesn't implement Decoder
+	decoder Decoder
+
+	lock   sync.Mutex
+	closed bool
+	// Subscriber --> the events it wants to receive
+	conns map[*connection]*filter
+}
+
+func newServer(log logging.Logger, chainID ids.ID, decoder Decoder) *server {
+	return &server{
+		log:     log,
+		chainID: chainID,
+		decoder: decoder,
+		conns:   make(map[*connection]*filter),
+	}
+}
+
+// Accept implements the triggers.Acceptor interface
+func (s *server) Accept(_ *snow.Context, containerID ids.ID, container []byte) error {
+	s.publish(Accepted, containerID, container)
+	return nil
+}
+
+// Reject implements the triggers.Rejector interface
+func (s *server) Reject(_ *snow.Context, containerID ids.ID, container []byte) error {
+	s.publish(Rejected, containerID, container)
+	return nil
+}
+
+func (s *server) publish(eventType string, containerID ids.ID, container []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.conns) == 0 {
+		return
+	}
+
+	event := &Event{
+		Type:        eventType,
+		ChainID:     s.chainID,
+		ContainerID: containerID,
+	}
+
+	var decoded *Decoded
+	if s.decoder != nil {
+		var err error
+		decoded, err = s.decoder.DecodeEvent(container)
+		if err != nil {
+			s.log.Debug("couldn't decode container %s of chain %s: %s", containerID, s.chainID, err)
+			decoded = nil
+		} else {
+			event.Tx = decoded.Tx
+			event.UTXOs = decoded.UTXOs
+		}
+	}
+
+	for conn, f := range s.conns {
+		if !f.matches(eventType, decoded) {
+			continue
+		}
+		select {
+		case conn.send <- event:
+		default:
+			s.log.Verbo("dropping event to subscriber of chain %s due to too many pending events", s.chainID)
+		}
+	}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Debug("failed to upgrade %s", err)
+		return
+	}
+
+	conn := &connection{
+		s:    s,
+		conn: wsConn,
+		send: make(chan *Event, maxPendingEvents),
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		_ = wsConn.Close()
+		return
+	}
+	s.conns[conn] = &filter{}
+
+	go conn.writePump()
+	go conn.readPump()
+}
+
+// parseFilter returns the parsed form of [f]
+func (s *server) parseFilter(f *Filter) (*filter, error) {
+	parsed := &filter{}
+	if len(f.Addresses) > 0 && s.decoder == nil {
+		return nil, fmt.Errorf("chain %s doesn't support filtering by address", s.chainID)
+	}
+	for _, addrStr := range f.Addresses {
+		addr, err := s.decoder.ParseLocalAddress(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse address %q: %w", addrStr, err)
+		}
+		parsed.addrs.Add(addr)
+	}
+	for _, assetIDStr := range f.AssetIDs {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse assetID %q: %w", assetIDStr, err)
+		}
+		parsed.assetIDs.Add(assetID)
+	}
+	if f.Bloom != nil {
+		if err := f.Bloom.Verify(); err != nil {
+			return nil, fmt.Errorf("invalid bloom filter: %w", err)
+		}
+		parsed.bloom = f.Bloom
+	}
+	return parsed, nil
+}
+
+func (s *server) setFilter(conn *connection, f *filter) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, exists := s.conns[conn]; exists {
+		s.conns[conn] = f
+	}
+}
+
+func (s *server) removeConnection(conn *connection) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, exists := s.conns[conn]; !exists {
+		return
+	}
+	delete(s.conns, conn)
+	// Stops the writePump of [conn]
+	close(conn.send)
+}
+
+// close disconnects every subscriber and stops accepting new ones
+func (s *server) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closed = true
+	for conn := range s.conns {
+		delete(s.conns, conn)
+		close(conn.send)
+	}
+}
+
+// connection is a websocket connection to a subscriber
+type connection struct {
+	s *server
+
+	// The websocket connection.
+	conn *websocket.Conn
+
+	// Buffered channel of outbound events. Closed when the subscriber is
+	// removed from the server.
+	send chan *Event
+}
+
+// readPump reads the filters sent by the subscriber.
+//
+// The server runs readPump in a per-connection goroutine, so there is at most
+// one reader on a connection.
+func (c *connection) readPump() {
+	defer func() {
+		c.s.removeConnection(c)
+		// close is called by both the writePump and the readPump so one of them
+		// will always error
+		_ = c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxFilterSize)
+	// SetReadDeadline returns an error if the connection is corrupted
+	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		f := Filter{}
+		if err := c.conn.ReadJSON(&f); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				c.s.log.Debug("unexpected close in events websocket: %s", err)
+			}
+			return
+		}
+		parsed, err := c.s.parseFilter(&f)
+		if err != nil {
+			c.s.log.Debug("ignoring invalid filter for chain %s: %s", c.s.chainID, err)
+			continue
+		}
+		c.s.setFilter(c, parsed)
+	}
+}
+
+// writePump writes the events the subscriber is interested in.
+//
+// The server runs writePump in a per-connection goroutine, so there is at most
+// one writer on a connection.
+func (c *connection) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		// close is called by both the writePump and the readPump so one of them
+		// will always error
+		_ = c.conn.Close()
+	}()
+	for {
+		select {
+		case event, ok := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("failed to set the write deadline, closing the connection due to %s", err)
+				return
+			}
+			if !ok {
+				// The server removed this subscriber. Attempt to close the
+				// connection gracefully.
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+
+			if err := c.conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("failed to set the write deadline, closing the connection due to %s", err)
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/bloom"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+var errUnknownAddress = errors.New("unknown address")
+
+type testDecoder struct {
+	addrs   map[string]ids.ShortID
+	decoded map[string]*Decoded
+}
+
+func (d *testDecoder) DecodeEvent(container []byte) (*Decoded, error) {
+	return d.decoded[string(container)], nil
+}
+
+func (d *testDecoder) ParseLocalAddress(addrStr string) (ids.ShortID, error) {
+	addr, ok := d.addrs[addrStr]
+	if !ok {
+		return ids.ShortID{}, errUnknownAddress
+	}
+	return addr, nil
+}
+
+func TestFilterMatches(t *testing.T) {
+	addr := ids.GenerateTestShortID()
+	assetID := ids.GenerateTestID()
+
+	decoded := &Decoded{}
+	decoded.Addresses.Add(addr)
+	decoded.AssetIDs.Add(assetID)
+
+	f := &filter{}
+	if !f.matches(Accepted, nil) || !f.matches(Accepted, decoded) {
+		t.Fatal("empty filter should match every event")
+	}
+
+	f.addrs.Add(ids.GenerateTestShortID())
+	if f.matches(Accepted, nil) {
+		t.Fatal("filter shouldn't match an event that couldn't be decoded")
+	}
+	if f.matches(Accepted, decoded) {
+		t.Fatal("filter shouldn't match an event with different addresses")
+	}
+
+	f.assetIDs.Add(assetID)
+	if !f.matches(Accepted, decoded) {
+		t.Fatal("filter should match an event with the same asset")
+	}
+
+	f = &filter{}
+	f.addrs.Add(addr)
+	if !f.matches(Accepted, decoded) {
+		t.Fatal("filter should match an event with the same address")
+	}
+}
+
+func TestFilterMatchesBloom(t *testing.T) {
+	addr := ids.GenerateTestShortID()
+	decoded := &Decoded{}
+	decoded.Addresses.Add(addr)
+
+	b, err := bloom.New(10, .01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &filter{bloom: b}
+	if f.matches(Accepted, decoded) {
+		t.Fatal("filter shouldn't match an address that isn't in the bloom filter")
+	}
+
+	b.Add(addr[:])
+	if !f.matches(Accepted, decoded) {
+		t.Fatal("filter should match an accepted event with an address in the bloom filter")
+	}
+	if f.matches(Rejected, decoded) {
+		t.Fatal("bloom filter shouldn't match rejected events")
+	}
+}
+
+func TestParseFilterInvalidBloom(t *testing.T) {
+	s := newServer(logging.NoLog{}, ids.GenerateTestID(), &testDecoder{})
+	if _, err := s.parseFilter(&Filter{Bloom: &bloom.Filter{}}); err == nil {
+		t.Fatal("should have failed to parse an invalid bloom filter")
+	}
+}
+
+func TestServerStreamsFilteredEvents(t *testing.T) {
+	addr := ids.GenerateTestShortID()
+	tx0 := &Decoded{Tx: "tx0"}
+	tx0.Addresses.Add(ids.GenerateTestShortID())
+	tx1 := &Decoded{Tx: "tx1"}
+	tx1.Addresses.Add(addr)
+
+	chainID := ids.GenerateTestID()
+	decoder := &testDecoder{
+		addrs: map[string]ids.ShortID{"addr": addr},
+		decoded: map[string]*Decoded{
+			"tx0": tx0,
+			"tx1": tx1,
+		},
+	}
+	s := newServer(logging.NoLog{}, chainID, decoder)
+	httpServer := httptest.NewServer(s)
+	defer httpServer.Close()
+	defer s.close()
+
+	wsURL := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(&Filter{Addresses: []string{"addr"}}); err != nil {
+		t.Fatal(err)
+	}
+	// Wait for the filter to be applied
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		s.lock.Lock()
+		applied := false
+		for _, f := range s.conns {
+			applied = !f.empty()
+		}
+		s.lock.Unlock()
+		if applied {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("filter was never applied")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	tx0ID := ids.GenerateTestID()
+	tx1ID := ids.GenerateTestID()
+	if err := s.Accept(nil, tx0ID, []byte("tx0")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reject(nil, tx1ID, []byte("tx1")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	event := Event{}
+	if err := conn.ReadJSON(&event); err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case event.Type != Rejected:
+		t.Fatalf("expected event type %s but got %s", Rejected, event.Type)
+	case event.ChainID != chainID:
+		t.Fatalf("expected chainID %s but got %s", chainID, event.ChainID)
+	case event.ContainerID != tx1ID:
+		t.Fatalf("expected containerID %s but got %s", tx1ID, event.ContainerID)
+	case event.Tx != "tx1":
+		t.Fatalf("expected tx %q but got %v", "tx1", event.Tx)
+	}
+}
@@ -0,0 +1,103 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/formatting"
+	"github.com/corpetty/avalanchego/utils/json"
+	"github.com/corpetty/avalanchego/utils/rpc"
+)
+
+// Client ...
+type Client struct {
+	requester rpc.EndpointRequester
+}
+
+// NewClient returns a Client for interacting with the index endpoint of
+// [chain] for containers of type [containerType].
+// For example, NewClient(uri, "X", "tx", requestTimeout) queries the index of
+// transactions accepted on the X-Chain.
+func NewClient(uri, chain, containerType string, requestTimeout time.Duration) *Client {
+	return &Client{
+		requester: rpc.NewEndpointRequester(uri, fmt.Sprintf("/ext/index/%s/%s", chain, containerType), "index", requestTimeout),
+	}
+}
+
+// GetContainerByIndex returns the container accepted at [index]
+func (c *Client) GetContainerByIndex(index uint64) (Container, error) {
+	res := &FormattedContainer{}
+	err := c.requester.SendRequest("getContainerByIndex", &GetContainerByIndexArgs{
+		Index:    json.Uint64(index),
+		Encoding: formatting.Hex,
+	}, res)
+	if err != nil {
+		return Container{}, err
+	}
+	return parseFormattedContainer(res)
+}
+
+// GetContainerByID returns the accepted container with ID [containerID]
+func (c *Client) GetContainerByID(containerID ids.ID) (Container, error) {
+	res := &FormattedContainer{}
+	err := c.requester.SendRequest("getContainerByID", &GetContainerByIDArgs{
+		ContainerID: containerID,
+		Encoding:    formatting.Hex,
+	}, res)
+	if err != nil {
+		return Container{}, err
+	}
+	return parseFormattedContainer(res)
+}
+
+// GetLastAccepted returns the most recently accepted container
+func (c *Client) GetLastAccepted() (Container, error) {
+	res := &FormattedContainer{}
+	err := c.requester.SendRequest("getLastAccepted", &GetLastAcceptedArgs{
+		Encoding: formatting.Hex,
+	}, res)
+	if err != nil {
+		return Container{}, err
+	}
+	return parseFormattedContainer(res)
+}
+
+// GetContainerRange returns up to [numToFetch] containers, in order, starting
+// with the container accepted at [startIndex]
+func (c *Client) GetContainerRange(startIndex, numToFetch uint64) ([]Container, error) {
+	res := &GetContainerRangeResponse{}
+	err := c.requester.SendRequest("getContainerRange", &GetContainerRangeArgs{
+		StartIndex: json.Uint64(startIndex),
+		NumToFetch: json.Uint64(numToFetch),
+		Encoding:   formatting.Hex,
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]Container, len(res.Containers))
+	for i := range res.Containers {
+		containers[i], err = parseFormattedContainer(&res.Containers[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return containers, nil
+}
+
+func parseFormattedContainer(container *FormattedContainer) (Container, error) {
+	bytes, err := formatting.Decode(container.Encoding, container.Bytes)
+	if err != nil {
+		return Container{}, err
+	}
+	return Container{
+		ID:        container.ID,
+		Bytes:     bytes,
+		Timestamp: container.Timestamp.UnixNano(),
+		Index:     uint64(container.Index),
+	}, nil
+}
@@ -0,0 +1,173 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/rpc/v2"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/formatting"
+	"github.com/corpetty/avalanchego/utils/json"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+// Container is an accepted container along with the order and the time it was
+// accepted in
+type Container struct {
+	// ID of the container
+	ID ids.ID
+	// Byte representation of the container
+	Bytes []byte
+	// Unix time, in nanoseconds, at which this node accepted the container
+	Timestamp int64
+	// Number of containers accepted on this chain before this one
+	Index uint64
+}
+
+// Index is an ordered index of the containers accepted by a chain
+type Index interface {
+	// GetContainerByIndex returns the container accepted at [index]
+	GetContainerByIndex(index uint64) (Container, error)
+
+	// GetContainerRange returns up to [numToFetch] containers, in order,
+	// starting with the container accepted at [startIndex]
+	GetContainerRange(startIndex uint64, numToFetch uint64) ([]Container, error)
+
+	// GetLastAccepted returns the most recently accepted container
+	GetLastAccepted() (Container, error)
+
+	// GetContainerByID returns the accepted container with ID [containerID]
+	GetContainerByID(containerID ids.ID) (Container, error)
+}
+
+// Service provides the API for querying an index of accepted containers
+type Service struct {
+	log   logging.Logger
+	index Index
+}
+
+// NewService returns a new index API service for [index]
+func NewService(log logging.Logger, index Index) (*common.HTTPHandler, error) {
+	newServer := rpc.NewServer()
+	codec := json.NewCodec()
+	newServer.RegisterCodec(codec, "application/json")
+	newServer.RegisterCodec(codec, "application/json;charset=UTF-8")
+	if err := newServer.RegisterService(&Service{log: log, index: index}, "index"); err != nil {
+		return nil, err
+	}
+	return &common.HTTPHandler{LockOptions: common.NoLock, Handler: newServer}, nil
+}
+
+// FormattedContainer is an accepted container formatted for the API
+type FormattedContainer struct {
+	ID        ids.ID              `json:"id"`
+	Bytes     string              `json:"bytes"`
+	Timestamp time.Time           `json:"timestamp"`
+	Encoding  formatting.Encoding `json:"encoding"`
+	Index     json.Uint64         `json:"index"`
+}
+
+func newFormattedContainer(container Container, encoding formatting.Encoding) (FormattedContainer, error) {
+	bytes, err := formatting.Encode(encoding, container.Bytes)
+	if err != nil {
+		return FormattedContainer{}, fmt.Errorf("couldn't encode container %s: %w", container.ID, err)
+	}
+	return FormattedContainer{
+		ID:        container.ID,
+		Bytes:     bytes,
+		Timestamp: time.Unix(0, container.Timestamp).UTC(),
+		Encoding:  encoding,
+		Index:     json.Uint64(container.Index),
+	}, nil
+}
+
+// GetContainerByIndexArgs are the arguments for calls to GetContainerByIndex
+type GetContainerByIndexArgs struct {
+	Index    json.Uint64         `json:"index"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetContainerByIndex returns the container accepted at the provided index
+func (s *Service) GetContainerByIndex(_ *http.Request, args *GetContainerByIndexArgs, reply *FormattedContainer) error {
+	s.log.Info("Index: GetContainerByIndex called with index %d", args.Index)
+
+	container, err := s.index.GetContainerByIndex(uint64(args.Index))
+	if err != nil {
+		return err
+	}
+	*reply, err = newFormattedContainer(container, args.Encoding)
+	return err
+}
+
+// GetContainerByIDArgs are the arguments for calls to GetContainerByID
+type GetContainerByIDArgs struct {
+	ContainerID ids.ID              `json:"containerID"`
+	Encoding    formatting.Encoding `json:"encoding"`
+}
+
+// GetContainerByID returns the accepted container with the provided ID
+func (s *Service) GetContainerByID(_ *http.Request, args *GetContainerByIDArgs, reply *FormattedContainer) error {
+	s.log.Info("Index: GetContainerByID called with ID %s", args.ContainerID)
+
+	container, err := s.index.GetContainerByID(args.ContainerID)
+	if err != nil {
+		return err
+	}
+	*reply, err = newFormattedContainer(container, args.Encoding)
+	return err
+}
+
+// GetLastAcceptedArgs are the arguments for calls to GetLastAccepted
+type GetLastAcceptedArgs struct {
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetLastAccepted returns the most recently accepted container
+func (s *Service) GetLastAccepted(_ *http.Request, args *GetLastAcceptedArgs, reply *FormattedContainer) error {
+	s.log.Info("Index: GetLastAccepted called")
+
+	container, err := s.index.GetLastAccepted()
+	if err != nil {
+		return err
+	}
+	*reply, err = newFormattedContainer(container, args.Encoding)
+	return err
+}
+
+// GetContainerRangeArgs are the arguments for calls to GetContainerRange
+type GetContainerRangeArgs struct {
+	StartIndex json.Uint64         `json:"startIndex"`
+	NumToFetch json.Uint64         `json:"numToFetch"`
+	Encoding   formatting.Encoding `json:"encoding"`
+}
+
+// GetContainerRangeResponse is the response from calls to GetContainerRange
+type GetContainerRangeResponse struct {
+	Containers []FormattedContainer `json:"containers"`
+}
+
+// GetContainerRange returns, in order, the containers accepted starting at
+// the provided index
+func (s *Service) GetContainerRange(_ *http.Request, args *GetContainerRangeArgs, reply *GetContainerRangeResponse) error {
+	s.log.Info("Index: GetContainerRange called with startIndex %d and numToFetch %d", args.StartIndex, args.NumToFetch)
+
+	containers, err := s.index.GetContainerRange(uint64(args.StartIndex), uint64(args.NumToFetch))
+	if err != nil {
+		return err
+	}
+
+	reply.Containers = make([]FormattedContainer, len(containers))
+	for i, container := range containers {
+		reply.Containers[i], err = newFormattedContainer(container, args.Encoding)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
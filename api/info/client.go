@@ -6,7 +6,6 @@ package info
 import (
 	"time"
 
-	"github.com/corpetty/avalanchego/network"
 	"github.com/corpetty/avalanchego/utils/rpc"
 )
 
@@ -53,7 +52,7 @@ func (c *Client) GetBlockchainID(alias string) (string, error) {
 }
 
 // Peers ...
-func (c *Client) Peers() ([]network.PeerID, error) {
+func (c *Client) Peers() ([]Peer, error) {
 	res := &PeersReply{}
 	err := c.requester.SendRequest("peers", struct{}{}, res)
 	return res.Peers, err
@@ -13,6 +13,7 @@ import (
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/network"
 	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/networking/benchlist"
 	"github.com/corpetty/avalanchego/utils/constants"
 	"github.com/corpetty/avalanchego/utils/json"
 	"github.com/corpetty/avalanchego/utils/logging"
@@ -27,6 +28,7 @@ type Info struct {
 	log           logging.Logger
 	networking    network.Network
 	chainManager  chains.Manager
+	benchlist     benchlist.Manager
 	creationTxFee uint64
 	txFee         uint64
 }
@@ -39,6 +41,7 @@ func NewService(
 	networkID uint32,
 	chainManager chains.Manager,
 	peers network.Network,
+	benchlist benchlist.Manager,
 	creationTxFee uint64,
 	txFee uint64,
 ) (*common.HTTPHandler, error) {
@@ -53,6 +56,7 @@ func NewService(
 		log:           log,
 		chainManager:  chainManager,
 		networking:    peers,
+		benchlist:     benchlist,
 		creationTxFee: creationTxFee,
 		txFee:         txFee,
 	}, "info"); err != nil {
@@ -137,12 +141,20 @@ type PeersArgs struct {
 	NodeIDs []string `json:"nodeIDs"`
 }
 
+// Peer is the description of a peer
+type Peer struct {
+	network.PeerID
+
+	// IDs of the chains that the peer is currently benched on
+	Benched []ids.ID `json:"benched"`
+}
+
 // PeersReply are the results from calling Peers
 type PeersReply struct {
 	// Number of elements in [Peers]
 	NumPeers json.Uint64 `json:"numPeers"`
 	// Each element is a peer
-	Peers []network.PeerID `json:"peers"`
+	Peers []Peer `json:"peers"`
 }
 
 // Peers returns the list of current validators
@@ -158,7 +170,18 @@ func (service *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply)
 		nodeIDs = append(nodeIDs, nID)
 	}
 
-	reply.Peers = service.networking.Peers(nodeIDs)
+	peers := service.networking.Peers(nodeIDs)
+	reply.Peers = make([]Peer, len(peers))
+	for i, peer := range peers {
+		nodeID, err := ids.ShortFromPrefixedString(peer.ID, constants.NodeIDPrefix)
+		if err != nil {
+			return err
+		}
+		reply.Peers[i] = Peer{
+			PeerID:  peer,
+			Benched: service.benchlist.GetBenched(nodeID),
+		}
+	}
 	reply.NumPeers = json.Uint64(len(reply.Peers))
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/corpetty/avalanchego/api"
+	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/utils/formatting"
 	"github.com/corpetty/avalanchego/utils/rpc"
 )
@@ -65,6 +66,31 @@ func (c *Client) ImportUser(user api.UserPass, account []byte) (bool, error) {
 	return res.Success, err
 }
 
+// ExportUserKeys returns the private keys of [user], encrypted with
+// [passphrase] in a format that other nodes and wallets can import
+func (c *Client) ExportUserKeys(user api.UserPass, passphrase string) (*PortableUser, error) {
+	res := &ExportUserKeysReply{}
+	err := c.requester.SendRequest("exportUserKeys", &ExportUserKeysArgs{
+		UserPass:   user,
+		Passphrase: passphrase,
+	}, res)
+	return res.User, err
+}
+
+// ImportUserKeys imports the private keys in [export], which was encrypted
+// with [passphrase], to [user] and returns the number of keys imported. If
+// [chainIDs] are given, only the keys on those chains are imported.
+func (c *Client) ImportUserKeys(user api.UserPass, passphrase string, export *PortableUser, chainIDs ...ids.ID) (uint32, error) {
+	res := &ImportUserKeysReply{}
+	err := c.requester.SendRequest("importUserKeys", &ImportUserKeysArgs{
+		UserPass:   user,
+		Passphrase: passphrase,
+		User:       *export,
+		ChainIDs:   chainIDs,
+	}, res)
+	return uint32(res.NumImported), err
+}
+
 // DeleteUser removes [user] from the node's keystore users
 func (c *Client) DeleteUser(user api.UserPass) (bool, error) {
 	res := &api.SuccessResponse{}
@@ -0,0 +1,308 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/utils/formatting"
+)
+
+const (
+	// portableUserVersion is the version of the portable user format written
+	// by this node
+	portableUserVersion = 1
+
+	portableUserKDF    = "argon2id"
+	portableUserCipher = "aes-256-gcm"
+
+	// Argon2id parameters used when exporting a user. The parameters used are
+	// stored in the export so they can be changed without breaking imports.
+	portableUserSaltLen = 16
+	portableUserTime    = 1
+	portableUserMemory  = 64 * 1024 // KiB
+	portableUserThreads = 4
+	portableUserKeyLen  = 32 // AES-256
+
+	// Bounds on the argon2id parameters accepted when importing a user, so a
+	// crafted export can't make the node do an unbounded amount of work
+	maxPortableUserTime   = 16
+	maxPortableUserMemory = 256 * 1024 // KiB
+
+	// Max number of addresses a user can hold on a chain, matching the limit
+	// the chains' importKey and createAddress APIs enforce
+	maxKeystoreAddresses = 5000
+)
+
+var (
+	errUnknownPortableUserVersion = fmt.Errorf("portable user version must be %d", portableUserVersion)
+	errUnknownPortableUserKDF     = fmt.Errorf("portable user kdf must be %s", portableUserKDF)
+	errUnknownPortableUserCipher  = fmt.Errorf("portable user cipher must be %s", portableUserCipher)
+	errInvalidPortableUserParams  = errors.New("invalid portable user kdf parameters")
+	errWrongPassphrase            = errors.New("couldn't decrypt the user. The passphrase may be incorrect")
+)
+
+// PortableUser is a keystore user's private keys, encrypted with a passphrase
+// chosen by the user, in a format that isn't tied to how this node stores the
+// user. It can be used to move a user's keys to another node or to a wallet.
+//
+// A PortableUser is serialized as JSON. Byte fields are base64 encoded:
+//
+//	{
+//		"version": 1,
+//		"kdf": "argon2id",
+//		"kdfParams": {"salt": "...", "time": 1, "memory": 65536, "threads": 4},
+//		"cipher": "aes-256-gcm",
+//		"nonce": "...",
+//		"ciphertext": "..."
+//	}
+//
+// The 32 byte AES key is derived from the passphrase with argon2id using
+// [kdfParams], where [memory] is in KiB. The plaintext is the JSON encoding of
+// the user's keys, grouped by chain, with each key formatted the same way as
+// the chains' exportKey APIs format it:
+//
+//	{
+//		"chains": [
+//			{"chainID": "...", "privateKeys": ["PrivateKey-...", ...]},
+//			...
+//		]
+//	}
+type PortableUser struct {
+	Version    uint32                `json:"version"`
+	KDF        string                `json:"kdf"`
+	KDFParams  PortableUserKDFParams `json:"kdfParams"`
+	Cipher     string                `json:"cipher"`
+	Nonce      []byte                `json:"nonce"`
+	Ciphertext []byte                `json:"ciphertext"`
+}
+
+// PortableUserKDFParams are the argon2id parameters used to derive the key
+// that a PortableUser is encrypted with
+type PortableUserKDFParams struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// portableKeys is the plaintext of a PortableUser
+type portableKeys struct {
+	Chains []portableChainKeys `json:"chains"`
+}
+
+// portableChainKeys are the private keys a user holds on a chain
+type portableChainKeys struct {
+	ChainID     ids.ID   `json:"chainID"`
+	PrivateKeys []string `json:"privateKeys"`
+}
+
+// encryptPortableUser encrypts [keys] with [passphrase]
+func encryptPortableUser(keys *portableKeys, passphrase string) (*PortableUser, error) {
+	plaintext, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &PortableUser{
+		Version: portableUserVersion,
+		KDF:     portableUserKDF,
+		KDFParams: PortableUserKDFParams{
+			Salt:    make([]byte, portableUserSaltLen),
+			Time:    portableUserTime,
+			Memory:  portableUserMemory,
+			Threads: portableUserThreads,
+		},
+		Cipher: portableUserCipher,
+	}
+	if _, err := rand.Read(user.KDFParams.Salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := user.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	user.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(user.Nonce); err != nil {
+		return nil, err
+	}
+	user.Ciphertext = aead.Seal(nil, user.Nonce, plaintext, nil)
+	return user, nil
+}
+
+// decrypt returns the keys in [user], which must have been encrypted with
+// [passphrase]
+func (user *PortableUser) decrypt(passphrase string) (*portableKeys, error) {
+	switch {
+	case user.Version != portableUserVersion:
+		return nil, errUnknownPortableUserVersion
+	case user.KDF != portableUserKDF:
+		return nil, errUnknownPortableUserKDF
+	case user.Cipher != portableUserCipher:
+		return nil, errUnknownPortableUserCipher
+	case user.KDFParams.Time == 0 || user.KDFParams.Time > maxPortableUserTime,
+		user.KDFParams.Memory == 0 || user.KDFParams.Memory > maxPortableUserMemory,
+		user.KDFParams.Threads == 0:
+		return nil, errInvalidPortableUserParams
+	}
+
+	aead, err := user.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(user.Nonce) != aead.NonceSize() {
+		return nil, errInvalidPortableUserParams
+	}
+	plaintext, err := aead.Open(nil, user.Nonce, user.Ciphertext, nil)
+	if err != nil {
+		return nil, errWrongPassphrase
+	}
+
+	keys := &portableKeys{}
+	return keys, json.Unmarshal(plaintext, keys)
+}
+
+// aead returns the cipher [user] is encrypted with when the key is derived
+// from [passphrase]
+func (user *PortableUser) aead(passphrase string) (cipher.AEAD, error) {
+	params := user.KDFParams
+	key := argon2.IDKey([]byte(passphrase), params.Salt, params.Time, params.Memory, params.Threads, portableUserKeyLen)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chainPrivateKeys returns the private keys in [db], which is a user's
+// database on a chain. Chains store each of a user's private keys under the
+// address of the key, which is how the keys are told apart from other data.
+func chainPrivateKeys(db database.Database) ([]*crypto.PrivateKeySECP256K1R, error) {
+	factory := crypto.FactorySECP256K1R{}
+
+	it := db.NewIterator()
+	defer it.Release()
+
+	keys := []*crypto.PrivateKeySECP256K1R(nil)
+	for it.Next() {
+		if len(it.Key()) != len(ids.ShortEmpty) {
+			continue
+		}
+		sk, err := factory.ToPrivateKey(it.Value())
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(it.Key(), sk.PublicKey().Address().Bytes()) {
+			continue
+		}
+		keys = append(keys, sk.(*crypto.PrivateKeySECP256K1R))
+	}
+	return keys, it.Error()
+}
+
+// formatPrivateKey formats [sk] the same way the chains' exportKey APIs do
+func formatPrivateKey(sk *crypto.PrivateKeySECP256K1R) (string, error) {
+	skStr, err := formatting.Encode(formatting.CB58, sk.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return constants.SecretKeyPrefix + skStr, nil
+}
+
+// parsePrivateKey parses a private key formatted by formatPrivateKey
+func parsePrivateKey(skStr string) (*crypto.PrivateKeySECP256K1R, error) {
+	if !strings.HasPrefix(skStr, constants.SecretKeyPrefix) {
+		return nil, fmt.Errorf("private key missing %s prefix", constants.SecretKeyPrefix)
+	}
+	skBytes, err := formatting.Decode(formatting.CB58, strings.TrimPrefix(skStr, constants.SecretKeyPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing private key: %w", err)
+	}
+	factory := crypto.FactorySECP256K1R{}
+	sk, err := factory.ToPrivateKey(skBytes)
+	if err != nil {
+		return nil, err
+	}
+	return sk.(*crypto.PrivateKeySECP256K1R), nil
+}
+
+// addressesKey is the key chains store the list of a user's addresses under
+var addressesKey = ids.Empty[:]
+
+// putChainPrivateKeys adds [keys] to [db], which is a user's database on a
+// chain, the same way the chains' importKey APIs do. Returns the number of keys
+// that the user didn't already hold.
+func (ks *Keystore) putChainPrivateKeys(db database.Database, keys []*crypto.PrivateKeySECP256K1R) (int, error) {
+	batch, numAdded, err := ks.chainPrivateKeysBatch(db, keys)
+	if err != nil || numAdded == 0 {
+		return 0, err
+	}
+	return numAdded, batch.Write()
+}
+
+// chainPrivateKeysBatch returns a batch that adds [keys] to [db] when written,
+// along with the number of keys that the user didn't already hold. Nothing is
+// written to [db]. Errors if the user would hold more than
+// [maxKeystoreAddresses] addresses on the chain.
+func (ks *Keystore) chainPrivateKeysBatch(db database.Database, keys []*crypto.PrivateKeySECP256K1R) (database.Batch, int, error) {
+	addresses := []ids.ShortID(nil)
+	addressesBytes, err := db.Get(addressesKey)
+	switch err {
+	case nil:
+		if _, err := ks.codec.Unmarshal(addressesBytes, &addresses); err != nil {
+			return nil, 0, err
+		}
+	case database.ErrNotFound:
+	default:
+		return nil, 0, err
+	}
+
+	batch := db.NewBatch()
+	added := ids.ShortSet{}
+	for _, sk := range keys {
+		address := sk.PublicKey().Address()
+		if added.Contains(address) {
+			continue
+		}
+		if has, err := db.Has(address.Bytes()); err != nil {
+			return nil, 0, err
+		} else if has {
+			continue
+		}
+		if err := batch.Put(address.Bytes(), sk.Bytes()); err != nil {
+			return nil, 0, err
+		}
+		addresses = append(addresses, address)
+		added.Add(address)
+	}
+	if added.Len() == 0 {
+		return batch, 0, nil
+	}
+	if len(addresses) > maxKeystoreAddresses {
+		return nil, 0, fmt.Errorf("keystore user would exceed its limit of %d addresses", maxKeystoreAddresses)
+	}
+
+	addressesBytes, err = ks.codec.Marshal(codecVersion, addresses)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := batch.Put(addressesKey, addressesBytes); err != nil {
+		return nil, 0, err
+	}
+	return batch, added.Len(), nil
+}
@@ -0,0 +1,261 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keystore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/corpetty/avalanchego/api"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/crypto"
+)
+
+func TestServiceExportImportUserKeys(t *testing.T) {
+	ks, err := CreateTestKeystore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.CreateUser(nil, &api.UserPass{
+		Username: "bob",
+		Password: strongPassword,
+	}, &api.SuccessResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	factory := crypto.FactorySECP256K1R{}
+	chainIDs := []ids.ID{{1}, {2}}
+	chainKeys := make(map[ids.ID]*crypto.PrivateKeySECP256K1R)
+	for _, chainID := range chainIDs {
+		bks := ks.NewBlockchainKeyStore(chainID)
+		db, err := bks.GetDatabase("bob", strongPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		skIntf, err := factory.NewPrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk := skIntf.(*crypto.PrivateKeySECP256K1R)
+		if _, err := ks.putChainPrivateKeys(db, []*crypto.PrivateKeySECP256K1R{sk}); err != nil {
+			t.Fatal(err)
+		}
+		// Data that isn't a private key shouldn't be exported
+		if err := db.Put([]byte("hello"), []byte("world")); err != nil {
+			t.Fatal(err)
+		}
+		chainKeys[chainID] = sk
+	}
+
+	passphrase := strongPassword + "passphrase"
+	exportReply := ExportUserKeysReply{}
+	if err := ks.ExportUserKeys(nil, &ExportUserKeysArgs{
+		UserPass:   api.UserPass{Username: "bob", Password: strongPassword},
+		Passphrase: "",
+	}, &exportReply); err == nil {
+		t.Fatal("Should have errored due to weak passphrase")
+	}
+	if err := ks.ExportUserKeys(nil, &ExportUserKeysArgs{
+		UserPass:   api.UserPass{Username: "bob", Password: strongPassword},
+		Passphrase: passphrase,
+	}, &exportReply); err != nil {
+		t.Fatal(err)
+	}
+
+	newKS, err := CreateTestKeystore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	importArgs := ImportUserKeysArgs{
+		UserPass:   api.UserPass{Username: "alice", Password: strongPassword},
+		Passphrase: strongPassword,
+		User:       *exportReply.User,
+	}
+	importReply := ImportUserKeysReply{}
+	if err := newKS.ImportUserKeys(nil, &importArgs, &importReply); err != errWrongPassphrase {
+		t.Fatalf("Should have errored with %s but got %v", errWrongPassphrase, err)
+	}
+
+	importArgs.Passphrase = passphrase
+	if err := newKS.ImportUserKeys(nil, &importArgs, &importReply); err != nil {
+		t.Fatal(err)
+	}
+	if importReply.NumImported != 2 {
+		t.Fatalf("Should have imported 2 keys but imported %d", importReply.NumImported)
+	}
+
+	for chainID, sk := range chainKeys {
+		db, err := newKS.GetDatabase(chainID, "alice", strongPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sks, err := chainPrivateKeys(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sks) != 1 || !bytes.Equal(sks[0].Bytes(), sk.Bytes()) {
+			t.Fatalf("Wrong keys imported on chain %s", chainID)
+		}
+		if has, err := db.Has([]byte("hello")); err != nil {
+			t.Fatal(err)
+		} else if has {
+			t.Fatalf("Data that isn't a key shouldn't have been imported")
+		}
+
+		addressesBytes, err := db.Get(addressesKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addresses := []ids.ShortID(nil)
+		if _, err := newKS.codec.Unmarshal(addressesBytes, &addresses); err != nil {
+			t.Fatal(err)
+		}
+		if len(addresses) != 1 || addresses[0] != sk.PublicKey().Address() {
+			t.Fatalf("Wrong addresses stored on chain %s", chainID)
+		}
+	}
+
+	// Importing keys the user already holds is a no-op
+	if err := newKS.ImportUserKeys(nil, &importArgs, &importReply); err != nil {
+		t.Fatal(err)
+	}
+	if importReply.NumImported != 0 {
+		t.Fatalf("Shouldn't have imported any keys but imported %d", importReply.NumImported)
+	}
+
+	// The user's password must be correct to import to an existing user
+	importArgs.Password = strongPassword + "wrong"
+	if err := newKS.ImportUserKeys(nil, &importArgs, &importReply); err == nil {
+		t.Fatal("Should have errored due to incorrect password")
+	}
+}
+
+func TestServiceImportUserKeysChainIDs(t *testing.T) {
+	ks, err := CreateTestKeystore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factory := crypto.FactorySECP256K1R{}
+	chainIDs := []ids.ID{{1}, {2}}
+	keys := portableKeys{}
+	for _, chainID := range chainIDs {
+		sk, err := factory.NewPrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		skStr, err := formatPrivateKey(sk.(*crypto.PrivateKeySECP256K1R))
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys.Chains = append(keys.Chains, portableChainKeys{
+			ChainID:     chainID,
+			PrivateKeys: []string{skStr},
+		})
+	}
+	passphrase := strongPassword + "passphrase"
+	export, err := encryptPortableUser(&keys, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	importArgs := ImportUserKeysArgs{
+		UserPass:   api.UserPass{Username: "bob", Password: strongPassword},
+		Passphrase: passphrase,
+		User:       *export,
+		ChainIDs:   []ids.ID{{3}},
+	}
+	importReply := ImportUserKeysReply{}
+	if err := ks.ImportUserKeys(nil, &importArgs, &importReply); err == nil {
+		t.Fatal("Should have errored due to a chain that isn't in the export")
+	}
+	importArgs.ChainIDs = []ids.ID{chainIDs[1], chainIDs[1]}
+	if err := ks.ImportUserKeys(nil, &importArgs, &importReply); err == nil {
+		t.Fatal("Should have errored due to a duplicated chain")
+	}
+	if _, err := ks.getUser("bob"); err == nil {
+		t.Fatal("User shouldn't have been created by a failed import")
+	}
+
+	importArgs.ChainIDs = []ids.ID{chainIDs[1]}
+	if err := ks.ImportUserKeys(nil, &importArgs, &importReply); err != nil {
+		t.Fatal(err)
+	}
+	if importReply.NumImported != 1 {
+		t.Fatalf("Should have imported 1 key but imported %d", importReply.NumImported)
+	}
+	for i, chainID := range chainIDs {
+		db, err := ks.GetDatabase(chainID, "bob", strongPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sks, err := chainPrivateKeys(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Only the key on the second chain should have been imported
+		if len(sks) != i {
+			t.Fatalf("Should have %d keys on chain %s but have %d", i, chainID, len(sks))
+		}
+	}
+}
+
+func TestServiceImportUserKeysInvalid(t *testing.T) {
+	ks, err := CreateTestKeystore()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factory := crypto.FactorySECP256K1R{}
+	tooManyKeys := make([]string, maxKeystoreAddresses+1)
+	for i := range tooManyKeys {
+		sk, err := factory.NewPrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tooManyKeys[i], err = formatPrivateKey(sk.(*crypto.PrivateKeySECP256K1R))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string][]portableChainKeys{
+		"invalid key": {
+			{ChainID: ids.ID{1}, PrivateKeys: tooManyKeys[:1]},
+			{ChainID: ids.ID{2}, PrivateKeys: []string{"PrivateKey-invalid"}},
+		},
+		"too many addresses": {
+			{ChainID: ids.ID{1}, PrivateKeys: tooManyKeys[:1]},
+			{ChainID: ids.ID{2}, PrivateKeys: tooManyKeys},
+		},
+	}
+	passphrase := strongPassword + "passphrase"
+	for name, chains := range tests {
+		t.Run(name, func(t *testing.T) {
+			export, err := encryptPortableUser(&portableKeys{Chains: chains}, passphrase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ks.ImportUserKeys(nil, &ImportUserKeysArgs{
+				UserPass:   api.UserPass{Username: "bob", Password: strongPassword},
+				Passphrase: passphrase,
+				User:       *export,
+			}, &ImportUserKeysReply{}); err == nil {
+				t.Fatal("Should have errored")
+			}
+			if _, err := ks.getUser("bob"); err == nil {
+				t.Fatal("User shouldn't have been created by a failed import")
+			}
+			db, err := ks.userChainDB(ids.ID{1}, "bob", strongPassword)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sks, err := chainPrivateKeys(db); err != nil {
+				t.Fatal(err)
+			} else if len(sks) != 0 {
+				t.Fatal("No keys should have been imported by a failed import")
+			}
+		})
+	}
+}
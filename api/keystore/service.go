@@ -22,6 +22,7 @@ import (
 	"github.com/corpetty/avalanchego/database/prefixdb"
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/crypto"
 	"github.com/corpetty/avalanchego/utils/formatting"
 	"github.com/corpetty/avalanchego/utils/logging"
 	"github.com/corpetty/avalanchego/utils/password"
@@ -66,6 +67,9 @@ type Keystore struct {
 	// Value: The user with that name
 	users map[string]*password.Hash
 
+	// Chains that have been given access to the keystore
+	blockchainIDs ids.Set
+
 	// Used to persist users and their data
 	userDB database.Database
 	bcDB   database.Database
@@ -89,6 +93,7 @@ func (ks *Keystore) Initialize(log logging.Logger, db database.Database) error {
 	ks.log = log
 	ks.codec = manager
 	ks.users = make(map[string]*password.Hash)
+	ks.blockchainIDs = ids.Set{}
 	ks.userDB = prefixdb.New([]byte("users"), db)
 	ks.bcDB = prefixdb.New([]byte("bcs"), db)
 	return nil
@@ -291,6 +296,179 @@ func (ks *Keystore) ImportUser(r *http.Request, args *ImportUserArgs, reply *api
 	return nil
 }
 
+// ExportUserKeysArgs are arguments for ExportUserKeys
+type ExportUserKeysArgs struct {
+	// The username and password of the user being exported
+	api.UserPass
+	// The passphrase to encrypt the export with
+	Passphrase string `json:"passphrase"`
+}
+
+// ExportUserKeysReply is the reply from ExportUserKeys
+type ExportUserKeysReply struct {
+	// The user's keys, encrypted with the passphrase
+	User *PortableUser `json:"user"`
+}
+
+// ExportUserKeys exports the private keys a user holds on each chain, encrypted
+// with a passphrase, in a format that can be imported by other nodes and
+// wallets. Unlike ExportUser, the export doesn't depend on how this node
+// stores the user.
+func (ks *Keystore) ExportUserKeys(_ *http.Request, args *ExportUserKeysArgs, reply *ExportUserKeysReply) error {
+	ks.log.Info("Keystore: ExportUserKeys called for %s", args.Username)
+
+	if err := password.IsValid(args.Passphrase, password.OK); err != nil {
+		return fmt.Errorf("invalid passphrase: %w", err)
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	user, err := ks.getUser(args.Username)
+	if err != nil {
+		return err
+	}
+	if !user.Check(args.Password) {
+		return fmt.Errorf("incorrect password for user %q", args.Username)
+	}
+
+	keys := portableKeys{}
+	for _, chainID := range ks.blockchainIDs.List() {
+		db, err := ks.userChainDB(chainID, args.Username, args.Password)
+		if err != nil {
+			return err
+		}
+		sks, err := chainPrivateKeys(db)
+		if err != nil {
+			return fmt.Errorf("couldn't read keys on chain %s: %w", chainID, err)
+		}
+		if len(sks) == 0 {
+			continue
+		}
+		chainKeys := portableChainKeys{
+			ChainID:     chainID,
+			PrivateKeys: make([]string, len(sks)),
+		}
+		for i, sk := range sks {
+			chainKeys.PrivateKeys[i], err = formatPrivateKey(sk)
+			if err != nil {
+				return err
+			}
+		}
+		keys.Chains = append(keys.Chains, chainKeys)
+	}
+
+	reply.User, err = encryptPortableUser(&keys, args.Passphrase)
+	return err
+}
+
+// ImportUserKeysArgs are arguments for ImportUserKeys
+type ImportUserKeysArgs struct {
+	// The username and password of the user to import the keys to. The user is
+	// created if it doesn't exist.
+	api.UserPass
+	// The passphrase the export was encrypted with
+	Passphrase string `json:"passphrase"`
+	// The export returned by ExportUserKeys
+	User PortableUser `json:"user"`
+	// The chains to import keys to. If empty, the keys on every chain in the
+	// export are imported.
+	ChainIDs []ids.ID `json:"chainIDs"`
+}
+
+// ImportUserKeysReply is the reply from ImportUserKeys
+type ImportUserKeysReply struct {
+	// Number of keys that the user didn't already hold
+	NumImported jsoncodec.Uint32 `json:"numImported"`
+}
+
+// ImportUserKeys imports the private keys in an export made by ExportUserKeys.
+// Each key is added to the user on the chain it was exported from, alongside
+// the keys the user already holds. If [ChainIDs] is given, only the keys on
+// those chains are imported. Nothing is imported, and the user isn't created,
+// unless every key parses and the user stays within its address limit on
+// every chain.
+func (ks *Keystore) ImportUserKeys(_ *http.Request, args *ImportUserKeysArgs, reply *ImportUserKeysReply) error {
+	ks.log.Info("Keystore: ImportUserKeys called for %s", args.Username)
+
+	if args.Username == "" {
+		return errEmptyUsername
+	}
+
+	keys, err := args.User.decrypt(args.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	chains := keys.Chains
+	if len(args.ChainIDs) > 0 {
+		exported := make(map[ids.ID]portableChainKeys, len(keys.Chains))
+		for _, chain := range keys.Chains {
+			exported[chain.ChainID] = chain
+		}
+		chains = make([]portableChainKeys, len(args.ChainIDs))
+		for i, chainID := range args.ChainIDs {
+			chain, ok := exported[chainID]
+			if !ok {
+				return fmt.Errorf("export doesn't have keys on chain %s, or it was given more than once", chainID)
+			}
+			delete(exported, chainID)
+			chains[i] = chain
+		}
+	}
+
+	chainKeys := make([][]*crypto.PrivateKeySECP256K1R, len(chains))
+	for i, chain := range chains {
+		chainKeys[i] = make([]*crypto.PrivateKeySECP256K1R, len(chain.PrivateKeys))
+		for j, skStr := range chain.PrivateKeys {
+			chainKeys[i][j], err = parsePrivateKey(skStr)
+			if err != nil {
+				return fmt.Errorf("couldn't parse key on chain %s: %w", chain.ChainID, err)
+			}
+		}
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	user, err := ks.getUser(args.Username)
+	userExists := err == nil
+	if userExists && !user.Check(args.Password) {
+		return fmt.Errorf("incorrect password for user %q", args.Username)
+	}
+
+	// A user that doesn't exist yet has no data, so the batches can be built
+	// before the user is created
+	batches := make([]database.Batch, len(chains))
+	numImported := 0
+	for i, chain := range chains {
+		db, err := ks.userChainDB(chain.ChainID, args.Username, args.Password)
+		if err != nil {
+			return err
+		}
+		batch, numAdded, err := ks.chainPrivateKeysBatch(db, chainKeys[i])
+		if err != nil {
+			return fmt.Errorf("couldn't import keys on chain %s: %w", chain.ChainID, err)
+		}
+		batches[i] = batch
+		numImported += numAdded
+	}
+
+	if !userExists {
+		if err := ks.AddUser(args.Username, args.Password); err != nil {
+			return err
+		}
+	}
+	for i, batch := range batches {
+		if err := batch.Write(); err != nil {
+			return fmt.Errorf("couldn't import keys on chain %s: %w", chains[i].ChainID, err)
+		}
+	}
+
+	reply.NumImported = jsoncodec.Uint32(numImported)
+	return nil
+}
+
 // DeleteUser deletes user with the provided username and password.
 func (ks *Keystore) DeleteUser(_ *http.Request, args *api.UserPass, reply *api.SuccessResponse) error {
 	ks.log.Info("Keystore: DeleteUser called with %s", args.Username)
@@ -346,6 +524,10 @@ func (ks *Keystore) DeleteUser(_ *http.Request, args *api.UserPass, reply *api.S
 
 // NewBlockchainKeyStore ...
 func (ks *Keystore) NewBlockchainKeyStore(blockchainID ids.ID) *BlockchainKeystore {
+	ks.lock.Lock()
+	ks.blockchainIDs.Add(blockchainID)
+	ks.lock.Unlock()
+
 	return &BlockchainKeystore{
 		blockchainID: blockchainID,
 		ks:           ks,
@@ -366,7 +548,12 @@ func (ks *Keystore) GetDatabase(bID ids.ID, username, password string) (database
 	if !usr.Check(password) {
 		return nil, fmt.Errorf("incorrect password for user %q", username)
 	}
+	return ks.userChainDB(bID, username, password)
+}
 
+// userChainDB returns the database of [username] on chain [bID]. Assumes that
+// [password] has already been checked.
+func (ks *Keystore) userChainDB(bID ids.ID, username, password string) (database.Database, error) {
 	userDB := prefixdb.New([]byte(username), ks.bcDB)
 	bcDB := prefixdb.NewNested(bID[:], userDB)
 	return encdb.New([]byte(password), bcDB)
@@ -0,0 +1,43 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/corpetty/avalanchego/ids"
+)
+
+const (
+	// chainConfigFileName is the name of the file, in a chain's directory in
+	// the chain config directory, that holds the chain's config
+	chainConfigFileName = "config.json"
+)
+
+// readChainConfig returns the contents of the config file of the chain with
+// ID [chainID] and aliases [aliases] in the chain config directory [dir]. The
+// chain's directory may be named after its ID or any of its aliases. If more
+// than one exists, the one named after the chain's ID is preferred, and then
+// the one named after its earliest alias. Returns nil if the chain doesn't
+// have a config file.
+func readChainConfig(dir string, chainID ids.ID, aliases []string) ([]byte, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	names := append([]string{chainID.String()}, aliases...)
+	for _, name := range names {
+		configBytes, err := ioutil.ReadFile(filepath.Join(dir, name, chainConfigFileName))
+		switch {
+		case err == nil:
+			return configBytes, nil
+		case os.IsNotExist(err):
+		default:
+			return nil, err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,85 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+)
+
+func writeChainConfig(t *testing.T, dir, name string, config []byte) {
+	chainDir := filepath.Join(dir, name)
+	if err := os.MkdirAll(chainDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(chainDir, chainConfigFileName), config, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadChainConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chain-configs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	chainID := ids.ID{1}
+	aliases := []string{"X", "avm"}
+
+	// No config
+	if config, err := readChainConfig(dir, chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if config != nil {
+		t.Fatalf("expected no config but got %s", config)
+	}
+	if config, err := readChainConfig("", chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if config != nil {
+		t.Fatalf("expected no config but got %s", config)
+	}
+	if config, err := readChainConfig(filepath.Join(dir, "missing"), chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if config != nil {
+		t.Fatalf("expected no config but got %s", config)
+	}
+
+	// Configs named after aliases are preferred in order
+	avmConfig := []byte(`{"alias": "avm"}`)
+	writeChainConfig(t, dir, "avm", avmConfig)
+	if config, err := readChainConfig(dir, chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(config, avmConfig) {
+		t.Fatalf("expected config %s but got %s", avmConfig, config)
+	}
+
+	xConfig := []byte(`{"alias": "X"}`)
+	writeChainConfig(t, dir, "X", xConfig)
+	if config, err := readChainConfig(dir, chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(config, xConfig) {
+		t.Fatalf("expected config %s but got %s", xConfig, config)
+	}
+
+	// The config named after the chain ID is preferred over aliases
+	idConfig := []byte(`{"alias": "none"}`)
+	writeChainConfig(t, dir, chainID.String(), idConfig)
+	if config, err := readChainConfig(dir, chainID, aliases); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(config, idConfig) {
+		t.Fatalf("expected config %s but got %s", idConfig, config)
+	}
+
+	// Another chain's config isn't read
+	if config, err := readChainConfig(dir, ids.ID{2}, nil); err != nil {
+		t.Fatal(err)
+	} else if config != nil {
+		t.Fatalf("expected no config but got %s", config)
+	}
+}
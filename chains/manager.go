@@ -46,6 +46,10 @@ const (
 	defaultChannelSize = 1024
 )
 
+var (
+	errNoPlatformChain = errors.New("platform chain hasn't been created")
+)
+
 // Manager manages the chains running on this node.
 // It can:
 //   * Create a chain
@@ -138,6 +142,8 @@ type ManagerConfig struct {
 	WhitelistedSubnets      ids.Set          // Subnets to validate
 	TimeoutManager          *timeout.Manager // Manages request timeouts when sending messages to other validators
 	HealthService           health.CheckRegisterer
+	ChainConfigDir          string // Holds the config files of chains
+	SubnetConfigDir         string // Holds the config files of subnets
 }
 
 type manager struct {
@@ -155,6 +161,11 @@ type manager struct {
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]*router.Handler
+
+	// The context and VM of the platform chain, which is used to look up the
+	// validator sets of subnets. Only accessed with [chainsLock] held.
+	platformCtx       *snow.Context
+	platformVDRLookup snow.ValidatorLookup
 }
 
 // New returns a new Manager
@@ -254,6 +265,7 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 		SharedMemory:         m.AtomicMemory.NewSharedMemory(chainParams.ID),
 		BCLookup:             m,
 		SNLookup:             m,
+		VDRLookup:            m,
 		Namespace:            fmt.Sprintf("%s_%s_vm", constants.PlatformName, primaryAlias),
 		Metrics:              m.ConsensusParams.Metrics,
 		EpochFirstTransition: m.EpochFirstTransition,
@@ -266,6 +278,11 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 		return nil, fmt.Errorf("error while getting vmFactory: %w", err)
 	}
 
+	configBytes, err := readChainConfig(m.ChainConfigDir, chainParams.ID, m.Aliases(chainParams.ID))
+	if err != nil {
+		return nil, fmt.Errorf("error while reading chain's config: %w", err)
+	}
+
 	// Create the chain
 	vm, err := vmFactory.New(ctx)
 	if err != nil {
@@ -273,6 +290,15 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 	}
 	// TODO: Shutdown VM if an error occurs
 
+	if chainParams.ID == constants.PlatformChainID {
+		if vdrLookup, ok := vm.(snow.ValidatorLookup); ok {
+			m.chainsLock.Lock()
+			m.platformCtx = ctx
+			m.platformVDRLookup = vdrLookup
+			m.chainsLock.Unlock()
+		}
+	}
+
 	fxs := make([]*common.Fx, len(chainParams.FxAliases))
 	for i, fxAlias := range chainParams.FxAliases {
 		fxID, err := m.VMManager.Lookup(fxAlias)
@@ -313,6 +339,17 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 		return nil, fmt.Errorf("couldn't get validator set of subnet with ID %s. The subnet may not exist", chainParams.SubnetID)
 	}
 
+	// The subnet may override the node's consensus parameters
+	consensusParams, overridden, err := readSubnetConsensusParams(m.SubnetConfigDir, chainParams.SubnetID, consensusParams)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading subnet's config: %w", err)
+	}
+	if overridden {
+		if err := verifySubnetConsensusParams(consensusParams, vdrs.Len()); err != nil {
+			return nil, fmt.Errorf("invalid consensus parameters for subnet %s: %w", chainParams.SubnetID, err)
+		}
+	}
+
 	beacons := vdrs
 	if chainParams.CustomBeacons != nil {
 		beacons = chainParams.CustomBeacons
@@ -326,6 +363,7 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 		chain, err = m.createAvalancheChain(
 			ctx,
 			chainParams.GenesisData,
+			configBytes,
 			vdrs,
 			beacons,
 			vm,
@@ -340,6 +378,7 @@ func (m *manager) buildChain(chainParams ChainParameters) (*chain, error) {
 		chain, err = m.createSnowmanChain(
 			ctx,
 			chainParams.GenesisData,
+			configBytes,
 			vdrs,
 			beacons,
 			vm,
@@ -389,6 +428,7 @@ func (m *manager) unblockChains() {
 func (m *manager) createAvalancheChain(
 	ctx *snow.Context,
 	genesisData []byte,
+	configBytes []byte,
 	validators,
 	beacons validators.Set,
 	vm vertex.DAGVM,
@@ -418,7 +458,11 @@ func (m *manager) createAvalancheChain(
 	// VM uses this channel to notify engine that a block is ready to be made
 	msgChan := make(chan common.Message, defaultChannelSize)
 
-	if err := vm.Initialize(ctx, vmDB, genesisData, msgChan, fxs); err != nil {
+	// Passes messages from the consensus engine to the network
+	sender := sender.Sender{}
+	sender.Initialize(ctx, m.Net, m.ManagerConfig.Router, m.TimeoutManager)
+
+	if err := vm.Initialize(ctx, vmDB, genesisData, configBytes, msgChan, fxs, &sender); err != nil {
 		return nil, fmt.Errorf("error during vm's Initialize: %w", err)
 	}
 
@@ -427,10 +471,6 @@ func (m *manager) createAvalancheChain(
 	vtxManager := &state.Serializer{}
 	vtxManager.Initialize(ctx, vm, vertexDB)
 
-	// Passes messages from the consensus engine to the network
-	sender := sender.Sender{}
-	sender.Initialize(ctx, m.Net, m.ManagerConfig.Router, m.TimeoutManager)
-
 	sampleK := consensusParams.K
 	if uint64(sampleK) > bootstrapWeight {
 		sampleK = int(bootstrapWeight)
@@ -501,6 +541,7 @@ func (m *manager) createAvalancheChain(
 func (m *manager) createSnowmanChain(
 	ctx *snow.Context,
 	genesisData []byte,
+	configBytes []byte,
 	validators,
 	beacons validators.Set,
 	vm block.ChainVM,
@@ -524,15 +565,15 @@ func (m *manager) createSnowmanChain(
 	// VM uses this channel to notify engine that a block is ready to be made
 	msgChan := make(chan common.Message, defaultChannelSize)
 
-	// Initialize the VM
-	if err := vm.Initialize(ctx, vmDB, genesisData, msgChan, fxs); err != nil {
-		return nil, err
-	}
-
 	// Passes messages from the consensus engine to the network
 	sender := sender.Sender{}
 	sender.Initialize(ctx, m.Net, m.ManagerConfig.Router, m.TimeoutManager)
 
+	// Initialize the VM
+	if err := vm.Initialize(ctx, vmDB, genesisData, configBytes, msgChan, fxs, &sender); err != nil {
+		return nil, err
+	}
+
 	sampleK := consensusParams.K
 	if uint64(sampleK) > bootstrapWeight {
 		sampleK = int(bootstrapWeight)
@@ -610,6 +651,46 @@ func (m *manager) SubnetID(chainID ids.ID) (ids.ID, error) {
 	return chain.Context().SubnetID, nil
 }
 
+// GetCurrentHeight implements the snow.ValidatorLookup interface by asking the
+// platform chain. This blocks while the platform chain is processing a message,
+// so it must not be called while the platform chain is creating a chain.
+func (m *manager) GetCurrentHeight() (uint64, error) {
+	ctx, vdrLookup, err := m.getPlatformVDRLookup()
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.Lock.RLock()
+	defer ctx.Lock.RUnlock()
+
+	return vdrLookup.GetCurrentHeight()
+}
+
+// GetValidatorSet implements the snow.ValidatorLookup interface by asking the
+// platform chain. This blocks while the platform chain is processing a message,
+// so it must not be called while the platform chain is creating a chain.
+func (m *manager) GetValidatorSet(height uint64, subnetID ids.ID) (map[ids.ShortID]uint64, error) {
+	ctx, vdrLookup, err := m.getPlatformVDRLookup()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Lock.RLock()
+	defer ctx.Lock.RUnlock()
+
+	return vdrLookup.GetValidatorSet(height, subnetID)
+}
+
+func (m *manager) getPlatformVDRLookup() (*snow.Context, snow.ValidatorLookup, error) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	if m.platformVDRLookup == nil {
+		return nil, nil, errNoPlatformChain
+	}
+	return m.platformCtx, m.platformVDRLookup, nil
+}
+
 func (m *manager) IsBootstrapped(id ids.ID) bool {
 	m.chainsLock.Lock()
 	chain, exists := m.chains[id]
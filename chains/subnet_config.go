@@ -0,0 +1,103 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/corpetty/avalanchego/ids"
+
+	avcon "github.com/corpetty/avalanchego/snow/consensus/avalanche"
+)
+
+const (
+	// subnetConfigFileExt is the extension of the files, in the subnet config
+	// directory, that hold the configs of subnets
+	subnetConfigFileExt = ".json"
+)
+
+// subnetConfig is the config of a subnet's chains on this node
+type subnetConfig struct {
+	// Overrides of the node's consensus parameters
+	ConsensusParameters json.RawMessage `json:"consensusParameters"`
+}
+
+// consensusConfig is the JSON form of the consensus parameters
+type consensusConfig struct {
+	K                 int `json:"k"`
+	Alpha             int `json:"alpha"`
+	BetaVirtuous      int `json:"betaVirtuous"`
+	BetaRogue         int `json:"betaRogue"`
+	ConcurrentRepolls int `json:"concurrentRepolls"`
+	OptimalProcessing int `json:"optimalProcessing"`
+	Parents           int `json:"parents"`
+	BatchSize         int `json:"batchSize"`
+}
+
+// readSubnetConsensusParams returns the consensus parameters of the chains of
+// the subnet with ID [subnetID]. They are [params] with the overrides in the
+// subnet's config file, [dir]/[subnetID].json, applied. Parameters the file
+// doesn't set keep their value in [params]. Returns true if the file overrides
+// the consensus parameters.
+func readSubnetConsensusParams(dir string, subnetID ids.ID, params avcon.Parameters) (avcon.Parameters, bool, error) {
+	if dir == "" {
+		return params, false, nil
+	}
+
+	configBytes, err := ioutil.ReadFile(filepath.Join(dir, subnetID.String()+subnetConfigFileExt))
+	switch {
+	case os.IsNotExist(err):
+		return params, false, nil
+	case err != nil:
+		return params, false, err
+	}
+
+	config := subnetConfig{}
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return params, false, fmt.Errorf("couldn't parse config of subnet %s: %w", subnetID, err)
+	}
+	if len(config.ConsensusParameters) == 0 {
+		return params, false, nil
+	}
+
+	consensus := consensusConfig{
+		K:                 params.K,
+		Alpha:             params.Alpha,
+		BetaVirtuous:      params.BetaVirtuous,
+		BetaRogue:         params.BetaRogue,
+		ConcurrentRepolls: params.ConcurrentRepolls,
+		OptimalProcessing: params.OptimalProcessing,
+		Parents:           params.Parents,
+		BatchSize:         params.BatchSize,
+	}
+	if err := json.Unmarshal(config.ConsensusParameters, &consensus); err != nil {
+		return params, false, fmt.Errorf("couldn't parse consensus parameters of subnet %s: %w", subnetID, err)
+	}
+
+	params.K = consensus.K
+	params.Alpha = consensus.Alpha
+	params.BetaVirtuous = consensus.BetaVirtuous
+	params.BetaRogue = consensus.BetaRogue
+	params.ConcurrentRepolls = consensus.ConcurrentRepolls
+	params.OptimalProcessing = consensus.OptimalProcessing
+	params.Parents = consensus.Parents
+	params.BatchSize = consensus.BatchSize
+	return params, true, nil
+}
+
+// verifySubnetConsensusParams returns nil if [params] can be used by the
+// chains of a subnet with [numValidators] validators
+func verifySubnetConsensusParams(params avcon.Parameters, numValidators int) error {
+	if err := params.Valid(); err != nil {
+		return err
+	}
+	if params.K > numValidators {
+		return fmt.Errorf("K = %d, validators = %d: Fails the condition that: K <= validators", params.K, numValidators)
+	}
+	return nil
+}
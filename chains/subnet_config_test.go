@@ -0,0 +1,112 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/consensus/snowball"
+
+	avcon "github.com/corpetty/avalanchego/snow/consensus/avalanche"
+)
+
+func writeSubnetConfig(t *testing.T, dir string, subnetID ids.ID, config string) {
+	if err := ioutil.WriteFile(filepath.Join(dir, subnetID.String()+subnetConfigFileExt), []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadSubnetConsensusParams(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subnet-configs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	subnetID := ids.ID{1}
+	defaults := avcon.Parameters{
+		Parameters: snowball.Parameters{
+			Namespace:         "namespace",
+			K:                 20,
+			Alpha:             14,
+			BetaVirtuous:      15,
+			BetaRogue:         20,
+			ConcurrentRepolls: 4,
+			OptimalProcessing: 50,
+		},
+		Parents:   5,
+		BatchSize: 30,
+	}
+
+	// No config
+	for _, configDir := range []string{"", dir, filepath.Join(dir, "missing")} {
+		if params, overridden, err := readSubnetConsensusParams(configDir, subnetID, defaults); err != nil {
+			t.Fatal(err)
+		} else if overridden || params != defaults {
+			t.Fatalf("expected the default parameters but got %+v", params)
+		}
+	}
+
+	// A config without consensus parameters
+	writeSubnetConfig(t, dir, subnetID, `{}`)
+	if params, overridden, err := readSubnetConsensusParams(dir, subnetID, defaults); err != nil {
+		t.Fatal(err)
+	} else if overridden || params != defaults {
+		t.Fatalf("expected the default parameters but got %+v", params)
+	}
+
+	// Only the given parameters are overridden
+	writeSubnetConfig(t, dir, subnetID, `{"consensusParameters": {"k": 3, "alpha": 2, "betaVirtuous": 4}}`)
+	expected := defaults
+	expected.K = 3
+	expected.Alpha = 2
+	expected.BetaVirtuous = 4
+	if params, overridden, err := readSubnetConsensusParams(dir, subnetID, defaults); err != nil {
+		t.Fatal(err)
+	} else if !overridden || params != expected {
+		t.Fatalf("expected parameters %+v but got %+v", expected, params)
+	}
+
+	// Other subnets keep the default parameters
+	if params, overridden, err := readSubnetConsensusParams(dir, ids.ID{2}, defaults); err != nil {
+		t.Fatal(err)
+	} else if overridden || params != defaults {
+		t.Fatalf("expected the default parameters but got %+v", params)
+	}
+
+	writeSubnetConfig(t, dir, subnetID, `{"consensusParameters": {"k": "3"}}`)
+	if _, _, err := readSubnetConsensusParams(dir, subnetID, defaults); err == nil {
+		t.Fatalf("should have failed to parse invalid consensus parameters")
+	}
+}
+
+func TestVerifySubnetConsensusParams(t *testing.T) {
+	params := avcon.Parameters{
+		Parameters: snowball.Parameters{
+			K:                 3,
+			Alpha:             2,
+			BetaVirtuous:      4,
+			BetaRogue:         4,
+			ConcurrentRepolls: 1,
+			OptimalProcessing: 1,
+		},
+		Parents:   2,
+		BatchSize: 1,
+	}
+	if err := verifySubnetConsensusParams(params, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifySubnetConsensusParams(params, 2); err == nil {
+		t.Fatalf("should have failed because K is greater than the number of validators")
+	}
+
+	params.Alpha = 1
+	if err := verifySubnetConsensusParams(params, 3); err == nil {
+		t.Fatalf("should have failed because Alpha <= K/2")
+	}
+}
@@ -0,0 +1,63 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package backend opens the database backends a node can store its state in.
+package backend
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/leveldb"
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/database/pebble"
+)
+
+// Supported database backends
+const (
+	LevelDB  = "leveldb"
+	PebbleDB = "pebbledb"
+	MemDB    = "memdb"
+)
+
+var (
+	// Types are the supported database backends
+	Types = []string{LevelDB, PebbleDB, MemDB}
+
+	errUnknownType = fmt.Errorf("database type must be one of {%s}", strings.Join(Types, ", "))
+)
+
+// Path returns the directory the [dbType] database of version [version] is
+// stored in under [networkDir], the directory of the node's network. LevelDB
+// databases are stored where nodes have always stored them. Other backends are
+// stored in their own directory so that a node never opens another backend's
+// files. In-memory databases aren't stored on disk, so their path is empty.
+func Path(networkDir, dbType, version string) (string, error) {
+	switch dbType {
+	case LevelDB:
+		return filepath.Join(networkDir, version), nil
+	case PebbleDB:
+		return filepath.Join(networkDir, dbType, version), nil
+	case MemDB:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unknown database type %q: %w", dbType, errUnknownType)
+	}
+}
+
+// New opens the [dbType] database stored at [path], creating it if it doesn't
+// exist. [path] is ignored by in-memory databases.
+func New(dbType, path string) (database.Database, error) {
+	switch dbType {
+	case LevelDB:
+		return leveldb.New(path, 0, 0, 0)
+	case PebbleDB:
+		return pebble.New(path, 0, 0, 0)
+	case MemDB:
+		return memdb.New(), nil
+	default:
+		return nil, fmt.Errorf("unknown database type %q: %w", dbType, errUnknownType)
+	}
+}
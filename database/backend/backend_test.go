@@ -0,0 +1,56 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package backend
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	networkDir, err := ioutil.TempDir("", "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(networkDir)
+
+	paths := map[string]bool{}
+	for _, dbType := range Types {
+		dbPath, err := Path(networkDir, dbType, "v1.0.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if paths[dbPath] {
+			t.Fatalf("%s database shares the path %q with another backend", dbType, dbPath)
+		}
+		paths[dbPath] = true
+
+		db, err := New(dbType, dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Put([]byte("key"), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+		if value, err := db.Get([]byte("key")); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(value, []byte("value")) {
+			t.Fatalf("%s database returned %v, expected %v", dbType, value, []byte("value"))
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestUnknownType(t *testing.T) {
+	if _, err := Path("", "unknown", "v1.0.0"); err == nil {
+		t.Fatalf("should have failed to locate an unknown backend")
+	}
+	if _, err := New("unknown", ""); err == nil {
+		t.Fatalf("should have failed to open an unknown backend")
+	}
+}
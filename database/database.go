@@ -49,6 +49,25 @@ type Compacter interface {
 	Compact(start []byte, limit []byte) error
 }
 
+// Snapshot is a read-only view of a database as of the time the snapshot was
+// created. Writes to the database after that time aren't visible through the
+// snapshot.
+type Snapshot interface {
+	KeyValueReader
+	Iteratee
+
+	// Release releases the resources held by the snapshot. Release should
+	// always succeed and can be called multiple times without causing error.
+	Release()
+}
+
+// Snapshotter wraps the NewSnapshot method of a backing data store.
+type Snapshotter interface {
+	// NewSnapshot returns a snapshot of the current state of the data store.
+	// The snapshot must be released after use.
+	NewSnapshot() (Snapshot, error)
+}
+
 // Database contains all the methods required to allow handling different
 // key-value data stores backing the database.
 type Database interface {
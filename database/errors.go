@@ -10,4 +10,5 @@ var (
 	ErrClosed          = errors.New("closed")
 	ErrNotFound        = errors.New("not found")
 	ErrAvoidCorruption = errors.New("closed to avoid possible corruption")
+	ErrNotSupported    = errors.New("not supported")
 )
@@ -161,6 +161,18 @@ func (db *Database) Compact(start []byte, limit []byte) error {
 	return db.handleError(db.DB.CompactRange(util.Range{Start: start, Limit: limit}))
 }
 
+// NewSnapshot returns a read-only view of the current state of the database
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	if db.errored {
+		return nil, database.ErrAvoidCorruption
+	}
+	snap, err := db.DB.GetSnapshot()
+	if err != nil {
+		return nil, db.handleError(err)
+	}
+	return &snapshot{snap: snap}, nil
+}
+
 // Close implements the Database interface
 func (db *Database) Close() error { return db.handleError(db.DB.Close()) }
 
@@ -265,3 +277,45 @@ func updateError(err error) error {
 		return err
 	}
 }
+
+// snapshot is a read-only view of the database at a point in time
+type snapshot struct{ snap *leveldb.Snapshot }
+
+// Has returns if the key was set in the database when the snapshot was taken
+func (s *snapshot) Has(key []byte) (bool, error) {
+	has, err := s.snap.Has(key, nil)
+	return has, updateError(err)
+}
+
+// Get returns the value the key mapped to when the snapshot was taken
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	return value, updateError(err)
+}
+
+// NewIterator implements the database.Snapshot interface
+func (s *snapshot) NewIterator() database.Iterator {
+	return &iter{s.snap.NewIterator(new(util.Range), nil)}
+}
+
+// NewIteratorWithStart implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return &iter{s.snap.NewIterator(&util.Range{Start: start}, nil)}
+}
+
+// NewIteratorWithPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return &iter{s.snap.NewIterator(util.BytesPrefix(prefix), nil)}
+}
+
+// NewIteratorWithStartAndPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	iterRange := util.BytesPrefix(prefix)
+	if bytes.Compare(start, prefix) == 1 {
+		iterRange.Start = start
+	}
+	return &iter{s.snap.NewIterator(iterRange, nil)}
+}
+
+// Release implements the database.Snapshot interface
+func (s *snapshot) Release() { s.snap.Release() }
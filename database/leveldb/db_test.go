@@ -25,3 +25,18 @@ func TestInterface(t *testing.T) {
 		test(t, db)
 	}
 }
+
+func TestSnapshotInterface(t *testing.T) {
+	for i, test := range database.SnapshotTests {
+		folder := fmt.Sprintf("snapshotdb%d", i)
+
+		db, err := New(folder, 0, 0, 0)
+		if err != nil {
+			t.Fatalf("leveldb.New(%s, 0, 0) errored with %s", folder, err)
+		}
+		defer os.RemoveAll(folder)
+		defer db.Close()
+
+		test(t, db)
+	}
+}
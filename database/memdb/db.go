@@ -140,6 +140,22 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 	}
 }
 
+// NewSnapshot implements the database.Snapshotter interface. The snapshot is a
+// copy of the database, since values are never modified in place.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return nil, database.ErrClosed
+	}
+	snap := NewWithSize(len(db.db))
+	for key, value := range db.db {
+		snap.db[key] = value
+	}
+	return &snapshot{Database: snap}, nil
+}
+
 // Stat implements the Database interface
 func (db *Database) Stat(property string) (string, error) { return "", database.ErrNotFound }
 
@@ -270,3 +286,9 @@ func (it *iterator) Value() []byte {
 
 // Release implements the Iterator interface
 func (it *iterator) Release() { it.keys = nil; it.values = nil }
+
+// snapshot is a read-only view of the database at a point in time
+type snapshot struct{ *Database }
+
+// Release implements the database.Snapshot interface
+func (s *snapshot) Release() { _ = s.Database.Close() }
@@ -0,0 +1,532 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/nodb"
+	"github.com/corpetty/avalanchego/utils"
+)
+
+const (
+	// minBlockCacheSize is the minimum number of bytes to use for block caching
+	// in pebble.
+	minBlockCacheSize = 8 * 1024 * 1024
+
+	// minWriteBufferSize is the minimum number of bytes to use for memtables in
+	// pebble.
+	minWriteBufferSize = 8 * 1024 * 1024
+
+	// minHandleCap is the minimum number of files descriptors to cap pebble to
+	// use
+	minHandleCap = 16
+
+	// MetricsProperty is the property Stat reports pebble's human readable
+	// summary of its internal metrics under
+	MetricsProperty = "pebble.metrics"
+)
+
+var (
+	errUnknownProperty = errors.New("unknown property")
+)
+
+// Database is a persistent key-value store backed by pebble, an LSM tree with
+// concurrent compactions. Apart from basic data storage functionality it also
+// supports batch writes and iterating over the keyspace in binary-alphabetical
+// order.
+type Database struct {
+	lock sync.RWMutex
+	// nil if the database has been closed
+	db *pebble.DB
+
+	stallLock sync.Mutex
+	// Number of times writes have been stalled
+	writeStalls uint64
+	// Total time writes have been stalled, not including the current stall
+	writeStallDuration time.Duration
+	// Time the current stall began, or zero if writes aren't stalled
+	writeStallStart time.Time
+}
+
+// New returns a wrapped pebble object.
+func New(file string, blockCacheSize, writeBufferSize, handleCap int) (*Database, error) {
+	// Enforce minimums
+	if blockCacheSize < minBlockCacheSize {
+		blockCacheSize = minBlockCacheSize
+	}
+	if writeBufferSize < minWriteBufferSize {
+		writeBufferSize = minWriteBufferSize
+	}
+	if handleCap < minHandleCap {
+		handleCap = minHandleCap
+	}
+
+	db := &Database{}
+	cache := pebble.NewCache(int64(blockCacheSize))
+	defer cache.Unref()
+
+	pebbleDB, err := pebble.Open(file, &pebble.Options{
+		Cache:        cache,
+		MaxOpenFiles: handleCap,
+		// There are two memtables of size MemTableSize used.
+		MemTableSize: writeBufferSize / 2,
+		Levels: []pebble.LevelOptions{{
+			FilterPolicy: bloom.FilterPolicy(10),
+		}},
+		EventListener: pebble.EventListener{
+			WriteStallBegin: db.writeStallBegin,
+			WriteStallEnd:   db.writeStallEnd,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	db.db = pebbleDB
+	return db, nil
+}
+
+// Has returns if the key is set in the database
+func (db *Database) Has(key []byte) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return false, database.ErrClosed
+	}
+	_, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, closer.Close()
+}
+
+// Get returns the value the key maps to in the database
+func (db *Database) Get(key []byte) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return nil, database.ErrClosed
+	}
+	return get(db.db, key)
+}
+
+// Put sets the value of the provided key to the provided value. Like the
+// leveldb database, writes aren't synced to disk before returning.
+func (db *Database) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return database.ErrClosed
+	}
+	return db.db.Set(key, value, pebble.NoSync)
+}
+
+// Delete removes the key from the database
+func (db *Database) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return database.ErrClosed
+	}
+	return db.db.Delete(key, pebble.NoSync)
+}
+
+// NewBatch creates a write/delete-only buffer that is atomically committed to
+// the database when write is called
+func (db *Database) NewBatch() database.Batch {
+	return &batch{
+		batch: new(pebble.Batch),
+		db:    db,
+	}
+}
+
+// NewIterator creates a lexicographically ordered iterator over the database
+func (db *Database) NewIterator() database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+// NewIteratorWithStart creates a lexicographically ordered iterator over the
+// database starting at the provided key
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+// NewIteratorWithPrefix creates a lexicographically ordered iterator over the
+// database ignoring keys that do not start with the provided prefix
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+// NewIteratorWithStartAndPrefix creates a lexicographically ordered iterator
+// over the database starting at start and ignoring keys that do not start with
+// the provided prefix
+func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return &nodb.Iterator{Err: database.ErrClosed}
+	}
+	return &iter{iter: db.db.NewIter(iterOptions(start, prefix))}
+}
+
+// Stat returns a particular internal stat of the database. MetricsProperty is
+// a human readable summary of the database's internal metrics. The other
+// properties are reported as numbers.
+func (db *Database) Stat(property string) (string, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return "", database.ErrClosed
+	}
+
+	metrics := db.db.Metrics()
+	if property == MetricsProperty {
+		return metrics.String(), nil
+	}
+
+	db.stallLock.Lock()
+	writeStalls := db.writeStalls
+	writeStallDuration := db.writeStallDuration
+	if !db.writeStallStart.IsZero() {
+		writeStallDuration += time.Since(db.writeStallStart)
+	}
+	db.stallLock.Unlock()
+
+	var value float64
+	switch property {
+	case CompactionsProperty:
+		value = float64(metrics.Compact.Count)
+	case CompactionDebtProperty:
+		value = float64(metrics.Compact.EstimatedDebt)
+	case FlushesProperty:
+		value = float64(metrics.Flush.Count)
+	case ReadAmpProperty:
+		value = float64(metrics.ReadAmp())
+	case L0FilesProperty:
+		value = float64(metrics.Levels[0].NumFiles)
+	case TablesSizeProperty:
+		value = float64(metrics.Total().Size)
+	case MemTablesSizeProperty:
+		value = float64(metrics.MemTable.Size)
+	case WriteStallsProperty:
+		value = float64(writeStalls)
+	case WriteStallDurationProperty:
+		value = writeStallDuration.Seconds()
+	default:
+		return "", fmt.Errorf("%w %q", errUnknownProperty, property)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64), nil
+}
+
+// Compact the underlying DB for the given key range.
+// Specifically, deleted and overwritten versions are discarded,
+// and the data is rearranged to reduce the cost of operations
+// needed to access the data. This operation should typically only
+// be invoked by users who understand the underlying implementation.
+//
+// A nil start is treated as a key before all keys in the DB.
+// And a nil limit is treated as a key after all keys in the DB.
+// Therefore if both are nil then it will compact entire DB.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return database.ErrClosed
+	}
+
+	if limit == nil {
+		// Pebble requires an explicit end, so compact up to just after the
+		// last key
+		it := db.db.NewIter(nil)
+		if it.Last() {
+			limit = append(utils.CopyBytes(it.Key()), 0)
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+		if limit == nil {
+			// The database is empty
+			return nil
+		}
+	}
+	if start == nil {
+		start = []byte{}
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return db.db.Compact(start, limit)
+}
+
+// NewSnapshot returns a read-only view of the current state of the database
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.db == nil {
+		return nil, database.ErrClosed
+	}
+	return &snapshot{snap: db.db.NewSnapshot()}, nil
+}
+
+// Close implements the Database interface
+func (db *Database) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.db == nil {
+		return database.ErrClosed
+	}
+	err := db.db.Close()
+	db.db = nil
+	return err
+}
+
+func (db *Database) writeStallBegin(pebble.WriteStallBeginInfo) {
+	db.stallLock.Lock()
+	defer db.stallLock.Unlock()
+
+	db.writeStalls++
+	db.writeStallStart = time.Now()
+}
+
+func (db *Database) writeStallEnd() {
+	db.stallLock.Lock()
+	defer db.stallLock.Unlock()
+
+	if !db.writeStallStart.IsZero() {
+		db.writeStallDuration += time.Since(db.writeStallStart)
+		db.writeStallStart = time.Time{}
+	}
+}
+
+// reader is the part of pebble's interface shared by the database and its
+// snapshots
+type reader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+}
+
+// get returns a copy of the value [key] maps to in [r]
+func get(r reader, key []byte) ([]byte, error) {
+	value, closer, err := r.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	value = utils.CopyBytes(value)
+	return value, closer.Close()
+}
+
+// iterOptions returns the bounds of an iterator that starts at [start] and
+// only includes keys that start with [prefix]
+func iterOptions(start, prefix []byte) *pebble.IterOptions {
+	opts := &pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixEnd(prefix),
+	}
+	if bytes.Compare(start, prefix) == 1 {
+		opts.LowerBound = start
+	}
+	return opts
+}
+
+// prefixEnd returns the first key after all the keys that start with
+// [prefix], or nil if there is no such key
+func prefixEnd(prefix []byte) []byte {
+	end := utils.CopyBytes(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// batch is a wrapper around a pebble batch to contain sizes.
+type batch struct {
+	batch *pebble.Batch
+	db    *Database
+	size  int
+}
+
+// Put the value into the batch for later writing
+func (b *batch) Put(key, value []byte) error {
+	b.size += len(value)
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete the key during writing
+func (b *batch) Delete(key []byte) error {
+	b.size++
+	return b.batch.Delete(key, nil)
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *batch) ValueSize() int { return b.size }
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if b.db.db == nil {
+		return database.ErrClosed
+	}
+
+	// A pebble batch can only be applied once, but this batch may be written
+	// again, so a copy of it is applied
+	applied := b.db.db.NewBatch()
+	if err := applied.SetRepr(utils.CopyBytes(b.batch.Repr())); err != nil {
+		return err
+	}
+	return b.db.db.Apply(applied, pebble.NoSync)
+}
+
+// Reset resets the batch for reuse.
+func (b *batch) Reset() {
+	b.batch.Reset()
+	b.size = 0
+}
+
+// Replay the batch contents.
+func (b *batch) Replay(w database.KeyValueWriter) error {
+	reader := b.batch.Reader()
+	for {
+		kind, key, value, ok := reader.Next()
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(key, value); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Inner returns itself
+func (b *batch) Inner() database.Batch { return b }
+
+type iter struct {
+	iter *pebble.Iterator
+
+	// True once the iterator has been positioned at its first key
+	started bool
+	// True if the iterator is positioned at a key
+	valid bool
+}
+
+// Next implements the Iterator interface
+func (it *iter) Next() bool {
+	if it.started {
+		it.valid = it.iter.Next()
+	} else {
+		it.started = true
+		it.valid = it.iter.First()
+	}
+	return it.valid
+}
+
+// Error implements the Iterator interface
+func (it *iter) Error() error { return it.iter.Error() }
+
+// Key implements the Iterator interface
+func (it *iter) Key() []byte {
+	if !it.valid {
+		return nil
+	}
+	return utils.CopyBytes(it.iter.Key())
+}
+
+// Value implements the Iterator interface
+func (it *iter) Value() []byte {
+	if !it.valid {
+		return nil
+	}
+	return utils.CopyBytes(it.iter.Value())
+}
+
+// Release implements the Iterator interface
+func (it *iter) Release() {
+	// Errors are reported by Error
+	_ = it.iter.Close()
+}
+
+// snapshot is a read-only view of the database at a point in time
+type snapshot struct {
+	snap        *pebble.Snapshot
+	releaseOnce sync.Once
+}
+
+// Has returns if the key was set in the database when the snapshot was taken
+func (s *snapshot) Has(key []byte) (bool, error) {
+	_, err := get(s.snap, key)
+	switch err {
+	case nil:
+		return true, nil
+	case database.ErrNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Get returns the value the key mapped to when the snapshot was taken
+func (s *snapshot) Get(key []byte) ([]byte, error) { return get(s.snap, key) }
+
+// NewIterator implements the database.Snapshot interface
+func (s *snapshot) NewIterator() database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+// NewIteratorWithStart implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+// NewIteratorWithPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+// NewIteratorWithStartAndPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	return &iter{iter: s.snap.NewIter(iterOptions(start, prefix))}
+}
+
+// Release implements the database.Snapshot interface
+func (s *snapshot) Release() {
+	s.releaseOnce.Do(func() {
+		// Closing a snapshot never fails
+		_ = s.snap.Close()
+	})
+}
@@ -0,0 +1,94 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/corpetty/avalanchego/database"
+)
+
+func newTestDB(t *testing.T, name string) *Database {
+	dir, err := ioutil.TempDir("", "pebble")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	db, err := New(filepath.Join(dir, name), 0, 0, 0)
+	if err != nil {
+		t.Fatalf("pebble.New(%s, 0, 0, 0) errored with %s", name, err)
+	}
+	return db
+}
+
+func TestInterface(t *testing.T) {
+	for i, test := range database.Tests {
+		db := newTestDB(t, fmt.Sprintf("db%d", i))
+		test(t, db)
+		_ = db.Close()
+	}
+}
+
+func TestSnapshotInterface(t *testing.T) {
+	for i, test := range database.SnapshotTests {
+		db := newTestDB(t, fmt.Sprintf("snapshotdb%d", i))
+		test(t, db)
+		_ = db.Close()
+	}
+}
+
+func TestStats(t *testing.T) {
+	db := newTestDB(t, "db")
+	defer db.Close()
+
+	if err := db.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Compact(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, stat := range stats {
+		valueStr, err := db.Stat(stat.property)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := strconv.ParseFloat(valueStr, 64); err != nil {
+			t.Fatalf("stat %s should be a number but is %q", stat.property, valueStr)
+		}
+	}
+	if compactions, err := db.Stat(CompactionsProperty); err != nil {
+		t.Fatal(err)
+	} else if compactions == "0" {
+		t.Fatalf("compaction wasn't counted")
+	}
+	if summary, err := db.Stat(MetricsProperty); err != nil {
+		t.Fatal(err)
+	} else if summary == "" {
+		t.Fatalf("expected a metrics summary")
+	}
+	if _, err := db.Stat("unknown"); err == nil {
+		t.Fatalf("should have failed to report an unknown property")
+	}
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(NewCollector("test", db)); err != nil {
+		t.Fatal(err)
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != len(stats) {
+		t.Fatalf("gathered %d metrics, expected %d", len(families), len(stats))
+	}
+}
@@ -0,0 +1,92 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pebble
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/corpetty/avalanchego/database"
+)
+
+// Numeric properties reported by Stat
+const (
+	CompactionsProperty        = "pebble.compactions"
+	CompactionDebtProperty     = "pebble.compaction-debt"
+	FlushesProperty            = "pebble.flushes"
+	ReadAmpProperty            = "pebble.read-amp"
+	L0FilesProperty            = "pebble.l0-files"
+	TablesSizeProperty         = "pebble.tables-size"
+	MemTablesSizeProperty      = "pebble.memtables-size"
+	WriteStallsProperty        = "pebble.write-stalls"
+	WriteStallDurationProperty = "pebble.write-stall-duration"
+)
+
+// stat is a numeric property reported by Stat and the metric it is exported as
+type stat struct {
+	property  string
+	name      string
+	help      string
+	valueType prometheus.ValueType
+}
+
+var stats = []stat{
+	{CompactionsProperty, "compactions", "Number of compactions", prometheus.CounterValue},
+	{CompactionDebtProperty, "compaction_debt_bytes", "Estimated number of bytes that need to be compacted for the database to reach a stable state", prometheus.GaugeValue},
+	{FlushesProperty, "flushes", "Number of memtable flushes", prometheus.CounterValue},
+	{ReadAmpProperty, "read_amplification", "Number of files and sublevels a read may have to check", prometheus.GaugeValue},
+	{L0FilesProperty, "l0_files", "Number of files in level 0", prometheus.GaugeValue},
+	{TablesSizeProperty, "tables_size_bytes", "Size of the database's tables", prometheus.GaugeValue},
+	{MemTablesSizeProperty, "memtables_size_bytes", "Size of the database's memtables", prometheus.GaugeValue},
+	{WriteStallsProperty, "write_stalls", "Number of times writes were stalled waiting for compactions or flushes", prometheus.CounterValue},
+	{WriteStallDurationProperty, "write_stall_duration_seconds", "Time writes have spent stalled waiting for compactions or flushes", prometheus.CounterValue},
+}
+
+// collector reports the numeric stats of a database as metrics
+type collector struct {
+	db    database.Stater
+	descs []*prometheus.Desc
+}
+
+// NewCollector returns a collector of the numeric stats [db] reports through
+// Stat, named under [namespace]
+func NewCollector(namespace string, db database.Stater) prometheus.Collector {
+	c := &collector{
+		db:    db,
+		descs: make([]*prometheus.Desc, len(stats)),
+	}
+	for i, stat := range stats {
+		c.descs[i] = prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "pebble", stat.name),
+			stat.help,
+			nil,
+			nil,
+		)
+	}
+	return c
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *collector) Describe(descs chan<- *prometheus.Desc) {
+	for _, desc := range c.descs {
+		descs <- desc
+	}
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *collector) Collect(metrics chan<- prometheus.Metric) {
+	for i, stat := range stats {
+		valueStr, err := c.db.Stat(stat.property)
+		if err != nil {
+			// The database is closed
+			return
+		}
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			continue
+		}
+		metrics <- prometheus.MustNewConstMetric(c.descs[i], stat.valueType, value)
+	}
+}
@@ -31,6 +31,13 @@ var (
 		TestMemorySafetyDatabase,
 		TestMemorySafetyBatch,
 	}
+
+	// SnapshotTests is a list of all tests of databases that implement
+	// Snapshotter
+	SnapshotTests = []func(t *testing.T, db Database){
+		TestSnapshot,
+		TestSnapshotClosed,
+	}
 )
 
 // TestSimpleKeyValue ...
@@ -855,3 +862,86 @@ func TestCompactNoPanic(t *testing.T, db Database) {
 		t.Fatalf("Expected error %s on db.Close but got %s", ErrClosed, err)
 	}
 }
+
+// TestSnapshot ...
+func TestSnapshot(t *testing.T, db Database) {
+	key1 := []byte("hello1")
+	value1 := []byte("world1")
+
+	key2 := []byte("hello2")
+	value2 := []byte("world2")
+
+	snapshotter, ok := db.(Snapshotter)
+	if !ok {
+		t.Fatalf("db doesn't implement Snapshotter")
+	}
+
+	if err := db.Put(key1, value1); err != nil {
+		t.Fatalf("Unexpected error on db.Put: %s", err)
+	}
+
+	snapshot, err := snapshotter.NewSnapshot()
+	if err != nil {
+		t.Fatalf("Unexpected error on db.NewSnapshot: %s", err)
+	}
+	defer snapshot.Release()
+
+	if err := db.Delete(key1); err != nil {
+		t.Fatalf("Unexpected error on db.Delete: %s", err)
+	}
+	if err := db.Put(key2, value2); err != nil {
+		t.Fatalf("Unexpected error on db.Put: %s", err)
+	}
+
+	if has, err := snapshot.Has(key1); err != nil {
+		t.Fatalf("Unexpected error on snapshot.Has: %s", err)
+	} else if !has {
+		t.Fatalf("snapshot.Has unexpectedly returned false on key %s", key1)
+	} else if v, err := snapshot.Get(key1); err != nil {
+		t.Fatalf("Unexpected error on snapshot.Get: %s", err)
+	} else if !bytes.Equal(value1, v) {
+		t.Fatalf("snapshot.Get: Returned: 0x%x ; Expected: 0x%x", v, value1)
+	}
+
+	if has, err := snapshot.Has(key2); err != nil {
+		t.Fatalf("Unexpected error on snapshot.Has: %s", err)
+	} else if has {
+		t.Fatalf("snapshot.Has unexpectedly returned true on key %s", key2)
+	} else if v, err := snapshot.Get(key2); err != ErrNotFound {
+		t.Fatalf("Expected %s on snapshot.Get for missing key %s. Returned 0x%x", ErrNotFound, key2, v)
+	}
+
+	iterator := snapshot.NewIteratorWithPrefix([]byte("hello"))
+	if iterator == nil {
+		t.Fatalf("snapshot.NewIteratorWithPrefix returned nil")
+	}
+	defer iterator.Release()
+
+	if !iterator.Next() {
+		t.Fatalf("iterator.Next Returned: %v ; Expected: %v", false, true)
+	} else if key := iterator.Key(); !bytes.Equal(key, key1) {
+		t.Fatalf("iterator.Key Returned: 0x%x ; Expected: 0x%x", key, key1)
+	} else if value := iterator.Value(); !bytes.Equal(value, value1) {
+		t.Fatalf("iterator.Value Returned: 0x%x ; Expected: 0x%x", value, value1)
+	} else if iterator.Next() {
+		t.Fatalf("iterator.Next Returned: %v ; Expected: %v", true, false)
+	} else if err := iterator.Error(); err != nil {
+		t.Fatalf("iterator.Error Returned: %s ; Expected: nil", err)
+	}
+}
+
+// TestSnapshotClosed ...
+func TestSnapshotClosed(t *testing.T, db Database) {
+	snapshotter, ok := db.(Snapshotter)
+	if !ok {
+		t.Fatalf("db doesn't implement Snapshotter")
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Unexpected error on db.Close: %s", err)
+	}
+
+	if _, err := snapshotter.NewSnapshot(); err != ErrClosed {
+		t.Fatalf("Expected %s on db.NewSnapshot", ErrClosed)
+	}
+}
@@ -124,25 +124,38 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 		return &nodb.Iterator{Err: database.ErrClosed}
 	}
 
-	startString := string(start)
-	prefixString := string(prefix)
-	keys := make([]string, 0, len(db.mem))
-	for key := range db.mem {
-		if strings.HasPrefix(key, prefixString) && key >= startString {
-			keys = append(keys, key)
-		}
+	return newIterator(db.mem, db.db, start, prefix)
+}
+
+// NewSnapshot implements the database.Snapshotter interface. The snapshot
+// includes the uncommitted changes of this database. Returns
+// database.ErrNotSupported if the underlying database doesn't support
+// snapshots.
+func (db *Database) NewSnapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.mem == nil {
+		return nil, database.ErrClosed
 	}
-	sort.Strings(keys) // Keys need to be in sorted order
-	values := make([]valueDelete, len(keys))
-	for i, key := range keys {
-		values[i] = db.mem[key]
+	snapshotter, ok := db.db.(database.Snapshotter)
+	if !ok {
+		return nil, database.ErrNotSupported
 	}
-
-	return &iterator{
-		Iterator: db.db.NewIteratorWithStartAndPrefix(start, prefix),
-		keys:     keys,
-		values:   values,
+	snap, err := snapshotter.NewSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	// Values in [db.mem] are replaced rather than modified, so a shallow copy
+	// is enough to isolate the snapshot from later writes
+	mem := make(map[string]valueDelete, len(db.mem))
+	for key, value := range db.mem {
+		mem[key] = value
 	}
+	return &snapshot{
+		mem:  mem,
+		snap: snap,
+	}, nil
 }
 
 // Stat implements the database.Database interface
@@ -346,6 +359,79 @@ func (b *batch) Replay(w database.KeyValueWriter) error {
 // Inner returns itself
 func (b *batch) Inner() database.Batch { return b }
 
+// snapshot is a read-only view of both the in memory database and the
+// underlying database at a point in time
+type snapshot struct {
+	mem  map[string]valueDelete
+	snap database.Snapshot
+}
+
+// Has implements the database.Snapshot interface
+func (s *snapshot) Has(key []byte) (bool, error) {
+	if val, has := s.mem[string(key)]; has {
+		return !val.delete, nil
+	}
+	return s.snap.Has(key)
+}
+
+// Get implements the database.Snapshot interface
+func (s *snapshot) Get(key []byte) ([]byte, error) {
+	if val, has := s.mem[string(key)]; has {
+		if val.delete {
+			return nil, database.ErrNotFound
+		}
+		return utils.CopyBytes(val.value), nil
+	}
+	return s.snap.Get(key)
+}
+
+// NewIterator implements the database.Snapshot interface
+func (s *snapshot) NewIterator() database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+// NewIteratorWithStart implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStart(start []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+// NewIteratorWithPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return s.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+// NewIteratorWithStartAndPrefix implements the database.Snapshot interface
+func (s *snapshot) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	return newIterator(s.mem, s.snap, start, prefix)
+}
+
+// Release implements the database.Snapshot interface
+func (s *snapshot) Release() { s.snap.Release() }
+
+// newIterator returns an iterator over the keys in [mem] merged with the keys
+// in [db] that are at least [start] and begin with [prefix]
+func newIterator(mem map[string]valueDelete, db database.Iteratee, start, prefix []byte) database.Iterator {
+	startString := string(start)
+	prefixString := string(prefix)
+	keys := make([]string, 0, len(mem))
+	for key := range mem {
+		if strings.HasPrefix(key, prefixString) && key >= startString {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys) // Keys need to be in sorted order
+	values := make([]valueDelete, len(keys))
+	for i, key := range keys {
+		values[i] = mem[key]
+	}
+
+	return &iterator{
+		Iterator: db.NewIteratorWithStartAndPrefix(start, prefix),
+		keys:     keys,
+		values:   values,
+	}
+}
+
 // iterator walks over both the in memory database and the underlying database
 // at the same time.
 type iterator struct {
@@ -18,6 +18,13 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestSnapshotInterface(t *testing.T) {
+	for _, test := range database.SnapshotTests {
+		baseDB := memdb.New()
+		test(t, New(baseDB))
+	}
+}
+
 func TestIterate(t *testing.T) {
 	baseDB := memdb.New()
 	db := New(baseDB)
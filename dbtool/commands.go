@@ -0,0 +1,180 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/prefixdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/hashing"
+)
+
+// Max number of bytes written to a batch before it is flushed during a copy
+const copyBatchSize = 4 * 1024 * 1024
+
+var (
+	// Prefixes the node creates on its database directly. Chains are prefixed
+	// by their ID, which isn't known offline unless given.
+	nodePrefixes = []string{
+		"indexer",
+		"shared memory",
+		"keystore",
+	}
+
+	// Prefixes the chain manager creates under each chain's prefix
+	chainPrefixes = []string{
+		"vm",
+		"vertex",
+		"vertex_bs",
+		"tx_bs",
+		"bs",
+	}
+)
+
+// namedPrefix is a prefix of keys in the node's database and a human readable
+// name for it
+type namedPrefix struct {
+	name   string
+	prefix []byte
+}
+
+// knownPrefixes returns the prefixes that prefixdb creates on the node's
+// database for the node itself and for the chains in [chainIDs]
+func knownPrefixes(chainIDs []ids.ID) []namedPrefix {
+	prefixes := []namedPrefix(nil)
+	for _, name := range nodePrefixes {
+		prefixes = append(prefixes, namedPrefix{
+			name:   name,
+			prefix: hashing.ComputeHash256([]byte(name)),
+		})
+	}
+	for _, chainID := range chainIDs {
+		chainPrefix := hashing.ComputeHash256(chainID[:])
+		prefixes = append(prefixes, namedPrefix{
+			name:   chainID.String(),
+			prefix: chainPrefix,
+		})
+		// prefixdb.New flattens nested prefixes by hashing the concatenation
+		// of the outer prefix and the inner one
+		for _, name := range chainPrefixes {
+			prefixes = append(prefixes, namedPrefix{
+				name:   fmt.Sprintf("%s/%s", chainID, name),
+				prefix: hashing.ComputeHash256(append(append([]byte(nil), chainPrefix...), name...)),
+			})
+		}
+	}
+	return prefixes
+}
+
+// prefixStats is the number of keys under a prefix and their total size
+type prefixStats struct {
+	prefix   []byte
+	numKeys  int
+	numBytes int
+}
+
+// prefixName returns the name of [prefix] if it is one of [known], and the hex
+// encoding of [prefix] otherwise
+func prefixName(prefix []byte, known []namedPrefix) string {
+	for _, named := range known {
+		if bytes.Equal(prefix, named.prefix) {
+			return named.name
+		}
+	}
+	return hex.EncodeToString(prefix)
+}
+
+// collectPrefixes groups the keys in [db] by the prefix prefixdb would have
+// added to them. Keys shorter than a prefix are grouped under an empty prefix.
+func collectPrefixes(db database.Database) ([]*prefixStats, error) {
+	iter := db.NewIterator()
+	defer iter.Release()
+
+	stats := []*prefixStats(nil)
+	var current *prefixStats
+	for iter.Next() {
+		key := iter.Key()
+		prefix := []byte(nil)
+		if len(key) >= hashing.HashLen {
+			prefix = key[:hashing.HashLen]
+		}
+		// Keys are iterated in order so keys with the same prefix are adjacent
+		if current == nil || !bytes.Equal(current.prefix, prefix) {
+			current = &prefixStats{prefix: append([]byte(nil), prefix...)}
+			stats = append(stats, current)
+		}
+		current.numKeys++
+		current.numBytes += len(key) + len(iter.Value())
+	}
+	return stats, iter.Error()
+}
+
+// listPrefixes writes the number of keys and bytes under each prefix in [db]
+func listPrefixes(db database.Database, known []namedPrefix, w io.Writer) error {
+	stats, err := collectPrefixes(db)
+	if err != nil {
+		return err
+	}
+	for _, s := range stats {
+		if _, err := fmt.Fprintf(w, "%s\t%d keys\t%d bytes\n", prefixName(s.prefix, known), s.numKeys, s.numBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nestedDB returns the database prefixdb.New would create by nesting each of
+// [prefixes] in order under [db]
+func nestedDB(db database.Database, prefixes ...[]byte) database.Database {
+	for _, prefix := range prefixes {
+		db = prefixdb.New(prefix, db)
+	}
+	return db
+}
+
+// dump writes each key/value pair in [db], hex encoded
+func dump(db database.Database, w io.Writer) error {
+	iter := db.NewIterator()
+	defer iter.Release()
+
+	for iter.Next() {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", hex.EncodeToString(iter.Key()), hex.EncodeToString(iter.Value())); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// copyDB writes every key/value pair in [src] to [dst] and returns the number
+// of pairs copied
+func copyDB(src, dst database.Database) (int, error) {
+	iter := src.NewIterator()
+	defer iter.Release()
+
+	numCopied := 0
+	batch := dst.NewBatch()
+	for iter.Next() {
+		if err := batch.Put(iter.Key(), iter.Value()); err != nil {
+			return numCopied, err
+		}
+		numCopied++
+
+		if batch.ValueSize() < copyBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return numCopied, err
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return numCopied, err
+	}
+	return numCopied, batch.Write()
+}
@@ -0,0 +1,145 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/backend"
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/database/prefixdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+)
+
+func TestListPrefixes(t *testing.T) {
+	db := memdb.New()
+	chainID := ids.GenerateTestID()
+
+	keystoreDB := prefixdb.New([]byte("keystore"), db)
+	if err := keystoreDB.Put([]byte{1}, []byte{2}); err != nil {
+		t.Fatal(err)
+	}
+	chainDB := nestedDB(db, chainID[:], []byte("vm"))
+	for _, key := range [][]byte{{1}, {2}, {3}} {
+		if err := chainDB.Put(key, []byte{4}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := listPrefixes(db, knownPrefixes([]ids.ID{chainID}), buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 prefixes but got %d: %q", len(lines), lines)
+	}
+	for _, expected := range []string{"keystore\t1 keys", chainID.String() + "/vm\t3 keys"} {
+		found := false
+		for _, line := range lines {
+			found = found || strings.HasPrefix(line, expected)
+		}
+		if !found {
+			t.Fatalf("expected a line starting with %q in %q", expected, lines)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	db := memdb.New()
+	chainID := ids.GenerateTestID()
+
+	if err := nestedDB(db, chainID[:], []byte("vm")).Put([]byte{0xab}, []byte{0xcd}); err != nil {
+		t.Fatal(err)
+	}
+	if err := nestedDB(db, chainID[:], []byte("bs")).Put([]byte{0x12}, []byte{0x34}); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := dump(nestedDB(db, chainID[:], []byte("vm")), buf); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); out != "ab\tcd\n" {
+		t.Fatalf("expected only the vm key to be dumped but got %q", out)
+	}
+}
+
+func TestCopyDB(t *testing.T) {
+	src := memdb.New()
+	dst := memdb.New()
+	for i := byte(0); i < 10; i++ {
+		if err := src.Put([]byte{i}, []byte{i + 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	numCopied, err := copyDB(src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if numCopied != 10 {
+		t.Fatalf("expected to copy 10 keys but copied %d", numCopied)
+	}
+	for i := byte(0); i < 10; i++ {
+		value, err := dst.Get([]byte{i})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(value, []byte{i + 1}) {
+			t.Fatalf("wrong value copied for key %d", i)
+		}
+	}
+}
+
+func TestCopyBetweenBackends(t *testing.T) {
+	dbDir, err := ioutil.TempDir("", "dbtool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dbDir)
+
+	src, err := openDB(dbDir, backend.LevelDB, constants.LocalName, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Put([]byte{1}, []byte{2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	err = run([]string{
+		"copy",
+		"--" + dbDirKey, dbDir,
+		"--" + dbTypeKey, backend.LevelDB,
+		"--" + networkNameKey, constants.LocalName,
+		"--" + dstDBDirKey, dbDir,
+		"--" + dstDBTypeKey, backend.PebbleDB,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := openDB(dbDir, backend.PebbleDB, constants.LocalName, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dst.Close()
+	if value, err := dst.Get([]byte{1}); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(value, []byte{2}) {
+		t.Fatalf("copied %v, expected %v", value, []byte{2})
+	}
+
+	if _, err := openDB(dbDir, backend.MemDB, constants.LocalName, false); err != errInMemoryDB {
+		t.Fatalf("expected %s but got %v", errInMemoryDB, err)
+	}
+}
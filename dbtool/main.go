@@ -0,0 +1,215 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// dbtool inspects and converts the database of a stopped node.
+//
+// Usage:
+//
+//	dbtool prefixes [--chain-ids=<id>,...]
+//	dbtool dump --chain-id=<id> [--sub-prefix=vm]
+//	dbtool copy --dst-db-dir=<dir> [--dst-db-type=pebbledb]
+//	dbtool compact [--start=<hex>] [--limit=<hex>]
+//
+// Every command also takes --db-dir, --db-type and --network-id, which locate
+// the database the same way the node does. --db-type and --dst-db-type take
+// the node's on-disk db-type values, so copy can move a node's database to
+// another backend.
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/backend"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+)
+
+const (
+	// Must match the version the node stores its database under
+	dbVersion = "v1.0.0"
+
+	dbDirKey       = "db-dir"
+	dbTypeKey      = "db-type"
+	networkNameKey = "network-id"
+	chainIDKey     = "chain-id"
+	chainIDsKey    = "chain-ids"
+	subPrefixKey   = "sub-prefix"
+	dstDBDirKey    = "dst-db-dir"
+	dstDBTypeKey   = "dst-db-type"
+	startKey       = "start"
+	limitKey       = "limit"
+)
+
+var (
+	defaultDBDir = filepath.Join(os.ExpandEnv("$HOME"), fmt.Sprintf(".%s", constants.AppName), "db")
+
+	errNoCommand  = errors.New("expected one of the commands {prefixes, dump, copy, compact}")
+	errNoChainID  = errors.New("--chain-id must be provided")
+	errNoDstDBDir = errors.New("--dst-db-dir must be provided")
+	errSameDB     = errors.New("can't copy a database onto itself")
+	errInMemoryDB = fmt.Errorf("%s databases aren't stored on disk", backend.MemDB)
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errNoCommand
+	}
+	command, args := args[0], args[1:]
+
+	fs := pflag.NewFlagSet(command, pflag.ContinueOnError)
+	dbDir := fs.String(dbDirKey, defaultDBDir, "Database directory of the node")
+	dbType := fs.String(dbTypeKey, backend.LevelDB, fmt.Sprintf("Database backend of the node. One of {%s}", strings.Join(backend.Types, ", ")))
+	networkName := fs.String(networkNameKey, constants.MainnetName, "Network ID the node was run with")
+
+	switch command {
+	case "prefixes":
+		chainIDStrs := fs.StringSlice(chainIDsKey, nil, "IDs of chains to name in the output")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		chainIDs := make([]ids.ID, len(*chainIDStrs))
+		for i, chainIDStr := range *chainIDStrs {
+			chainID, err := ids.FromString(chainIDStr)
+			if err != nil {
+				return fmt.Errorf("couldn't parse chain ID %q: %w", chainIDStr, err)
+			}
+			chainIDs[i] = chainID
+		}
+
+		db, err := openDB(*dbDir, *dbType, *networkName, true)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		return listPrefixes(db, knownPrefixes(chainIDs), os.Stdout)
+	case "dump":
+		chainIDStr := fs.String(chainIDKey, "", "ID of the chain to dump")
+		subPrefix := fs.String(subPrefixKey, "", "Only dump keys the chain stored under this prefix, such as vm")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if *chainIDStr == "" {
+			return errNoChainID
+		}
+		chainID, err := ids.FromString(*chainIDStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse chain ID %q: %w", *chainIDStr, err)
+		}
+		prefixes := [][]byte{chainID[:]}
+		if *subPrefix != "" {
+			prefixes = append(prefixes, []byte(*subPrefix))
+		}
+
+		db, err := openDB(*dbDir, *dbType, *networkName, true)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		return dump(nestedDB(db, prefixes...), os.Stdout)
+	case "copy":
+		dstDBDir := fs.String(dstDBDirKey, "", "Database directory to copy the database to")
+		dstDBType := fs.String(dstDBTypeKey, backend.LevelDB, fmt.Sprintf("Database backend to copy the database to. One of {%s}", strings.Join(backend.Types, ", ")))
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if *dstDBDir == "" {
+			return errNoDstDBDir
+		}
+		if filepath.Clean(*dstDBDir) == filepath.Clean(*dbDir) && *dstDBType == *dbType {
+			return errSameDB
+		}
+
+		src, err := openDB(*dbDir, *dbType, *networkName, true)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		dst, err := openDB(*dstDBDir, *dstDBType, *networkName, false)
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+
+		numCopied, err := copyDB(src, dst)
+		if err != nil {
+			return fmt.Errorf("copy failed after %d keys: %w", numCopied, err)
+		}
+		fmt.Printf("copied %d keys\n", numCopied)
+		return nil
+	case "compact":
+		startStr := fs.String(startKey, "", "Hex encoded key to start compacting at. Defaults to the first key")
+		limitStr := fs.String(limitKey, "", "Hex encoded key to stop compacting at. Defaults to after the last key")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		start, err := parseKey(*startStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse --%s: %w", startKey, err)
+		}
+		limit, err := parseKey(*limitStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse --%s: %w", limitKey, err)
+		}
+
+		db, err := openDB(*dbDir, *dbType, *networkName, true)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		return db.Compact(start, limit)
+	default:
+		return fmt.Errorf("unknown command %q: %w", command, errNoCommand)
+	}
+}
+
+// openDB opens the database the node would use given the same flags. If
+// [mustExist] is true, errors rather than creating a new database.
+func openDB(dbDir, dbType, networkName string, mustExist bool) (database.Database, error) {
+	if dbType == backend.MemDB {
+		return nil, errInMemoryDB
+	}
+	networkID, err := constants.NetworkID(networkName)
+	if err != nil {
+		return nil, err
+	}
+	networkDir := path.Join(os.ExpandEnv(dbDir), constants.NetworkName(networkID))
+	dbPath, err := backend.Path(networkDir, dbType, dbVersion)
+	if err != nil {
+		return nil, err
+	}
+	if mustExist {
+		if _, err := os.Stat(dbPath); err != nil {
+			return nil, fmt.Errorf("couldn't find db at %s: %w", dbPath, err)
+		}
+	}
+
+	db, err := backend.New(dbType, dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open db at %s: %w", dbPath, err)
+	}
+	return db, nil
+}
+
+// parseKey returns nil if [keyStr] is empty, so that compaction is unbounded
+func parseKey(keyStr string) ([]byte, error) {
+	if keyStr == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(keyStr)
+}
@@ -211,5 +211,6 @@ var (
 		EpochFirstTransition: time.Unix(1607626800, 0),
 		EpochDuration:        6 * time.Hour,
 		ApricotPhase0Time:    time.Date(2020, 12, 5, 5, 00, 0, 0, time.UTC),
+		ApricotPhase1Time:    time.Date(2021, 3, 26, 14, 00, 0, 0, time.UTC),
 	}
 )
@@ -175784,5 +175784,6 @@ var (
 		EpochFirstTransition: time.Unix(1607626800, 0),
 		EpochDuration:        6 * time.Hour,
 		ApricotPhase0Time:    time.Date(2020, 12, 8, 3, 00, 0, 0, time.UTC),
+		ApricotPhase1Time:    time.Date(2021, 3, 31, 14, 00, 0, 0, time.UTC),
 	}
 )
@@ -90,5 +90,6 @@ var (
 		EpochFirstTransition: time.Unix(1613848877, 0),
 		EpochDuration:        30 * time.Minute,
 		ApricotPhase0Time:    time.Date(2021, 2, 15, 5, 00, 0, 0, time.UTC),
+		ApricotPhase1Time:    time.Date(2021, 3, 24, 14, 00, 0, 0, time.UTC),
 	}
 )
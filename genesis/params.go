@@ -42,6 +42,8 @@ type Params struct {
 	EpochDuration time.Duration
 	// Time that Apricot phase 0 rules go into effect
 	ApricotPhase0Time time.Time
+	// Time that Apricot phase 1 rules go into effect
+	ApricotPhase1Time time.Time
 }
 
 // GetParams ...
@@ -6,6 +6,7 @@ require (
 	github.com/AppsFlyer/go-sundheit v0.3.1
 	github.com/Microsoft/go-winio v0.4.16
 	github.com/btcsuite/btcutil v1.0.2
+	github.com/cockroachdb/pebble v0.0.0-20210217155127-444296cfa2bb
 	github.com/davecgh/go-spew v1.1.1
 	github.com/decred/dcrd/dcrec/secp256k1/v3 v3.0.0
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
@@ -30,7 +31,7 @@ require (
 	github.com/rs/cors v1.7.0
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.7.1
-	github.com/stretchr/testify v1.5.1
+	github.com/stretchr/testify v1.6.1
 	github.com/syndtr/goleveldb v1.0.1-0.20200815110645-5c35d600f0ca
 	golang.org/x/crypto v0.0.0-20210220033148-5ea612d1eb83
 	golang.org/x/net v0.0.0-20210222171744-9060382bd457
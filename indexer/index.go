@@ -0,0 +1,232 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	indexapi "github.com/corpetty/avalanchego/api/index"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/prefixdb"
+	"github.com/corpetty/avalanchego/database/versiondb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/triggers"
+	"github.com/corpetty/avalanchego/utils/hashing"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/utils/timer"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+)
+
+const (
+	// MaxFetchedByRange is the maximum number of containers that can be
+	// fetched in a single call to GetContainerRange
+	MaxFetchedByRange = 1024
+)
+
+var (
+	// Maps to the byte representation of the index of the next accepted
+	// container
+	nextAcceptedIndexKey   = []byte("next")
+	indexToContainerPrefix = []byte("itc")
+	containerToIndexPrefix = []byte("cti")
+
+	errNoneAccepted   = errors.New("no containers have been accepted")
+	errNumToFetchZero = errors.New("numToFetch must be at least 1")
+
+	_ indexapi.Index    = &containerIndex{}
+	_ triggers.Acceptor = &containerIndex{}
+)
+
+// containerIndex indexes the containers accepted by a chain in the order they
+// were accepted in
+type containerIndex struct {
+	clock timer.Clock
+	log   logging.Logger
+
+	lock sync.RWMutex
+	// The index that will be assigned to the next accepted container
+	nextAcceptedIndex uint64
+	// When [vDB] is committed, the writes to [indexToContainer] and
+	// [containerToIndex] are atomically written to the underlying database
+	vDB              *versiondb.Database
+	indexToContainer database.Database
+	containerToIndex database.Database
+}
+
+// newContainerIndex returns a new index of accepted containers that is
+// persisted in [db]
+func newContainerIndex(db database.Database, log logging.Logger) (*containerIndex, error) {
+	vDB := versiondb.New(db)
+	i := &containerIndex{
+		log:              log,
+		vDB:              vDB,
+		indexToContainer: prefixdb.New(indexToContainerPrefix, vDB),
+		containerToIndex: prefixdb.New(containerToIndexPrefix, vDB),
+	}
+
+	nextAcceptedIndexBytes, err := vDB.Get(nextAcceptedIndexKey)
+	switch err {
+	case nil:
+		p := wrappers.Packer{Bytes: nextAcceptedIndexBytes}
+		i.nextAcceptedIndex = p.UnpackLong()
+		if p.Errored() {
+			return nil, fmt.Errorf("couldn't parse the next accepted index: %w", p.Err)
+		}
+	case database.ErrNotFound:
+		// Nothing has been accepted yet
+	default:
+		return nil, fmt.Errorf("couldn't get the next accepted index: %w", err)
+	}
+	return i, nil
+}
+
+// Accept implements the triggers.Acceptor interface
+func (i *containerIndex) Accept(ctx *snow.Context, containerID ids.ID, container []byte) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if has, err := i.containerToIndex.Has(containerID[:]); err != nil {
+		return err
+	} else if has {
+		i.log.Debug("not indexing %s as it has already been indexed", containerID)
+		return nil
+	}
+
+	p := wrappers.Packer{MaxSize: hashing.HashLen + wrappers.LongLen + wrappers.IntLen + len(container)}
+	p.PackFixedBytes(containerID[:])
+	p.PackLong(uint64(i.clock.Time().UnixNano()))
+	p.PackBytes(container)
+	if p.Errored() {
+		return fmt.Errorf("couldn't serialize container %s: %w", containerID, p.Err)
+	}
+
+	indexKey := packIndex(i.nextAcceptedIndex)
+	if err := i.indexToContainer.Put(indexKey, p.Bytes); err != nil {
+		return err
+	}
+	if err := i.containerToIndex.Put(containerID[:], indexKey); err != nil {
+		return err
+	}
+	if err := i.vDB.Put(nextAcceptedIndexKey, packIndex(i.nextAcceptedIndex+1)); err != nil {
+		return err
+	}
+	if err := i.vDB.Commit(); err != nil {
+		return err
+	}
+
+	i.nextAcceptedIndex++
+	return nil
+}
+
+// GetContainerByIndex implements the indexapi.Index interface
+func (i *containerIndex) GetContainerByIndex(index uint64) (indexapi.Container, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.getContainerByIndex(index)
+}
+
+// GetContainerRange implements the indexapi.Index interface
+func (i *containerIndex) GetContainerRange(startIndex, numToFetch uint64) ([]indexapi.Container, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	switch {
+	case numToFetch == 0:
+		return nil, errNumToFetchZero
+	case numToFetch > MaxFetchedByRange:
+		return nil, fmt.Errorf("numToFetch must be at most %d but is %d", MaxFetchedByRange, numToFetch)
+	case startIndex >= i.nextAcceptedIndex:
+		return nil, fmt.Errorf("start index %d is greater than the last accepted index %d", startIndex, i.nextAcceptedIndex)
+	}
+
+	endIndex := startIndex + numToFetch
+	if endIndex > i.nextAcceptedIndex || endIndex < startIndex {
+		endIndex = i.nextAcceptedIndex
+	}
+
+	containers := make([]indexapi.Container, 0, endIndex-startIndex)
+	for index := startIndex; index < endIndex; index++ {
+		container, err := i.getContainerByIndex(index)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+// GetLastAccepted implements the indexapi.Index interface
+func (i *containerIndex) GetLastAccepted() (indexapi.Container, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	if i.nextAcceptedIndex == 0 {
+		return indexapi.Container{}, errNoneAccepted
+	}
+	return i.getContainerByIndex(i.nextAcceptedIndex - 1)
+}
+
+// GetContainerByID implements the indexapi.Index interface
+func (i *containerIndex) GetContainerByID(containerID ids.ID) (indexapi.Container, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	indexBytes, err := i.containerToIndex.Get(containerID[:])
+	if err != nil {
+		return indexapi.Container{}, fmt.Errorf("couldn't get the index of %s: %w", containerID, err)
+	}
+	p := wrappers.Packer{Bytes: indexBytes}
+	index := p.UnpackLong()
+	if p.Errored() {
+		return indexapi.Container{}, fmt.Errorf("couldn't parse the index of %s: %w", containerID, p.Err)
+	}
+	return i.getContainerByIndex(index)
+}
+
+// Close the database this index is written to
+func (i *containerIndex) Close() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.vDB.Close()
+}
+
+// Assumes [i.lock] is held
+func (i *containerIndex) getContainerByIndex(index uint64) (indexapi.Container, error) {
+	if index >= i.nextAcceptedIndex {
+		return indexapi.Container{}, fmt.Errorf("no container has been accepted at index %d", index)
+	}
+
+	containerBytes, err := i.indexToContainer.Get(packIndex(index))
+	if err != nil {
+		return indexapi.Container{}, fmt.Errorf("couldn't get the container at index %d: %w", index, err)
+	}
+
+	p := wrappers.Packer{Bytes: containerBytes}
+	containerID, err := ids.ToID(p.UnpackFixedBytes(hashing.HashLen))
+	if err != nil {
+		return indexapi.Container{}, err
+	}
+	timestamp := p.UnpackLong()
+	container := p.UnpackBytes()
+	if p.Errored() {
+		return indexapi.Container{}, fmt.Errorf("couldn't parse the container at index %d: %w", index, p.Err)
+	}
+	return indexapi.Container{
+		ID:        containerID,
+		Bytes:     container,
+		Timestamp: int64(timestamp),
+		Index:     index,
+	}, nil
+}
+
+func packIndex(index uint64) []byte {
+	p := wrappers.Packer{Bytes: make([]byte, wrappers.LongLen)}
+	p.PackLong(index)
+	return p.Bytes
+}
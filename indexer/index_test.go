@@ -0,0 +1,136 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+func TestIndex(t *testing.T) {
+	db := memdb.New()
+	ctx := snow.DefaultContextTest()
+
+	index, err := newContainerIndex(db, logging.NoLog{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := index.GetLastAccepted(); err == nil {
+		t.Fatal("shouldn't have a last accepted container")
+	}
+
+	// Accept a few containers
+	containerIDs := []ids.ID{}
+	for i := 0; i < 5; i++ {
+		containerID := ids.GenerateTestID()
+		index.clock.Set(time.Unix(int64(i), 0))
+		if err := index.Accept(ctx, containerID, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+		containerIDs = append(containerIDs, containerID)
+	}
+
+	// Accepting a container twice shouldn't index it twice
+	if err := index.Accept(ctx, containerIDs[0], []byte{0}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the index to make sure it was persisted
+	index, err = newContainerIndex(db, logging.NoLog{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, containerID := range containerIDs {
+		container, err := index.GetContainerByIndex(uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case container.ID != containerID:
+			t.Fatalf("expected container %s at index %d but got %s", containerID, i, container.ID)
+		case !bytes.Equal(container.Bytes, []byte{byte(i)}):
+			t.Fatalf("wrong bytes for container at index %d", i)
+		case container.Timestamp != time.Unix(int64(i), 0).UnixNano():
+			t.Fatalf("wrong timestamp for container at index %d", i)
+		case container.Index != uint64(i):
+			t.Fatalf("expected index %d but got %d", i, container.Index)
+		}
+
+		container, err = index.GetContainerByID(containerID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if container.Index != uint64(i) {
+			t.Fatalf("expected index %d but got %d", i, container.Index)
+		}
+	}
+
+	if _, err := index.GetContainerByIndex(uint64(len(containerIDs))); err == nil {
+		t.Fatal("shouldn't have a container past the last accepted index")
+	}
+	if _, err := index.GetContainerByID(ids.GenerateTestID()); err == nil {
+		t.Fatal("shouldn't have an unknown container")
+	}
+
+	lastAccepted, err := index.GetLastAccepted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastAccepted.ID != containerIDs[len(containerIDs)-1] {
+		t.Fatalf("expected last accepted container %s but got %s", containerIDs[len(containerIDs)-1], lastAccepted.ID)
+	}
+}
+
+func TestIndexGetContainerRange(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	index, err := newContainerIndex(memdb.New(), logging.NoLog{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	containerIDs := []ids.ID{}
+	for i := 0; i < 5; i++ {
+		containerID := ids.GenerateTestID()
+		if err := index.Accept(ctx, containerID, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+		containerIDs = append(containerIDs, containerID)
+	}
+
+	containers, err := index.GetContainerRange(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 2 || containers[0].ID != containerIDs[1] || containers[1].ID != containerIDs[2] {
+		t.Fatal("returned the wrong containers")
+	}
+
+	// Fetching past the last accepted container returns the remaining
+	// containers
+	containers, err = index.GetContainerRange(3, MaxFetchedByRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 2 || containers[0].ID != containerIDs[3] || containers[1].ID != containerIDs[4] {
+		t.Fatal("returned the wrong containers")
+	}
+
+	if _, err := index.GetContainerRange(0, 0); err == nil {
+		t.Fatal("should have errored due to fetching no containers")
+	}
+	if _, err := index.GetContainerRange(0, MaxFetchedByRange+1); err == nil {
+		t.Fatal("should have errored due to fetching too many containers")
+	}
+	if _, err := index.GetContainerRange(5, 1); err == nil {
+		t.Fatal("should have errored due to starting past the last accepted container")
+	}
+}
@@ -0,0 +1,195 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	indexapi "github.com/corpetty/avalanchego/api/index"
+	"github.com/corpetty/avalanchego/chains"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/prefixdb"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
+	"github.com/corpetty/avalanchego/snow/triggers"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+)
+
+const (
+	indexerIdentifier = "indexer"
+
+	// Container types that are indexed
+	txType     = "tx"
+	vertexType = "vtx"
+	blockType  = "block"
+)
+
+var (
+	// Maps to whether the indexer was enabled the last time the node ran
+	indexEnabledKey = []byte("indexEnabled")
+
+	errIndexReenabled = errors.New("the indexer was disabled while this node accepted containers, so its index would be incomplete")
+	errIndexDisabled  = errors.New("the indexer was enabled the last time this node ran, so disabling it would leave its index incomplete")
+
+	_ chains.Registrant = &Indexer{}
+)
+
+// APIServer is the part of the API server that the indexer needs to serve the
+// index API of each chain
+type APIServer interface {
+	AddRoute(handler *common.HTTPHandler, lock *sync.RWMutex, base, endpoint string, loggingWriter io.Writer) error
+}
+
+// Config ...
+type Config struct {
+	// Database the indices are persisted in
+	DB                  database.Database
+	Log                 logging.Logger
+	HTTPLog             io.Writer
+	DecisionDispatcher  *triggers.EventDispatcher
+	ConsensusDispatcher *triggers.EventDispatcher
+	APIServer           APIServer
+}
+
+// Indexer indexes the containers accepted by every chain on this node.
+//
+// For chains run by the Avalanche consensus engine, the accepted transactions
+// and vertices are indexed separately. For chains run by the Snowman consensus
+// engine, the accepted blocks are indexed. The index of each chain is served at
+// /ext/index/[chain alias]/[container type].
+type Indexer struct {
+	Config
+
+	lock    sync.Mutex
+	closed  bool
+	indices []*containerIndex
+}
+
+// NewIndexer returns a new Indexer. It should be added as a registrant of the
+// chain manager so that it is notified of every chain that is created.
+func NewIndexer(config Config) *Indexer {
+	return &Indexer{Config: config}
+}
+
+// CheckHistory returns an error if running the node with the indexer
+// [enabled] would leave the index in [db] missing containers. That is the case
+// if the indexer was disabled at some point since the node's database was
+// created and is now enabled, or if it was enabled the last time the node ran
+// and is now disabled. [newDB] should be true if the node's database was
+// created by this run of the node. If [allowIncomplete], a warning is logged
+// instead of returning an error. The indexer's state is recorded so that the
+// next run can be checked.
+func CheckHistory(db database.Database, log logging.Logger, enabled, allowIncomplete, newDB bool) error {
+	// If the indexer has never run, nothing has been indexed unless the
+	// database was just created
+	previouslyEnabled := enabled && newDB
+	enabledBytes, err := db.Get(indexEnabledKey)
+	switch err {
+	case nil:
+		p := wrappers.Packer{Bytes: enabledBytes}
+		previouslyEnabled = p.UnpackBool()
+		if p.Err != nil {
+			return p.Err
+		}
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+
+	switch {
+	case enabled && !previouslyEnabled:
+		if !allowIncomplete {
+			return errIndexReenabled
+		}
+		log.Warn("the index is missing the containers accepted while the indexer was disabled")
+	case !enabled && previouslyEnabled:
+		if !allowIncomplete {
+			return errIndexDisabled
+		}
+		log.Warn("disabling the indexer. The containers accepted while it is disabled won't be indexed")
+	}
+
+	p := wrappers.Packer{MaxSize: wrappers.BoolLen}
+	p.PackBool(enabled)
+	if p.Err != nil {
+		return p.Err
+	}
+	return db.Put(indexEnabledKey, p.Bytes)
+}
+
+// RegisterChain implements the chains.Registrant interface
+func (i *Indexer) RegisterChain(name string, ctx *snow.Context, vm interface{}) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		return
+	}
+
+	var err error
+	switch vm.(type) {
+	case vertex.DAGVM:
+		err = i.registerIndex(name, ctx, txType, i.DecisionDispatcher)
+		if err == nil {
+			err = i.registerIndex(name, ctx, vertexType, i.ConsensusDispatcher)
+		}
+	case block.ChainVM:
+		err = i.registerIndex(name, ctx, blockType, i.ConsensusDispatcher)
+	default:
+		i.Log.Debug("not indexing chain %s as it has an unknown VM type %T", ctx.ChainID, vm)
+		return
+	}
+	if err != nil {
+		i.Log.Error("couldn't index chain %s: %s", ctx.ChainID, err)
+	}
+}
+
+// Assumes [i.lock] is held
+func (i *Indexer) registerIndex(
+	name string,
+	ctx *snow.Context,
+	containerType string,
+	dispatcher *triggers.EventDispatcher,
+) error {
+	chainDB := prefixdb.New(ctx.ChainID[:], i.DB)
+	index, err := newContainerIndex(prefixdb.New([]byte(containerType), chainDB), i.Log)
+	if err != nil {
+		return fmt.Errorf("couldn't create %s index: %w", containerType, err)
+	}
+
+	identifier := fmt.Sprintf("%s-%s", indexerIdentifier, containerType)
+	if err := dispatcher.RegisterChain(ctx.ChainID, identifier, index); err != nil {
+		_ = index.Close()
+		return fmt.Errorf("couldn't register %s index: %w", containerType, err)
+	}
+	i.indices = append(i.indices, index)
+
+	handler, err := indexapi.NewService(i.Log, index)
+	if err != nil {
+		return fmt.Errorf("couldn't create %s index API: %w", containerType, err)
+	}
+
+	i.Log.Info("indexing accepted %ss of chain %s", containerType, ctx.ChainID)
+	return i.APIServer.AddRoute(handler, &sync.RWMutex{}, "index/"+name, "/"+containerType, i.HTTPLog)
+}
+
+// Close the indices of every chain
+func (i *Indexer) Close() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.closed = true
+	errs := wrappers.Errs{}
+	for _, index := range i.indices {
+		errs.Add(index.Close())
+	}
+	i.indices = nil
+	return errs.Err
+}
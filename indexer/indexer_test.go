@@ -0,0 +1,51 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/utils/logging"
+)
+
+func TestCheckHistory(t *testing.T) {
+	type run struct {
+		enabled, allowIncomplete, newDB bool
+		expectedErr                     error
+	}
+	tests := map[string][]run{
+		"enabled from the start": {
+			{enabled: true, newDB: true},
+			{enabled: true},
+			{enabled: false, expectedErr: errIndexDisabled},
+			{enabled: false, allowIncomplete: true},
+			{enabled: false},
+			{enabled: true, expectedErr: errIndexReenabled},
+			{enabled: true, allowIncomplete: true},
+			{enabled: true},
+		},
+		"disabled from the start": {
+			{enabled: false, newDB: true},
+			{enabled: true, expectedErr: errIndexReenabled},
+			{enabled: false},
+		},
+		"enabled on an existing database": {
+			{enabled: true, expectedErr: errIndexReenabled},
+			{enabled: false},
+			{enabled: true, allowIncomplete: true},
+			{enabled: true},
+		},
+	}
+	for name, runs := range tests {
+		t.Run(name, func(t *testing.T) {
+			db := memdb.New()
+			for i, r := range runs {
+				if err := CheckHistory(db, logging.NoLog{}, r.enabled, r.allowIncomplete, r.newDB); err != r.expectedErr {
+					t.Fatalf("run %d: expected %v but got %v", i, r.expectedErr, err)
+				}
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ const (
 	signatureVerificationEnabledKey = "signature-verification-enabled"
 	dbEnabledKey                    = "db-enabled"
 	dbDirKey                        = "db-dir"
+	dbTypeKey                       = "db-type"
 	publicIPKey                     = "public-ip"
 	dynamicUpdateDurationKey        = "dynamic-update-duration"
 	dynamicPublicIPResolverKey      = "dynamic-public-ip"
@@ -53,11 +54,15 @@ const (
 	networkTimeoutIncreaseKey       = "network-timeout-increase"
 	networkTimeoutReductionKey      = "network-timeout-reduction"
 	sendQueueSizeKey                = "send-queue-size"
+	networkCompressionEnabledKey    = "network-compression-enabled"
 	benchlistFailThresholdKey       = "benchlist-fail-threshold"
 	benchlistPeerSummaryEnabledKey  = "benchlist-peer-summary-enabled"
 	benchlistDurationKey            = "benchlist-duration"
 	benchlistMinFailingDurationKey  = "benchlist-min-failing-duration"
 	pluginDirKey                    = "plugin-dir"
+	vmAliasesFileKey                = "vm-aliases-file"
+	chainConfigDirKey               = "chain-config-dir"
+	subnetConfigDirKey              = "subnet-config-dir"
 	logsDirKey                      = "log-dir"
 	logLevelKey                     = "log-level"
 	logDisplayLevelKey              = "log-display-level"
@@ -79,6 +84,10 @@ const (
 	metricsAPIEnabledKey            = "api-metrics-enabled"
 	healthAPIEnabledKey             = "api-health-enabled"
 	ipcAPIEnabledKey                = "api-ipcs-enabled"
+	eventsAPIEnabledKey             = "api-events-enabled"
+	indexEnabledKey                 = "index-enabled"
+	indexTransactionsKey            = "index-transactions"
+	indexAllowIncompleteKey         = "index-allow-incomplete"
 	xputServerPortKey               = "xput-server-port"
 	xputServerEnabledKey            = "xput-server-enabled"
 	ipcsChainIDsKey                 = "ipcs-chain-ids"
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path"
@@ -21,7 +22,7 @@ import (
 
 	"github.com/kardianos/osext"
 
-	"github.com/corpetty/avalanchego/database/leveldb"
+	"github.com/corpetty/avalanchego/database/backend"
 	"github.com/corpetty/avalanchego/database/memdb"
 	"github.com/corpetty/avalanchego/genesis"
 	"github.com/corpetty/avalanchego/ids"
@@ -54,6 +55,9 @@ var (
 	defaultDbDir           = filepath.Join(homeDir, prefixedAppName, "db")
 	defaultStakingKeyPath  = filepath.Join(homeDir, prefixedAppName, "staking", "staker.key")
 	defaultStakingCertPath = filepath.Join(homeDir, prefixedAppName, "staking", "staker.crt")
+	defaultVMAliasesFile   = filepath.Join(homeDir, prefixedAppName, "configs", "vms", "aliases.json")
+	defaultChainConfigDir  = filepath.Join(homeDir, prefixedAppName, "configs", "chains")
+	defaultSubnetConfigDir = filepath.Join(homeDir, prefixedAppName, "configs", "subnets")
 	defaultPluginDirs      = []string{
 		filepath.Join(".", "build", "plugins"),
 		filepath.Join(".", "plugins"),
@@ -130,6 +134,7 @@ func avalancheFlagSet() *flag.FlagSet {
 	// Database:
 	fs.Bool(dbEnabledKey, true, "Turn on persistent storage")
 	fs.String(dbDirKey, defaultString, "Database directory for Avalanche state")
+	fs.String(dbTypeKey, backend.LevelDB, fmt.Sprintf("Database backend to use if db-enabled is set. Must be one of {%s}", strings.Join(backend.Types, ", ")))
 
 	// IP:
 	fs.String(publicIPKey, "", "Public IP of this node for P2P communication. If empty, try to discover with NAT. Ignored if dynamic-public-ip is non-empty.")
@@ -185,6 +190,7 @@ func avalancheFlagSet() *flag.FlagSet {
 	fs.Duration(networkTimeoutIncreaseKey, 100*time.Millisecond, "Increase of network timeout after a failed request.")
 	fs.Duration(networkTimeoutReductionKey, 5*time.Millisecond, "Decrease of network timeout after a successful request.")
 	fs.Uint(sendQueueSizeKey, 4096, "Max number of messages waiting to be sent to peers.")
+	fs.Bool(networkCompressionEnabledKey, true, "If true, containers sent to peers that support compression are compressed.")
 
 	// Benchlist Parameters:
 	fs.Int(benchlistFailThresholdKey, 10, "Number of consecutive failed queries before benchlisting a node.")
@@ -194,6 +200,9 @@ func avalancheFlagSet() *flag.FlagSet {
 
 	// Plugins:
 	fs.String(pluginDirKey, defaultString, "Plugin directory for Avalanche VMs")
+	fs.String(vmAliasesFileKey, defaultVMAliasesFile, "JSON file mapping VM IDs to aliases. A plugin may be named after any of its VM's aliases")
+	fs.String(chainConfigDirKey, defaultChainConfigDir, "Chain config directory. The config of a chain is read from [chain-config-dir]/[chain ID or alias]/config.json and given to the chain's VM")
+	fs.String(subnetConfigDirKey, defaultSubnetConfigDir, "Subnet config directory. The config of a subnet is read from [subnet-config-dir]/[subnet ID].json. Its consensusParameters override the snow-* parameters for the subnet's chains")
 
 	// Logging:
 	fs.String(logsDirKey, "", "Logging directory for Avalanche")
@@ -219,6 +228,10 @@ func avalancheFlagSet() *flag.FlagSet {
 	fs.Bool(metricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(healthAPIEnabledKey, true, "If true, this node exposes the Health API")
 	fs.Bool(ipcAPIEnabledKey, false, "If true, IPCs can be opened")
+	fs.Bool(eventsAPIEnabledKey, false, "If true, the decisions of each chain are streamed over websockets at /ext/bc/[chainID]/events")
+	fs.Bool(indexEnabledKey, false, "If true, this node indexes the containers accepted by each chain and exposes the Index API")
+	fs.Bool(indexTransactionsKey, false, "If true, the X-Chain indexes accepted transactions by the addresses and assets they touched. Overridden by indexTransactions in the X-Chain's config")
+	fs.Bool(indexAllowIncompleteKey, false, "If true, the node starts even if enabling or disabling an index since the last run leaves the index missing data")
 
 	// Throughput Server
 	fs.Uint(xputServerPortKey, 9652, "Port of the deprecated throughput test server")
@@ -334,14 +347,20 @@ func setNodeConfig(v *viper.Viper) error {
 			dbDir = defaultDbDir
 		}
 		dbDir = os.ExpandEnv(dbDir) // parse any env variables
-		dbPath := path.Join(dbDir, constants.NetworkName(Config.NetworkID), dbVersion)
-		db, err := leveldb.New(dbPath, 0, 0, 0)
+		dbType := v.GetString(dbTypeKey)
+		dbPath, err := backend.Path(path.Join(dbDir, constants.NetworkName(Config.NetworkID)), dbType, dbVersion)
+		if err != nil {
+			return err
+		}
+		db, err := backend.New(dbType, dbPath)
 		if err != nil {
 			return fmt.Errorf("couldn't create db at %s: %w", dbPath, err)
 		}
 		Config.DB = db
+		Config.DBType = dbType
 	} else {
 		Config.DB = memdb.New()
+		Config.DBType = backend.MemDB
 	}
 
 	// IP Configuration
@@ -514,6 +533,13 @@ func setNodeConfig(v *viper.Viper) error {
 			}
 		}
 	}
+	vmAliases, err := readVMAliases(v.GetString(vmAliasesFileKey), v.IsSet(vmAliasesFileKey))
+	if err != nil {
+		return err
+	}
+	Config.VMAliases = vmAliases
+	Config.ChainConfigDir = os.ExpandEnv(v.GetString(chainConfigDirKey))
+	Config.SubnetConfigDir = os.ExpandEnv(v.GetString(subnetConfigDirKey))
 
 	// HTTP:
 	Config.HTTPHost = v.GetString(httpHostKey)
@@ -542,6 +568,10 @@ func setNodeConfig(v *viper.Viper) error {
 	Config.MetricsAPIEnabled = v.GetBool(metricsAPIEnabledKey)
 	Config.HealthAPIEnabled = v.GetBool(healthAPIEnabledKey)
 	Config.IPCAPIEnabled = v.GetBool(ipcAPIEnabledKey)
+	Config.EventsAPIEnabled = v.GetBool(eventsAPIEnabledKey)
+	Config.IndexEnabled = v.GetBool(indexEnabledKey)
+	Config.IndexTransactions = v.GetBool(indexTransactionsKey)
+	Config.IndexAllowIncomplete = v.GetBool(indexAllowIncompleteKey)
 
 	// Throughput:
 	Config.ThroughputServerEnabled = v.GetBool(xputServerEnabledKey)
@@ -568,6 +598,7 @@ func setNodeConfig(v *viper.Viper) error {
 	Config.StakerMSGPortion = v.GetFloat64(stakerMsgReservedKey)
 	Config.StakerCPUPortion = v.GetFloat64(stakerCPUReservedKey)
 	Config.SendQueueSize = v.GetUint32(sendQueueSizeKey)
+	Config.NetworkCompressionEnabled = v.GetBool(networkCompressionEnabledKey)
 	Config.MaxPendingMsgs = v.GetUint32(maxPendingMsgsKey)
 	if Config.MaxPendingMsgs < Config.MaxNonStakerPendingMsgs {
 		return errors.New("maximum pending messages must be >= maximum non-staker pending messages")
@@ -740,3 +771,30 @@ func parseViper() error {
 
 	return setNodeConfig(v)
 }
+
+// readVMAliases parses the VM aliases in the JSON file at [path], which maps
+// each VM ID to a list of aliases. If [mustExist] is false, a missing file is
+// treated as having no aliases.
+func readVMAliases(path string, mustExist bool) (map[ids.ID][]string, error) {
+	aliasesBytes, err := ioutil.ReadFile(os.ExpandEnv(path))
+	switch {
+	case os.IsNotExist(err) && !mustExist:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("couldn't read %s: %w", vmAliasesFileKey, err)
+	}
+
+	aliasMap := make(map[string][]string)
+	if err := json.Unmarshal(aliasesBytes, &aliasMap); err != nil {
+		return nil, fmt.Errorf("couldn't parse %s: %w", vmAliasesFileKey, err)
+	}
+	vmAliases := make(map[ids.ID][]string, len(aliasMap))
+	for vmIDStr, aliases := range aliasMap {
+		vmID, err := ids.FromString(vmIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse VM ID %q in %s: %w", vmIDStr, vmAliasesFileKey, err)
+		}
+		vmAliases[vmID] = aliases
+	}
+	return vmAliases, nil
+}
@@ -33,6 +33,16 @@ func (m Builder) PeerList(ipDescs []utils.IPDesc) (Msg, error) {
 	return m.Pack(PeerList, map[Field]interface{}{Peers: ipDescs})
 }
 
+// SignedPeerList message
+func (m Builder) SignedPeerList(claims []SignedIP) (Msg, error) {
+	return m.Pack(SignedPeerList, map[Field]interface{}{SignedPeers: claims})
+}
+
+// CompressionList message
+func (m Builder) CompressionList(compressions []Compression) (Msg, error) {
+	return m.Pack(CompressionList, map[Field]interface{}{Compressions: compressions})
+}
+
 // Ping message
 func (m Builder) Ping() (Msg, error) { return m.Pack(Ping, nil) }
 
@@ -164,3 +174,72 @@ func (m Builder) Chits(chainID ids.ID, requestID uint32, containerIDs []ids.ID)
 		ContainerIDs: containerIDBytes,
 	})
 }
+
+// AppRequest message
+func (m Builder) AppRequest(chainID ids.ID, requestID uint32, deadline uint64, msg []byte) (Msg, error) {
+	return m.Pack(AppRequest, map[Field]interface{}{
+		ChainID:   chainID[:],
+		RequestID: requestID,
+		Deadline:  deadline,
+		AppBytes:  msg,
+	})
+}
+
+// AppResponse message
+func (m Builder) AppResponse(chainID ids.ID, requestID uint32, msg []byte) (Msg, error) {
+	return m.Pack(AppResponse, map[Field]interface{}{
+		ChainID:   chainID[:],
+		RequestID: requestID,
+		AppBytes:  msg,
+	})
+}
+
+// AppGossip message
+func (m Builder) AppGossip(chainID ids.ID, msg []byte) (Msg, error) {
+	return m.Pack(AppGossip, map[Field]interface{}{
+		ChainID:  chainID[:],
+		AppBytes: msg,
+	})
+}
+
+// GetStateSummaryFrontier message
+func (m Builder) GetStateSummaryFrontier(chainID ids.ID, requestID uint32, deadline uint64) (Msg, error) {
+	return m.Pack(GetStateSummaryFrontier, map[Field]interface{}{
+		ChainID:   chainID[:],
+		RequestID: requestID,
+		Deadline:  deadline,
+	})
+}
+
+// StateSummaryFrontier message
+func (m Builder) StateSummaryFrontier(chainID ids.ID, requestID uint32, summary []byte) (Msg, error) {
+	return m.Pack(StateSummaryFrontier, map[Field]interface{}{
+		ChainID:      chainID[:],
+		RequestID:    requestID,
+		SummaryBytes: summary,
+	})
+}
+
+// GetAcceptedStateSummary message
+func (m Builder) GetAcceptedStateSummary(chainID ids.ID, requestID uint32, deadline uint64, heights []uint64) (Msg, error) {
+	return m.Pack(GetAcceptedStateSummary, map[Field]interface{}{
+		ChainID:        chainID[:],
+		RequestID:      requestID,
+		Deadline:       deadline,
+		SummaryHeights: heights,
+	})
+}
+
+// AcceptedStateSummary message
+func (m Builder) AcceptedStateSummary(chainID ids.ID, requestID uint32, summaryIDs []ids.ID) (Msg, error) {
+	summaryIDBytes := make([][]byte, len(summaryIDs))
+	for i, summaryID := range summaryIDs {
+		copy := summaryID
+		summaryIDBytes[i] = copy[:]
+	}
+	return m.Pack(AcceptedStateSummary, map[Field]interface{}{
+		ChainID:      chainID[:],
+		RequestID:    requestID,
+		ContainerIDs: summaryIDBytes,
+	})
+}
@@ -97,6 +97,22 @@ func TestBuildPeerList(t *testing.T) {
 	assert.Equal(t, ips, parsedMsg.Get(Peers))
 }
 
+func TestBuildCompressionList(t *testing.T) {
+	compressions := []Compression{GzipCompression, NoCompression}
+
+	msg, err := TestBuilder.CompressionList(compressions)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, CompressionList, msg.Op())
+	assert.Equal(t, compressions, msg.Get(Compressions))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, CompressionList, parsedMsg.Op())
+	assert.Equal(t, compressions, parsedMsg.Get(Compressions))
+}
+
 func TestBuildGetAcceptedFrontier(t *testing.T) {
 	chainID := ids.Empty.Prefix(0)
 	requestID := uint32(5)
@@ -316,3 +332,161 @@ func TestBuildChits(t *testing.T) {
 	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
 	assert.Equal(t, containerIDs, parsedMsg.Get(ContainerIDs))
 }
+
+func TestBuildAppRequest(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	deadline := uint64(15)
+	appRequestBytes := []byte{2}
+
+	msg, err := TestBuilder.AppRequest(chainID, requestID, deadline, appRequestBytes)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, AppRequest, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, deadline, msg.Get(Deadline))
+	assert.Equal(t, appRequestBytes, msg.Get(AppBytes))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, AppRequest, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, deadline, parsedMsg.Get(Deadline))
+	assert.Equal(t, appRequestBytes, parsedMsg.Get(AppBytes))
+}
+
+func TestBuildAppResponse(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	appResponseBytes := []byte{2}
+
+	msg, err := TestBuilder.AppResponse(chainID, requestID, appResponseBytes)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, AppResponse, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, appResponseBytes, msg.Get(AppBytes))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, AppResponse, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, appResponseBytes, parsedMsg.Get(AppBytes))
+}
+
+func TestBuildAppGossip(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	appGossipBytes := []byte{2}
+
+	msg, err := TestBuilder.AppGossip(chainID, appGossipBytes)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, AppGossip, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, appGossipBytes, msg.Get(AppBytes))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, AppGossip, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, appGossipBytes, parsedMsg.Get(AppBytes))
+}
+
+func TestBuildGetStateSummaryFrontier(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	deadline := uint64(15)
+
+	msg, err := TestBuilder.GetStateSummaryFrontier(chainID, requestID, deadline)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, GetStateSummaryFrontier, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, deadline, msg.Get(Deadline))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, GetStateSummaryFrontier, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, deadline, parsedMsg.Get(Deadline))
+}
+
+func TestBuildStateSummaryFrontier(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	summary := []byte{2}
+
+	msg, err := TestBuilder.StateSummaryFrontier(chainID, requestID, summary)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, StateSummaryFrontier, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, summary, msg.Get(SummaryBytes))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, StateSummaryFrontier, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, summary, parsedMsg.Get(SummaryBytes))
+}
+
+func TestBuildGetAcceptedStateSummary(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	deadline := uint64(15)
+	heights := []uint64{1, 1000}
+
+	msg, err := TestBuilder.GetAcceptedStateSummary(chainID, requestID, deadline, heights)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, GetAcceptedStateSummary, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, deadline, msg.Get(Deadline))
+	assert.Equal(t, heights, msg.Get(SummaryHeights))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, GetAcceptedStateSummary, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, deadline, parsedMsg.Get(Deadline))
+	assert.Equal(t, heights, parsedMsg.Get(SummaryHeights))
+}
+
+func TestBuildAcceptedStateSummary(t *testing.T) {
+	chainID := ids.Empty.Prefix(0)
+	requestID := uint32(5)
+	summaryID := ids.Empty.Prefix(1)
+	summaryIDs := [][]byte{summaryID[:]}
+
+	msg, err := TestBuilder.AcceptedStateSummary(chainID, requestID, []ids.ID{summaryID})
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, AcceptedStateSummary, msg.Op())
+	assert.Equal(t, chainID[:], msg.Get(ChainID))
+	assert.Equal(t, requestID, msg.Get(RequestID))
+	assert.Equal(t, summaryIDs, msg.Get(ContainerIDs))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, AcceptedStateSummary, parsedMsg.Op())
+	assert.Equal(t, chainID[:], parsedMsg.Get(ChainID))
+	assert.Equal(t, requestID, parsedMsg.Get(RequestID))
+	assert.Equal(t, summaryIDs, parsedMsg.Get(ContainerIDs))
+}
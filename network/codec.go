@@ -4,16 +4,29 @@
 package network
 
 import (
+	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math"
 
 	"github.com/corpetty/avalanchego/utils/wrappers"
 )
 
+const (
+	// If this bit is set in the first byte of a message, the byte that follows
+	// is the Compression that was used to compress the message's fields
+	compressedOpMask byte = 0x80
+)
+
 var (
-	errMissingField = errors.New("message missing field")
-	errBadOp        = errors.New("input field has invalid operation")
+	errMissingField        = errors.New("message missing field")
+	errBadOp               = errors.New("input field has invalid operation")
+	errNotCompressible     = errors.New("message can't be compressed")
+	errUnknownCompression  = errors.New("unknown compression algorithm")
+	errDecompressedTooLong = errors.New("decompressed message is too long")
 )
 
 // Codec defines the serialization and deserialization of network messages
@@ -44,9 +57,125 @@ func (Codec) Pack(op Op, fields map[Field]interface{}) (Msg, error) {
 	}, p.Err
 }
 
+// supportedCompressions are the Compressions this node can compress and
+// decompress messages with, in order of preference
+var supportedCompressions = []Compression{GzipCompression}
+
+// Compress returns [m] with its fields compressed using [compression]. The
+// first byte of the compressed message is the opcode of the message with the
+// compressedOpMask bit set, and the second byte is [compression].
+//
+// Only messages whose opcode is Compressible may be compressed, and they must
+// only be sent to peers that announced support for [compression]. Compressing
+// the same message with the same algorithm multiple times only compresses its
+// fields once.
+func (Codec) Compress(m Msg, compression Compression) (Msg, error) {
+	if !m.Op().Compressible() {
+		return nil, errNotCompressible
+	}
+	uncompressed, ok := m.(*msg)
+	if !ok || uncompressed.compressed {
+		return nil, errNotCompressible
+	}
+
+	uncompressed.compressLock.Lock()
+	defer uncompressed.compressLock.Unlock()
+
+	if result, ok := uncompressed.compressedMsgs[compression]; ok {
+		return result.msg, result.err
+	}
+	compressed, err := compress(uncompressed, compression)
+	if uncompressed.compressedMsgs == nil {
+		uncompressed.compressedMsgs = make(map[Compression]compressResult, 1)
+	}
+	uncompressed.compressedMsgs[compression] = compressResult{
+		msg: compressed,
+		err: err,
+	}
+	return compressed, err
+}
+
+func compress(m *msg, compression Compression) (*msg, error) {
+	buf := bytes.Buffer{}
+	buf.WriteByte(byte(m.op) | compressedOpMask)
+	buf.WriteByte(byte(compression))
+
+	var w io.WriteCloser
+	switch compression {
+	case GzipCompression:
+		w = gzip.NewWriter(&buf)
+	default:
+		return nil, errUnknownCompression
+	}
+	if _, err := w.Write(m.bytes[1:]); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &msg{
+		op:              m.op,
+		fields:          m.fields,
+		bytes:           buf.Bytes(),
+		compressed:      true,
+		uncompressedLen: len(m.bytes),
+	}, nil
+}
+
 // Parse attempts to convert bytes into a message.
 // The first byte of the message is the opcode of the message.
 func (Codec) Parse(b []byte) (Msg, error) {
+	if len(b) == 0 || b[0]&compressedOpMask == 0 {
+		return parse(b, b)
+	}
+	uncompressed, err := decompress(b)
+	if err != nil {
+		return nil, err
+	}
+	return parse(uncompressed, b)
+}
+
+// decompress returns the uncompressed form of the compressed message [b]
+func decompress(b []byte) ([]byte, error) {
+	if len(b) < 2 {
+		return nil, errBadOp
+	}
+	op := Op(b[0] &^ compressedOpMask)
+	if !op.Compressible() {
+		return nil, errBadOp
+	}
+
+	var r io.Reader
+	switch Compression(b[1]) {
+	case GzipCompression:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(b[2:]))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't decompress %s message: %w", op, err)
+		}
+		r = gzipReader
+	default:
+		return nil, errUnknownCompression
+	}
+
+	// The opcode and the fields of a message must fit in the max message size,
+	// so reading that many bytes of fields detects messages that are too long
+	fields, err := ioutil.ReadAll(io.LimitReader(r, int64(DefaultMaxMessageSize)))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decompress %s message: %w", op, err)
+	}
+	if len(fields) >= int(DefaultMaxMessageSize) {
+		return nil, errDecompressedTooLong
+	}
+
+	uncompressed := make([]byte, len(fields)+1)
+	uncompressed[0] = byte(op)
+	copy(uncompressed[1:], fields)
+	return uncompressed, nil
+}
+
+// parse the uncompressed message [b] that was sent over the wire as [wireBytes]
+func parse(b []byte, wireBytes []byte) (Msg, error) {
 	p := wrappers.Packer{Bytes: b}
 	op := Op(p.UnpackByte())
 	message, ok := Messages[op]
@@ -62,10 +191,42 @@ func (Codec) Parse(b []byte) (Msg, error) {
 	if p.Offset != len(b) {
 		p.Add(fmt.Errorf("expected length %d got %d", len(b), p.Offset))
 	}
+	if p.Errored() {
+		return nil, p.Err
+	}
 
-	return &msg{
+	m := &msg{
 		op:     op,
 		fields: fields,
-		bytes:  b,
-	}, p.Err
+		bytes:  wireBytes,
+	}
+	if wireBytes[0]&compressedOpMask != 0 {
+		m.compressed = true
+		m.uncompressedLen = len(b)
+	}
+	return m, nil
+}
+
+// packCompressions attempts to pack the value as a list of Compressions
+func packCompressions(p *wrappers.Packer, valIntf interface{}) {
+	compressions, ok := valIntf.([]Compression)
+	if !ok {
+		p.Add(errBadType)
+		return
+	}
+	compressionBytes := make([]byte, len(compressions))
+	for i, compression := range compressions {
+		compressionBytes[i] = byte(compression)
+	}
+	p.PackBytes(compressionBytes)
+}
+
+// unpackCompressions attempts to unpack the value as a list of Compressions
+func unpackCompressions(p *wrappers.Packer) interface{} {
+	compressionBytes := p.UnpackBytes()
+	compressions := make([]Compression, len(compressionBytes))
+	for i, compressionByte := range compressionBytes {
+		compressions[i] = Compression(compressionByte)
+	}
+	return compressions
 }
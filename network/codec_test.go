@@ -4,10 +4,14 @@
 package network
 
 import (
+	"bytes"
+	"compress/gzip"
 	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/corpetty/avalanchego/ids"
 )
 
 var (
@@ -33,3 +37,80 @@ func TestCodecParseExtraSpace(t *testing.T) {
 	_, err := TestCodec.Parse([]byte{byte(GetVersion), 0x00})
 	assert.Error(t, err)
 }
+
+func TestCodecCompress(t *testing.T) {
+	chainID := ids.GenerateTestID()
+	containerID := ids.GenerateTestID()
+	container := bytes.Repeat([]byte{1, 2, 3, 4}, 1024)
+	m, err := TestCodec.Pack(Put, map[Field]interface{}{
+		ChainID:        chainID[:],
+		RequestID:      uint32(1),
+		ContainerID:    containerID[:],
+		ContainerBytes: container,
+	})
+	assert.NoError(t, err)
+
+	compressed, err := TestCodec.Compress(m, GzipCompression)
+	assert.NoError(t, err)
+	assert.Equal(t, Put, compressed.Op())
+	assert.Less(t, len(compressed.Bytes()), len(m.Bytes()))
+	assert.Equal(t, byte(Put)|compressedOpMask, compressed.Bytes()[0])
+	assert.Equal(t, byte(GzipCompression), compressed.Bytes()[1])
+
+	// Compressing the message again should return the same message
+	compressedAgain, err := TestCodec.Compress(m, GzipCompression)
+	assert.NoError(t, err)
+	assert.Equal(t, compressed, compressedAgain)
+
+	// A compressed message can't be compressed again
+	_, err = TestCodec.Compress(compressed, GzipCompression)
+	assert.Error(t, err)
+
+	// Unknown compression algorithm
+	_, err = TestCodec.Compress(m, math.MaxUint8)
+	assert.Equal(t, errUnknownCompression, err)
+
+	parsed, err := TestCodec.Parse(compressed.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, Put, parsed.Op())
+	assert.Equal(t, chainID[:], parsed.Get(ChainID))
+	assert.Equal(t, uint32(1), parsed.Get(RequestID))
+	assert.Equal(t, containerID[:], parsed.Get(ContainerID))
+	assert.Equal(t, container, parsed.Get(ContainerBytes))
+	assert.Equal(t, compressed.Bytes(), parsed.Bytes())
+}
+
+func TestCodecCompressNotCompressible(t *testing.T) {
+	m, err := TestCodec.Pack(Ping, nil)
+	assert.NoError(t, err)
+
+	_, err = TestCodec.Compress(m, GzipCompression)
+	assert.Error(t, err)
+}
+
+func TestCodecParseCompressedInvalid(t *testing.T) {
+	// Only ops that are compressible may be compressed
+	_, err := TestCodec.Parse([]byte{byte(Get) | compressedOpMask, byte(GzipCompression)})
+	assert.Error(t, err)
+
+	// Unknown compression algorithm
+	_, err = TestCodec.Parse([]byte{byte(Put) | compressedOpMask, math.MaxUint8})
+	assert.Error(t, err)
+
+	// Invalid gzip payload
+	_, err = TestCodec.Parse([]byte{byte(Put) | compressedOpMask, byte(GzipCompression), 1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestCodecParseCompressedTooLong(t *testing.T) {
+	buf := bytes.Buffer{}
+	buf.WriteByte(byte(Put) | compressedOpMask)
+	buf.WriteByte(byte(GzipCompression))
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(make([]byte, DefaultMaxMessageSize))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	_, err = TestCodec.Parse(buf.Bytes())
+	assert.Equal(t, errDecompressedTooLong, err)
+}
@@ -25,6 +25,11 @@ const (
 	ContainerBytes                   // Used for gossiping
 	ContainerIDs                     // Used for querying
 	MultiContainerBytes              // Used in MultiPut
+	AppBytes                         // Used in app messages
+	SummaryBytes                     // Used in state sync
+	SummaryHeights                   // Used in state sync
+	SignedPeers                      // Used in handshake
+	Compressions                     // Used in handshake
 )
 
 // Packer returns the packer function that can be used to pack this field.
@@ -56,6 +61,16 @@ func (f Field) Packer() func(*wrappers.Packer, interface{}) {
 		return wrappers.TryPackHashes
 	case MultiContainerBytes:
 		return wrappers.TryPack2DBytes
+	case AppBytes:
+		return wrappers.TryPackBytes
+	case SummaryBytes:
+		return wrappers.TryPackBytes
+	case SummaryHeights:
+		return wrappers.TryPackLongs
+	case SignedPeers:
+		return packSignedIPs
+	case Compressions:
+		return packCompressions
 	default:
 		return nil
 	}
@@ -90,6 +105,16 @@ func (f Field) Unpacker() func(*wrappers.Packer) interface{} {
 		return wrappers.TryUnpackHashes
 	case MultiContainerBytes:
 		return wrappers.TryUnpack2DBytes
+	case AppBytes:
+		return wrappers.TryUnpackBytes
+	case SummaryBytes:
+		return wrappers.TryUnpackBytes
+	case SummaryHeights:
+		return wrappers.TryUnpackLongs
+	case SignedPeers:
+		return unpackSignedIPs
+	case Compressions:
+		return unpackCompressions
 	default:
 		return nil
 	}
@@ -123,6 +148,16 @@ func (f Field) String() string {
 		return "Container IDs"
 	case MultiContainerBytes:
 		return "MultiContainerBytes"
+	case AppBytes:
+		return "AppBytes"
+	case SummaryBytes:
+		return "SummaryBytes"
+	case SummaryHeights:
+		return "SummaryHeights"
+	case SignedPeers:
+		return "SignedPeers"
+	case Compressions:
+		return "Compressions"
 	default:
 		return "Unknown Field"
 	}
@@ -131,6 +166,17 @@ func (f Field) String() string {
 // Op is an opcode
 type Op byte
 
+// Compressible returns true if messages with this opcode carry containers and
+// may be compressed when sent to a peer that supports compression.
+func (op Op) Compressible() bool {
+	switch op {
+	case Put, MultiPut, PushQuery:
+		return true
+	default:
+		return false
+	}
+}
+
 func (op Op) String() string {
 	switch op {
 	case GetVersion:
@@ -167,6 +213,24 @@ func (op Op) String() string {
 		return "pull_query"
 	case Chits:
 		return "chits"
+	case AppRequest:
+		return "app_request"
+	case AppResponse:
+		return "app_response"
+	case AppGossip:
+		return "app_gossip"
+	case GetStateSummaryFrontier:
+		return "get_state_summary_frontier"
+	case StateSummaryFrontier:
+		return "state_summary_frontier"
+	case GetAcceptedStateSummary:
+		return "get_accepted_state_summary"
+	case AcceptedStateSummary:
+		return "accepted_state_summary"
+	case SignedPeerList:
+		return "signed_peerlist"
+	case CompressionList:
+		return "compression_list"
 	default:
 		return "Unknown Op"
 	}
@@ -194,8 +258,41 @@ const (
 	PushQuery
 	PullQuery
 	Chits
+	// Application level:
+	AppRequest
+	AppResponse
+	AppGossip
+	// State sync:
+	GetStateSummaryFrontier
+	StateSummaryFrontier
+	GetAcceptedStateSummary
+	AcceptedStateSummary
+	// Handshake:
+	SignedPeerList
+	CompressionList
 )
 
+// Compression is an algorithm that the fields of a message may be compressed
+// with
+type Compression byte
+
+// Compression algorithms that may be used. These values are sent over the wire.
+const (
+	NoCompression Compression = iota
+	GzipCompression
+)
+
+func (c Compression) String() string {
+	switch c {
+	case NoCompression:
+		return "none"
+	case GzipCompression:
+		return "gzip"
+	default:
+		return "Unknown Compression"
+	}
+}
+
 // Defines the messages that can be sent/received with this network
 var (
 	Messages = map[Op][]Field{
@@ -219,5 +316,17 @@ var (
 		PushQuery: {ChainID, RequestID, Deadline, ContainerID, ContainerBytes},
 		PullQuery: {ChainID, RequestID, Deadline, ContainerID},
 		Chits:     {ChainID, RequestID, ContainerIDs},
+		// Application level:
+		AppRequest:  {ChainID, RequestID, Deadline, AppBytes},
+		AppResponse: {ChainID, RequestID, AppBytes},
+		AppGossip:   {ChainID, AppBytes},
+		// State sync:
+		GetStateSummaryFrontier: {ChainID, RequestID, Deadline},
+		StateSummaryFrontier:    {ChainID, RequestID, SummaryBytes},
+		GetAcceptedStateSummary: {ChainID, RequestID, Deadline, SummaryHeights},
+		AcceptedStateSummary:    {ChainID, RequestID, ContainerIDs},
+		// Handshake:
+		SignedPeerList:  {SignedPeers},
+		CompressionList: {Compressions},
 	}
 )
@@ -48,16 +48,62 @@ func (mm *messageMetrics) initialize(msgType Op, registerer prometheus.Registere
 	return nil
 }
 
+type compressionMetrics struct {
+	numCompressedSent, numCompressedReceived prometheus.Counter
+	bytesSavedSent, bytesSavedReceived       prometheus.Counter
+}
+
+func (cm *compressionMetrics) initialize(registerer prometheus.Registerer) error {
+	cm.numCompressedSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.PlatformName,
+		Name:      "compressed_sent",
+		Help:      "Number of compressed messages sent",
+	})
+	cm.numCompressedReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.PlatformName,
+		Name:      "compressed_received",
+		Help:      "Number of compressed messages received",
+	})
+	cm.bytesSavedSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.PlatformName,
+		Name:      "compression_bytes_saved_sent",
+		Help:      "Number of bytes saved by compressing sent messages",
+	})
+	cm.bytesSavedReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: constants.PlatformName,
+		Name:      "compression_bytes_saved_received",
+		Help:      "Number of bytes saved by peers compressing received messages",
+	})
+
+	errs := wrappers.Errs{}
+	for _, counter := range []prometheus.Counter{
+		cm.numCompressedSent,
+		cm.numCompressedReceived,
+		cm.bytesSavedSent,
+		cm.bytesSavedReceived,
+	} {
+		if err := registerer.Register(counter); err != nil {
+			errs.Add(fmt.Errorf("failed to register compression statistics due to %s", err))
+		}
+	}
+	return errs.Err
+}
+
 type metrics struct {
 	numPeers prometheus.Gauge
 
+	compression compressionMetrics
+
 	getVersion, version,
-	getPeerlist, peerlist,
+	getPeerlist, peerlist, signedPeerlist, compressionList,
 	ping, pong,
 	getAcceptedFrontier, acceptedFrontier,
 	getAccepted, accepted,
 	get, getAncestors, put, multiPut,
-	pushQuery, pullQuery, chits messageMetrics
+	pushQuery, pullQuery, chits,
+	appRequest, appResponse, appGossip,
+	getStateSummaryFrontier, stateSummaryFrontier,
+	getAcceptedStateSummary, acceptedStateSummary messageMetrics
 }
 
 func (m *metrics) initialize(registerer prometheus.Registerer) error {
@@ -73,10 +119,13 @@ func (m *metrics) initialize(registerer prometheus.Registerer) error {
 			err))
 	}
 	errs.Add(
+		m.compression.initialize(registerer),
 		m.getVersion.initialize(GetVersion, registerer),
 		m.version.initialize(Version, registerer),
 		m.getPeerlist.initialize(GetPeerList, registerer),
 		m.peerlist.initialize(PeerList, registerer),
+		m.signedPeerlist.initialize(SignedPeerList, registerer),
+		m.compressionList.initialize(CompressionList, registerer),
 		m.ping.initialize(Ping, registerer),
 		m.pong.initialize(Pong, registerer),
 		m.getAcceptedFrontier.initialize(GetAcceptedFrontier, registerer),
@@ -90,6 +139,13 @@ func (m *metrics) initialize(registerer prometheus.Registerer) error {
 		m.pushQuery.initialize(PushQuery, registerer),
 		m.pullQuery.initialize(PullQuery, registerer),
 		m.chits.initialize(Chits, registerer),
+		m.appRequest.initialize(AppRequest, registerer),
+		m.appResponse.initialize(AppResponse, registerer),
+		m.appGossip.initialize(AppGossip, registerer),
+		m.getStateSummaryFrontier.initialize(GetStateSummaryFrontier, registerer),
+		m.stateSummaryFrontier.initialize(StateSummaryFrontier, registerer),
+		m.getAcceptedStateSummary.initialize(GetAcceptedStateSummary, registerer),
+		m.acceptedStateSummary.initialize(AcceptedStateSummary, registerer),
 	)
 	return errs.Err
 }
@@ -104,6 +160,10 @@ func (m *metrics) message(msgType Op) *messageMetrics {
 		return &m.getPeerlist
 	case PeerList:
 		return &m.peerlist
+	case SignedPeerList:
+		return &m.signedPeerlist
+	case CompressionList:
+		return &m.compressionList
 	case Ping:
 		return &m.ping
 	case Pong:
@@ -130,6 +190,20 @@ func (m *metrics) message(msgType Op) *messageMetrics {
 		return &m.pullQuery
 	case Chits:
 		return &m.chits
+	case AppRequest:
+		return &m.appRequest
+	case AppResponse:
+		return &m.appResponse
+	case AppGossip:
+		return &m.appGossip
+	case GetStateSummaryFrontier:
+		return &m.getStateSummaryFrontier
+	case StateSummaryFrontier:
+		return &m.stateSummaryFrontier
+	case GetAcceptedStateSummary:
+		return &m.getAcceptedStateSummary
+	case AcceptedStateSummary:
+		return &m.acceptedStateSummary
 	default:
 		return nil
 	}
@@ -3,6 +3,10 @@
 
 package network
 
+import (
+	"sync"
+)
+
 // Msg represents a set of fields that can be serialized into a byte stream
 type Msg interface {
 	Op() Op
@@ -14,6 +18,23 @@ type msg struct {
 	op     Op
 	fields map[Field]interface{}
 	bytes  []byte
+
+	// true if [bytes] contains the compressed fields of this message
+	compressed bool
+	// length of this message before it was compressed. Only set if
+	// [compressed] is true.
+	uncompressedLen int
+
+	// the compressed forms of this message, built at most once per
+	// Compression
+	compressLock   sync.Mutex
+	compressedMsgs map[Compression]compressResult
+}
+
+// compressResult is the outcome of compressing a message
+type compressResult struct {
+	msg *msg
+	err error
 }
 
 // Field returns the value of the specified field in this message
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -54,8 +55,24 @@ const (
 var (
 	errNetworkClosed = errors.New("network closed")
 	errPeerIsMyself  = errors.New("peer is myself")
+	errNotValidator  = errors.New("signed IP wasn't made by a validator")
+	errFutureClaim   = errors.New("signed IP has a timestamp too far in the future")
 
 	minimumUnmaskedVersion = version.NewDefaultVersion(constants.PlatformName, 1, 1, 0)
+
+	// Peers at or after this version are able to parse application level
+	// messages
+	minimumAppVersion = version.NewDefaultVersion(constants.PlatformName, 1, 2, 1)
+
+	// Peers at or after this version are able to parse state sync messages
+	minimumStateSyncVersion = version.NewDefaultVersion(constants.PlatformName, 1, 2, 1)
+
+	// Peers at or after this version exchange the Compressions they can
+	// decompress messages with during the handshake
+	minimumCompressionVersion = version.NewDefaultVersion(constants.PlatformName, 1, 2, 2)
+
+	// Peers at or after this version exchange signed IPs rather than bare IPs
+	minimumSignedIPVersion = version.NewDefaultVersion(constants.PlatformName, 1, 2, 2)
 )
 
 func init() { rand.Seed(time.Now().UnixNano()) }
@@ -140,6 +157,13 @@ type network struct {
 	b                                  Builder
 	apricotPhase0Time                  time.Time
 
+	// true if compressed messages may be sent to peers that support them
+	compressionEnabled bool
+
+	// the staking certificate used to sign this node's IP. nil if this node
+	// can't sign its IP.
+	stakingCert *tls.Certificate
+
 	// stateLock should never be held when grabbing a peer lock
 	stateLock       sync.RWMutex
 	pendingBytes    int64
@@ -150,6 +174,10 @@ type network struct {
 	// TODO: bound the size of [myIPs] to avoid DoS. LRU caching would be ideal
 	myIPs map[string]struct{} // set of IPs that resulted in my ID.
 	peers map[ids.ShortID]*peer
+	// the most recent signed IP of each validator
+	peerIPClaims map[ids.ShortID]*SignedIP
+	// this node's signed IP. nil if it hasn't been signed yet.
+	myIPClaim *SignedIP
 
 	// ensures the close of the network only happens once.
 	closeOnce sync.Once
@@ -204,6 +232,8 @@ func NewDefaultNetwork(
 	disconnectedRestartTimeout time.Duration,
 	apricotPhase0Time time.Time,
 	sendQueueSize uint32,
+	compressionEnabled bool,
+	stakingCert *tls.Certificate,
 ) Network {
 	return NewNetwork(
 		registerer,
@@ -245,6 +275,8 @@ func NewDefaultNetwork(
 		disconnectedCheckFreq,
 		disconnectedRestartTimeout,
 		apricotPhase0Time,
+		compressionEnabled,
+		stakingCert,
 	)
 }
 
@@ -289,6 +321,8 @@ func NewNetwork(
 	disconnectedCheckFreq time.Duration,
 	disconnectedRestartTimeout time.Duration,
 	apricotPhase0Time time.Time,
+	compressionEnabled bool,
+	stakingCert *tls.Certificate,
 ) Network {
 	// #nosec G404
 	netw := &network{
@@ -329,6 +363,7 @@ func NewNetwork(
 		retryDelay:                         make(map[string]time.Duration),
 		myIPs:                              map[string]struct{}{ip.IP().String(): {}},
 		peers:                              make(map[ids.ShortID]*peer),
+		peerIPClaims:                       make(map[ids.ShortID]*SignedIP),
 		readBufferSize:                     readBufferSize,
 		readHandshakeTimeout:               readHandshakeTimeout,
 		connMeter:                          NewConnMeter(connMeterResetDuration, connMeterCacheSize),
@@ -339,6 +374,8 @@ func NewNetwork(
 		connectedMeter:                     timer.TimedMeter{Duration: disconnectedRestartTimeout},
 		restarter:                          restarter,
 		apricotPhase0Time:                  apricotPhase0Time,
+		compressionEnabled:                 compressionEnabled,
+		stakingCert:                        stakingCert,
 	}
 
 	if err := netw.initialize(registerer); err != nil {
@@ -646,6 +683,223 @@ func (n *network) Chits(validatorID ids.ShortID, chainID ids.ID, requestID uint3
 	}
 }
 
+// AppRequest implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) AppRequest(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte) {
+	msg, err := n.b.AppRequest(chainID, requestID, uint64(deadline.Sub(n.clock.Time())), appRequestBytes)
+	if err != nil {
+		n.log.Error("failed to build AppRequest(%s, %d): %s. len(appRequestBytes): %d",
+			chainID,
+			requestID,
+			err,
+			len(appRequestBytes))
+		n.log.Verbo("message: %s", formatting.DumpBytes{Bytes: appRequestBytes})
+		for validatorID := range validatorIDs {
+			vID := validatorID // Prevent overwrite in next loop iteration
+			n.executor.Add(func() { n.router.AppRequestFailed(vID, chainID, requestID) })
+		}
+		return // Packing message failed
+	}
+
+	for _, peerElement := range n.getPeers(validatorIDs) {
+		peer := peerElement.peer
+		vID := peerElement.id
+		if peer == nil || !peer.connected.GetValue() || !peer.supportsAppMessages() || !peer.Send(msg) {
+			n.log.Debug("failed to send AppRequest(%s, %s, %d)",
+				vID,
+				chainID,
+				requestID)
+			n.log.Verbo("message: %s", formatting.DumpBytes{Bytes: appRequestBytes})
+			n.executor.Add(func() { n.router.AppRequestFailed(vID, chainID, requestID) })
+			n.appRequest.numFailed.Inc()
+		} else {
+			n.appRequest.numSent.Inc()
+		}
+	}
+}
+
+// AppResponse implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) AppResponse(validatorID ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte) {
+	msg, err := n.b.AppResponse(chainID, requestID, appResponseBytes)
+	if err != nil {
+		n.log.Error("failed to build AppResponse(%s, %d): %s. len(appResponseBytes): %d",
+			chainID,
+			requestID,
+			err,
+			len(appResponseBytes))
+		n.log.Verbo("message: %s", formatting.DumpBytes{Bytes: appResponseBytes})
+		return
+	}
+
+	peer := n.getPeer(validatorID)
+	if peer == nil || !peer.connected.GetValue() || !peer.supportsAppMessages() || !peer.Send(msg) {
+		n.log.Debug("failed to send AppResponse(%s, %s, %d)",
+			validatorID,
+			chainID,
+			requestID)
+		n.log.Verbo("message: %s", formatting.DumpBytes{Bytes: appResponseBytes})
+		n.appResponse.numFailed.Inc()
+	} else {
+		n.appResponse.numSent.Inc()
+	}
+}
+
+// AppGossip attempts to gossip the application level message to the network
+// assumes the stateLock is not held.
+func (n *network) AppGossip(chainID ids.ID, appGossipBytes []byte) {
+	if err := n.gossipAppMsg(chainID, appGossipBytes); err != nil {
+		n.log.Debug("failed to AppGossip(%s): %s", chainID, err)
+		n.log.Verbo("message:\n%s", formatting.DumpBytes{Bytes: appGossipBytes})
+	}
+}
+
+// AppGossipSpecific implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) AppGossipSpecific(validatorIDs ids.ShortSet, chainID ids.ID, appGossipBytes []byte) {
+	msg, err := n.b.AppGossip(chainID, appGossipBytes)
+	if err != nil {
+		n.log.Error("failed to build AppGossip(%s): %s. len(appGossipBytes): %d",
+			chainID,
+			err,
+			len(appGossipBytes))
+		return // Packing message failed
+	}
+
+	for _, peerElement := range n.getPeers(validatorIDs) {
+		peer := peerElement.peer
+		if peer == nil || !peer.connected.GetValue() || !peer.supportsAppMessages() || !peer.Send(msg) {
+			n.log.Debug("failed to send AppGossip(%s, %s)",
+				peerElement.id,
+				chainID)
+			n.appGossip.numFailed.Inc()
+		} else {
+			n.appGossip.numSent.Inc()
+		}
+	}
+}
+
+// GetStateSummaryFrontier sends a GetStateSummaryFrontier message to each
+// connected validator in [validatorIDs]. GetStateSummaryFrontierFailed is
+// routed for each validator that the message couldn't be sent to.
+// Implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) GetStateSummaryFrontier(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time) {
+	msg, err := n.b.GetStateSummaryFrontier(chainID, requestID, uint64(deadline.Sub(n.clock.Time())))
+	n.log.AssertNoError(err)
+
+	for _, peerElement := range n.getPeers(validatorIDs) {
+		peer := peerElement.peer
+		vID := peerElement.id
+		if peer == nil || !peer.connected.GetValue() || !peer.supportsStateSync() || !peer.Send(msg) {
+			n.log.Debug("failed to send GetStateSummaryFrontier(%s, %s, %d)",
+				vID,
+				chainID,
+				requestID)
+			n.executor.Add(func() { n.router.GetStateSummaryFrontierFailed(vID, chainID, requestID) })
+			n.getStateSummaryFrontier.numFailed.Inc()
+		} else {
+			n.getStateSummaryFrontier.numSent.Inc()
+		}
+	}
+}
+
+// StateSummaryFrontier sends [summary], this node's most recent state summary,
+// to [validatorID] in response to its GetStateSummaryFrontier request.
+// Implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) StateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte) {
+	msg, err := n.b.StateSummaryFrontier(chainID, requestID, summary)
+	if err != nil {
+		n.log.Error("failed to build StateSummaryFrontier(%s, %d): %s. len(summary): %d",
+			chainID,
+			requestID,
+			err,
+			len(summary))
+		return // Packing message failed
+	}
+
+	peer := n.getPeer(validatorID)
+	if peer == nil || !peer.connected.GetValue() || !peer.supportsStateSync() || !peer.Send(msg) {
+		n.log.Debug("failed to send StateSummaryFrontier(%s, %s, %d)",
+			validatorID,
+			chainID,
+			requestID)
+		n.stateSummaryFrontier.numFailed.Inc()
+	} else {
+		n.stateSummaryFrontier.numSent.Inc()
+	}
+}
+
+// GetAcceptedStateSummary sends a GetAcceptedStateSummary message, asking for
+// the state summaries accepted at [heights], to each connected validator in
+// [validatorIDs]. GetAcceptedStateSummaryFailed is routed for each validator
+// that the message couldn't be sent to.
+// Implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) GetAcceptedStateSummary(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64) {
+	msg, err := n.b.GetAcceptedStateSummary(chainID, requestID, uint64(deadline.Sub(n.clock.Time())), heights)
+	if err != nil {
+		n.log.Error("failed to build GetAcceptedStateSummary(%s, %d, %v): %s",
+			chainID,
+			requestID,
+			heights,
+			err)
+		for validatorID := range validatorIDs {
+			vID := validatorID // Prevent overwrite in next loop iteration
+			n.executor.Add(func() {
+				n.router.GetAcceptedStateSummaryFailed(vID, chainID, requestID)
+			})
+		}
+		return
+	}
+
+	for _, peerElement := range n.getPeers(validatorIDs) {
+		peer := peerElement.peer
+		vID := peerElement.id
+		if peer == nil || !peer.connected.GetValue() || !peer.supportsStateSync() || !peer.Send(msg) {
+			n.log.Debug("failed to send GetAcceptedStateSummary(%s, %s, %d, %v)",
+				vID,
+				chainID,
+				requestID,
+				heights)
+			n.executor.Add(func() { n.router.GetAcceptedStateSummaryFailed(vID, chainID, requestID) })
+			n.getAcceptedStateSummary.numFailed.Inc()
+		} else {
+			n.getAcceptedStateSummary.numSent.Inc()
+		}
+	}
+}
+
+// AcceptedStateSummary sends [summaryIDs], the IDs of the state summaries this
+// node has accepted, to [validatorID] in response to its
+// GetAcceptedStateSummary request.
+// Implements the Sender interface.
+// assumes the stateLock is not held.
+func (n *network) AcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID) {
+	msg, err := n.b.AcceptedStateSummary(chainID, requestID, summaryIDs)
+	if err != nil {
+		n.log.Error("failed to build AcceptedStateSummary(%s, %d, %s): %s",
+			chainID,
+			requestID,
+			summaryIDs,
+			err)
+		return // Packing message failed
+	}
+
+	peer := n.getPeer(validatorID)
+	if peer == nil || !peer.connected.GetValue() || !peer.supportsStateSync() || !peer.Send(msg) {
+		n.log.Debug("failed to send AcceptedStateSummary(%s, %s, %d, %s)",
+			validatorID,
+			chainID,
+			requestID,
+			summaryIDs)
+		n.acceptedStateSummary.numFailed.Inc()
+	} else {
+		n.acceptedStateSummary.numSent.Inc()
+	}
+}
+
 // Gossip attempts to gossip the container to the network
 // assumes the stateLock is not held.
 func (n *network) Gossip(chainID, containerID ids.ID, container []byte) {
@@ -665,6 +919,15 @@ func (n *network) Accept(ctx *snow.Context, containerID ids.ID, container []byte
 	return n.gossipContainer(ctx.ChainID, containerID, container)
 }
 
+// compressionReceived updates the compression metrics with [received], which was
+// just received from a peer
+func (n *network) compressionReceived(received Msg) {
+	if m, ok := received.(*msg); ok && m.compressed {
+		n.compression.numCompressedReceived.Inc()
+		n.compression.bytesSavedReceived.Add(float64(m.uncompressedLen - len(m.bytes)))
+	}
+}
+
 // heartbeat registers a new heartbeat to signal liveness
 func (n *network) heartbeat() { atomic.StoreInt64(&n.lastHeartbeat, n.clock.Time().Unix()) }
 
@@ -757,14 +1020,7 @@ func (n *network) Peers(nodeIDs []ids.ShortID) []PeerID {
 		peers = make([]PeerID, 0, len(n.peers))
 		for _, peer := range n.peers {
 			if peer.connected.GetValue() {
-				peers = append(peers, PeerID{
-					IP:           peer.conn.RemoteAddr().String(),
-					PublicIP:     peer.getIP().String(),
-					ID:           peer.id.PrefixedString(constants.NodeIDPrefix),
-					Version:      peer.versionStr.GetValue().(string),
-					LastSent:     time.Unix(atomic.LoadInt64(&peer.lastSent), 0),
-					LastReceived: time.Unix(atomic.LoadInt64(&peer.lastReceived), 0),
-				})
+				peers = append(peers, peer.info())
 			}
 		}
 	} else {
@@ -772,14 +1028,7 @@ func (n *network) Peers(nodeIDs []ids.ShortID) []PeerID {
 		for _, nodeID := range nodeIDs {
 			peer, ok := n.peers[nodeID]
 			if ok && peer.connected.GetValue() {
-				peers = append(peers, PeerID{
-					IP:           peer.conn.RemoteAddr().String(),
-					PublicIP:     peer.getIP().String(),
-					ID:           peer.id.PrefixedString(constants.NodeIDPrefix),
-					Version:      peer.versionStr.GetValue().(string),
-					LastSent:     time.Unix(atomic.LoadInt64(&peer.lastSent), 0),
-					LastReceived: time.Unix(atomic.LoadInt64(&peer.lastReceived), 0),
-				})
+				peers = append(peers, peer.info())
 			}
 		}
 	}
@@ -872,6 +1121,46 @@ func (n *network) gossipContainer(chainID, containerID ids.ID, container []byte)
 	return nil
 }
 
+// assumes the stateLock is not held.
+func (n *network) gossipAppMsg(chainID ids.ID, appGossipBytes []byte) error {
+	msg, err := n.b.AppGossip(chainID, appGossipBytes)
+	if err != nil {
+		return fmt.Errorf("attempted to pack too large of an AppGossip message.\nMessage length: %d", len(appGossipBytes))
+	}
+
+	// Only gossip to peers that are able to parse the message
+	allPeers := n.getAllPeers()
+	appPeers := allPeers[:0]
+	for _, peer := range allPeers {
+		if peer.supportsAppMessages() {
+			appPeers = append(appPeers, peer)
+		}
+	}
+	allPeers = appPeers
+
+	numToGossip := n.gossipSize
+	if numToGossip > len(allPeers) {
+		numToGossip = len(allPeers)
+	}
+
+	s := sampler.NewUniform()
+	if err := s.Initialize(uint64(len(allPeers))); err != nil {
+		return err
+	}
+	indices, err := s.Sample(numToGossip)
+	if err != nil {
+		return err
+	}
+	for _, index := range indices {
+		if allPeers[int(index)].Send(msg) {
+			n.appGossip.numSent.Inc()
+		} else {
+			n.appGossip.numFailed.Inc()
+		}
+	}
+	return nil
+}
+
 // assumes the stateLock is held.
 func (n *network) track(ip utils.IPDesc) {
 	if n.closed.GetValue() {
@@ -921,7 +1210,8 @@ func (n *network) gossip() {
 			}
 		}
 
-		if len(ips) == 0 {
+		claims := n.validatorSignedIPs()
+		if len(ips) == 0 && len(claims) == 0 {
 			n.log.Debug("skipping validator gossiping as no public validators are connected")
 			continue
 		}
@@ -932,6 +1222,13 @@ func (n *network) gossip() {
 				len(ips))
 			continue
 		}
+		signedMsg, err := n.b.SignedPeerList(claims)
+		if err != nil {
+			n.log.Error("failed to build signed peer list to gossip: %s. len(claims): %d",
+				err,
+				len(claims))
+			continue
+		}
 
 		stakers := make([]*peer, 0, len(allPeers))
 		nonStakers := make([]*peer, 0, len(allPeers))
@@ -967,7 +1264,7 @@ func (n *network) gossip() {
 			continue
 		}
 		for _, index := range stakerIndices {
-			stakers[int(index)].Send(msg)
+			stakers[int(index)].sendPeerList(msg, signedMsg)
 		}
 
 		if err := s.Initialize(uint64(len(nonStakers))); err != nil {
@@ -984,7 +1281,7 @@ func (n *network) gossip() {
 			continue
 		}
 		for _, index := range nonStakerIndices {
-			nonStakers[int(index)].Send(msg)
+			nonStakers[int(index)].sendPeerList(msg, signedMsg)
 		}
 	}
 }
@@ -1170,6 +1467,118 @@ func (n *network) validatorIPs() []utils.IPDesc {
 	return ips
 }
 
+// assumes the stateLock is not held. Returns the most recent signed IPs of the
+// validators that are connected, along with this node's signed IP. If they
+// don't all fit in a single message, a random sample of them that does is
+// returned.
+func (n *network) validatorSignedIPs() []SignedIP {
+	n.stateLock.Lock()
+	defer n.stateLock.Unlock()
+
+	claims := make([]SignedIP, 0, len(n.peerIPClaims))
+	for nodeID, claim := range n.peerIPClaims {
+		peer, ok := n.peers[nodeID]
+		if ok && peer.connected.GetValue() && n.vdrs.Contains(nodeID) {
+			claims = append(claims, *claim)
+		}
+	}
+	sampled, err := sampleSignedIPs(n.getMyIPClaim(), claims, int(n.maxMessageSize))
+	if err != nil {
+		n.log.Error("failed to sample signed IPs: %s. len(claims): %d",
+			err,
+			len(claims))
+		return nil
+	}
+	return sampled
+}
+
+// assumes the stateLock is held. Returns this node's signed IP, re-signing it
+// if this node's IP has changed since it was last signed. Returns nil if this
+// node's IP can't be signed.
+func (n *network) getMyIPClaim() *SignedIP {
+	if n.stakingCert == nil {
+		return nil
+	}
+	ip := n.ip.IP()
+	if ip.IsZero() {
+		return nil
+	}
+	if n.myIPClaim != nil && n.myIPClaim.IP.Equal(ip) {
+		return n.myIPClaim
+	}
+
+	claim, err := newSignedIP(n.stakingCert, ip, n.clock.Unix())
+	if err != nil {
+		n.log.Error("failed to sign my IP %s due to %s", ip, err)
+		return nil
+	}
+	n.myIPClaim = claim
+	return claim
+}
+
+// assumes the stateLock is not held. Verifies [claim] and, if it is newer than
+// the last signed IP of the validator that made it, replaces that signed IP
+// and starts tracking the claimed IP. Returns an error if [claim] is invalid.
+func (n *network) trackSignedIP(claim SignedIP) error {
+	if maxTimestamp := n.clock.Unix() + uint64(n.maxClockDifference.Seconds()); claim.Timestamp > maxTimestamp {
+		return errFutureClaim
+	}
+
+	nodeID := claim.NodeID()
+	if nodeID == n.id {
+		return nil
+	}
+	if !n.vdrs.Contains(nodeID) {
+		return errNotValidator
+	}
+	if !n.isNewerIPClaim(nodeID, &claim) {
+		return nil
+	}
+
+	// Verifying the signature is relatively expensive, so the stateLock isn't
+	// held while doing so
+	if err := claim.Verify(); err != nil {
+		return err
+	}
+
+	n.stateLock.Lock()
+	defer n.stateLock.Unlock()
+
+	prevClaim, ok := n.peerIPClaims[nodeID]
+	if ok && prevClaim.Timestamp >= claim.Timestamp {
+		return nil
+	}
+	n.peerIPClaims[nodeID] = &claim
+
+	if ok && !prevClaim.IP.Equal(claim.IP) {
+		// The validator is no longer claiming to be at its previous IP, so stop
+		// attempting to connect to it
+		str := prevClaim.IP.String()
+		delete(n.disconnectedIPs, str)
+		delete(n.retryDelay, str)
+	}
+
+	if _, connected := n.peers[nodeID]; connected {
+		return nil
+	}
+	if !claim.IP.Equal(n.ip.IP()) &&
+		!claim.IP.IsZero() &&
+		(n.allowPrivateIPs || !claim.IP.IsPrivate()) {
+		n.track(claim.IP)
+	}
+	return nil
+}
+
+// assumes the stateLock is not held. Returns true if [claim] is newer than the
+// last signed IP of [nodeID].
+func (n *network) isNewerIPClaim(nodeID ids.ShortID, claim *SignedIP) bool {
+	n.stateLock.RLock()
+	defer n.stateLock.RUnlock()
+
+	prevClaim, ok := n.peerIPClaims[nodeID]
+	return !ok || prevClaim.Timestamp < claim.Timestamp
+}
+
 // should only be called after the peer is marked as connected. Should not be
 // called after disconnected is called with this peer.
 // assumes the stateLock is not held.
@@ -1177,6 +1586,7 @@ func (n *network) connected(p *peer) {
 	p.net.stateLock.Lock()
 	defer p.net.stateLock.Unlock()
 
+	atomic.StoreInt64(&p.connectedAt, n.clock.Time().Unix())
 	p.connected.SetValue(true)
 
 	peerVersion := p.versionStruct.GetValue().(version.Version)
@@ -18,6 +18,7 @@ import (
 	"github.com/corpetty/avalanchego/snow/networking/router"
 	"github.com/corpetty/avalanchego/snow/validators"
 	"github.com/corpetty/avalanchego/utils"
+	"github.com/corpetty/avalanchego/utils/constants"
 	"github.com/corpetty/avalanchego/utils/hashing"
 	"github.com/corpetty/avalanchego/utils/logging"
 	"github.com/corpetty/avalanchego/version"
@@ -216,6 +217,8 @@ func TestNewDefaultNetwork(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net)
 
@@ -330,6 +333,8 @@ func TestEstablishConnection(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net0)
 
@@ -356,6 +361,8 @@ func TestEstablishConnection(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net1)
 
@@ -482,6 +489,8 @@ func TestDoubleTrack(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net0)
 
@@ -508,6 +517,8 @@ func TestDoubleTrack(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net1)
 
@@ -635,6 +646,8 @@ func TestDoubleClose(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net0)
 
@@ -661,6 +674,8 @@ func TestDoubleClose(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net1)
 
@@ -793,6 +808,8 @@ func TestTrackConnected(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net0)
 
@@ -819,6 +836,8 @@ func TestTrackConnected(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net1)
 
@@ -925,6 +944,8 @@ func TestTrackConnectedRace(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net0)
 
@@ -951,6 +972,8 @@ func TestTrackConnectedRace(t *testing.T) {
 		0,
 		time.Now(),
 		defaultSendQueueSize,
+		true,
+		nil,
 	)
 	assert.NotNil(t, net1)
 
@@ -971,3 +994,140 @@ func TestTrackConnectedRace(t *testing.T) {
 	err = net1.Close()
 	assert.NoError(t, err)
 }
+
+type failedRequestRouter struct {
+	router.Router
+	lock   sync.Mutex
+	failed []ids.ShortID
+	done   chan struct{}
+}
+
+func (r *failedRequestRouter) fail(id ids.ShortID) {
+	r.lock.Lock()
+	r.failed = append(r.failed, id)
+	r.lock.Unlock()
+	r.done <- struct{}{}
+}
+
+func (r *failedRequestRouter) AppRequestFailed(id ids.ShortID, _ ids.ID, _ uint32) {
+	r.fail(id)
+}
+
+func (r *failedRequestRouter) GetStateSummaryFrontierFailed(id ids.ShortID, _ ids.ID, _ uint32) {
+	r.fail(id)
+}
+
+func (r *failedRequestRouter) GetAcceptedStateSummaryFailed(id ids.ShortID, _ ids.ID, _ uint32) {
+	r.fail(id)
+}
+
+// versionGatingTest is a network with one peer that predates the messages
+// under test and one peer that supports them.
+type versionGatingTest struct {
+	t       *testing.T
+	n       *network
+	router  *failedRequestRouter
+	oldPeer *peer
+	newPeer *peer
+	peerIDs ids.ShortSet
+}
+
+func newVersionGatingTest(t *testing.T, testRouter *failedRequestRouter) *versionGatingTest {
+	n := &network{
+		log:            logging.NoLog{},
+		router:         testRouter,
+		peers:          make(map[ids.ShortID]*peer),
+		gossipSize:     10,
+		maxMessageSize: int64(DefaultMaxMessageSize),
+	}
+	assert.NoError(t, n.initialize(prometheus.NewRegistry()))
+	n.executor.Initialize()
+	go n.executor.Dispatch()
+
+	addPeer := func(peerVersion version.Version) *peer {
+		p := &peer{
+			net:    n,
+			id:     ids.GenerateTestShortID(),
+			sender: make(chan []byte, 10),
+		}
+		p.connected.SetValue(true)
+		p.gotVersion.SetValue(true)
+		p.versionStruct.SetValue(peerVersion)
+		n.peers[p.id] = p
+		return p
+	}
+	test := &versionGatingTest{
+		t:       t,
+		n:       n,
+		router:  testRouter,
+		oldPeer: addPeer(version.NewDefaultVersion(constants.PlatformName, 1, 2, 0)),
+		newPeer: addPeer(version.NewDefaultVersion(constants.PlatformName, 1, 2, 1)),
+	}
+	test.peerIDs.Add(test.oldPeer.id, test.newPeer.id)
+	return test
+}
+
+// checkSent asserts that only the new peer was sent a message with [op]
+func (test *versionGatingTest) checkSent(op Op) {
+	test.t.Helper()
+	assert.Len(test.t, test.oldPeer.sender, 0, "shouldn't send %s to a peer that can't parse it", op)
+	assert.Len(test.t, test.newPeer.sender, 1, "should send %s to a peer that can parse it", op)
+	msg, err := test.n.b.Parse(<-test.newPeer.sender)
+	assert.NoError(test.t, err)
+	assert.Equal(test.t, op, msg.Op())
+}
+
+// checkFailed asserts that only the request to the old peer was failed
+func (test *versionGatingTest) checkFailed() {
+	test.t.Helper()
+	<-test.router.done
+	test.router.lock.Lock()
+	defer test.router.lock.Unlock()
+	assert.Equal(test.t, []ids.ShortID{test.oldPeer.id}, test.router.failed, "request to the old peer should have failed")
+	test.router.failed = nil
+}
+
+func TestAppMessagesSkipOldPeers(t *testing.T) {
+	test := newVersionGatingTest(t, &failedRequestRouter{done: make(chan struct{}, 1)})
+	defer test.n.executor.Stop()
+
+	chainID := ids.GenerateTestID()
+
+	test.n.AppRequest(test.peerIDs, chainID, 1, time.Now().Add(time.Minute), []byte{1})
+	test.checkSent(AppRequest)
+	test.checkFailed()
+
+	test.n.AppResponse(test.oldPeer.id, chainID, 1, []byte{1})
+	test.n.AppResponse(test.newPeer.id, chainID, 1, []byte{1})
+	test.checkSent(AppResponse)
+
+	test.n.AppGossipSpecific(test.peerIDs, chainID, []byte{1})
+	test.checkSent(AppGossip)
+
+	test.n.AppGossip(chainID, []byte{1})
+	test.checkSent(AppGossip)
+}
+
+func TestStateSyncMessagesSkipOldPeers(t *testing.T) {
+	test := newVersionGatingTest(t, &failedRequestRouter{done: make(chan struct{}, 1)})
+	defer test.n.executor.Stop()
+
+	chainID := ids.GenerateTestID()
+	deadline := time.Now().Add(time.Minute)
+
+	test.n.GetStateSummaryFrontier(test.peerIDs, chainID, 1, deadline)
+	test.checkSent(GetStateSummaryFrontier)
+	test.checkFailed()
+
+	test.n.StateSummaryFrontier(test.oldPeer.id, chainID, 1, []byte{1})
+	test.n.StateSummaryFrontier(test.newPeer.id, chainID, 1, []byte{1})
+	test.checkSent(StateSummaryFrontier)
+
+	test.n.GetAcceptedStateSummary(test.peerIDs, chainID, 2, deadline, []uint64{1})
+	test.checkSent(GetAcceptedStateSummary)
+	test.checkFailed()
+
+	test.n.AcceptedStateSummary(test.oldPeer.id, chainID, 2, []ids.ID{ids.GenerateTestID()})
+	test.n.AcceptedStateSummary(test.newPeer.id, chainID, 2, []ids.ID{ids.GenerateTestID()})
+	test.checkSent(AcceptedStateSummary)
+}
@@ -13,7 +13,9 @@ import (
 
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/utils"
+	"github.com/corpetty/avalanchego/utils/constants"
 	"github.com/corpetty/avalanchego/utils/formatting"
+	"github.com/corpetty/avalanchego/utils/json"
 	"github.com/corpetty/avalanchego/utils/wrappers"
 	"github.com/corpetty/avalanchego/version"
 )
@@ -62,9 +64,25 @@ type peer struct {
 	// version that the peer reported during the handshake
 	versionStruct, versionStr utils.AtomicInterface
 
+	// Compression to send compressible messages to the peer with. Unset until
+	// the peer announces the algorithms it supports.
+	compression utils.AtomicInterface
+
 	// unix time of the last message sent and received respectively
 	lastSent, lastReceived int64
 
+	// unix time the peer was marked as connected
+	connectedAt int64
+
+	// unix time, in nanoseconds, that the last ping was sent. Zero if the peer
+	// responded to the last ping.
+	pingSent int64
+	// round trip time, in nanoseconds, of the last ping the peer responded to
+	roundTripTime int64
+
+	// messages exchanged with the peer
+	stats peerStats
+
 	tickerCloser chan struct{}
 
 	// ticker processes
@@ -197,6 +215,8 @@ func (p *peer) ReadMessages() {
 				err)
 			return
 		}
+		p.net.compressionReceived(msg)
+		p.stats.received(msg.Op(), len(msgBytes))
 
 		p.handle(msg)
 	}
@@ -235,6 +255,18 @@ func (p *peer) WriteMessages() {
 
 // send assumes that the stateLock is not held.
 func (p *peer) Send(msg Msg) bool {
+	if compression, ok := p.sendCompression(); ok && msg.Op().Compressible() {
+		compressedMsg, err := p.net.b.Compress(msg, compression)
+		if err != nil {
+			p.net.log.Debug("failed to compress %s message to %s: %s", msg.Op(), p.id, err)
+		} else if len(compressedMsg.Bytes()) < len(msg.Bytes()) {
+			// only send the compressed message if it is actually smaller
+			p.net.compression.numCompressedSent.Inc()
+			p.net.compression.bytesSavedSent.Add(float64(len(msg.Bytes()) - len(compressedMsg.Bytes())))
+			msg = compressedMsg
+		}
+	}
+
 	p.senderLock.Lock()
 	defer p.senderLock.Unlock()
 
@@ -269,6 +301,7 @@ func (p *peer) Send(msg Msg) bool {
 	select {
 	case p.sender <- msgBytes:
 		atomic.AddInt64(&p.pendingBytes, msgBytesLen)
+		p.stats.sent(msg.Op(), len(msgBytes))
 		return true
 	default:
 		// we never sent the message, remove from pending totals
@@ -278,6 +311,77 @@ func (p *peer) Send(msg Msg) bool {
 	}
 }
 
+// sendCompression returns the Compression that compressible messages sent to
+// this peer should be compressed with. Returns false if they shouldn't be
+// compressed.
+func (p *peer) sendCompression() (Compression, bool) {
+	compression, ok := p.compression.GetValue().(Compression)
+	return compression, ok && compression != NoCompression
+}
+
+// supportsCompressionList returns true if this peer can be told which
+// Compressions this node supports. Peers report whether they can through the
+// version they send during the handshake.
+func (p *peer) supportsCompressionList() bool {
+	if !p.gotVersion.GetValue() {
+		return false
+	}
+	peerVersion, ok := p.versionStruct.GetValue().(version.Version)
+	return ok && !peerVersion.Before(minimumCompressionVersion)
+}
+
+// supportsAppMessages returns true if this peer is able to parse application
+// level messages. Peers report whether they can through the version they send
+// during the handshake.
+func (p *peer) supportsAppMessages() bool {
+	if !p.gotVersion.GetValue() {
+		return false
+	}
+	peerVersion, ok := p.versionStruct.GetValue().(version.Version)
+	return ok && !peerVersion.Before(minimumAppVersion)
+}
+
+// supportsStateSync returns true if this peer is able to parse state sync
+// messages. Peers report whether they can through the version they send during
+// the handshake.
+func (p *peer) supportsStateSync() bool {
+	if !p.gotVersion.GetValue() {
+		return false
+	}
+	peerVersion, ok := p.versionStruct.GetValue().(version.Version)
+	return ok && !peerVersion.Before(minimumStateSyncVersion)
+}
+
+// supportsSignedIPs returns true if this peer exchanges signed IPs rather than
+// bare IPs. Peers report whether they support signed IPs through the version
+// they send during the handshake.
+func (p *peer) supportsSignedIPs() bool {
+	if !p.gotVersion.GetValue() {
+		return false
+	}
+	peerVersion, ok := p.versionStruct.GetValue().(version.Version)
+	return ok && !peerVersion.Before(minimumSignedIPVersion)
+}
+
+// info returns the description of this peer, including the statistics of the
+// connection to it
+func (p *peer) info() PeerID {
+	connectedAt := time.Unix(atomic.LoadInt64(&p.connectedAt), 0)
+	peerID := PeerID{
+		IP:            p.conn.RemoteAddr().String(),
+		PublicIP:      p.getIP().String(),
+		ID:            p.id.PrefixedString(constants.NodeIDPrefix),
+		Version:       p.versionStr.GetValue().(string),
+		LastSent:      time.Unix(atomic.LoadInt64(&p.lastSent), 0),
+		LastReceived:  time.Unix(atomic.LoadInt64(&p.lastReceived), 0),
+		ConnectedAt:   connectedAt,
+		Uptime:        json.Uint64(p.net.clock.Time().Sub(connectedAt) / time.Second),
+		RoundTripTime: json.Uint64(atomic.LoadInt64(&p.roundTripTime) / int64(time.Millisecond)),
+	}
+	p.stats.fill(&peerID)
+	return peerID
+}
+
 // assumes the stateLock is not held
 func (p *peer) handle(msg Msg) {
 	p.net.heartbeat()
@@ -318,6 +422,12 @@ func (p *peer) handle(msg Msg) {
 	case PeerList:
 		p.peerList(msg)
 		return
+	case SignedPeerList:
+		p.signedPeerList(msg)
+		return
+	case CompressionList:
+		p.compressionList(msg)
+		return
 	}
 	if !p.connected.GetValue() {
 		p.net.log.Debug("dropping message from %s because the connection hasn't been established yet", p.id)
@@ -361,6 +471,20 @@ func (p *peer) handle(msg Msg) {
 		p.pullQuery(msg)
 	case Chits:
 		p.chits(msg)
+	case AppRequest:
+		p.appRequest(msg)
+	case AppResponse:
+		p.appResponse(msg)
+	case AppGossip:
+		p.appGossip(msg)
+	case GetStateSummaryFrontier:
+		p.getStateSummaryFrontier(msg)
+	case StateSummaryFrontier:
+		p.stateSummaryFrontier(msg)
+	case GetAcceptedStateSummary:
+		p.getAcceptedStateSummary(msg)
+	case AcceptedStateSummary:
+		p.acceptedStateSummary(msg)
 	default:
 		p.net.log.Debug("dropping an unknown message from %s with op %s", p.id, op.String())
 	}
@@ -432,10 +556,25 @@ func (p *peer) GetPeerList() {
 
 // assumes the stateLock is not held
 func (p *peer) SendPeerList() {
+	if p.supportsSignedIPs() {
+		claims := p.net.validatorSignedIPs()
+		p.SignedPeerList(claims)
+		return
+	}
 	ips := p.net.validatorIPs()
 	p.PeerList(ips)
 }
 
+// assumes the stateLock is not held. Sends [signedMsg] if this peer supports
+// signed IPs, and [msg] otherwise.
+func (p *peer) sendPeerList(msg, signedMsg Msg) {
+	if p.supportsSignedIPs() {
+		p.Send(signedMsg)
+	} else {
+		p.Send(msg)
+	}
+}
+
 // assumes the stateLock is not held
 func (p *peer) PeerList(peers []utils.IPDesc) {
 	msg, err := p.net.b.PeerList(peers)
@@ -446,10 +585,29 @@ func (p *peer) PeerList(peers []utils.IPDesc) {
 	p.Send(msg)
 }
 
+// assumes the stateLock is not held. Tells the peer which Compressions this
+// node can decompress messages with.
+func (p *peer) CompressionList() {
+	msg, err := p.net.b.CompressionList(supportedCompressions)
+	p.net.log.AssertNoError(err)
+	p.Send(msg)
+}
+
+// assumes the stateLock is not held
+func (p *peer) SignedPeerList(claims []SignedIP) {
+	msg, err := p.net.b.SignedPeerList(claims)
+	if err != nil {
+		p.net.log.Warn("failed to send SignedPeerList message due to %s", err)
+		return
+	}
+	p.Send(msg)
+}
+
 // assumes the stateLock is not held
 func (p *peer) Ping() {
 	msg, err := p.net.b.Ping()
 	p.net.log.AssertNoError(err)
+	atomic.StoreInt64(&p.pingSent, p.net.clock.Time().UnixNano())
 	if p.Send(msg) {
 		p.net.ping.numSent.Inc()
 	} else {
@@ -562,12 +720,17 @@ func (p *peer) version(msg Msg) {
 		}
 	}
 
-	p.SendPeerList()
-
 	p.versionStruct.SetValue(peerVersion)
 	p.versionStr.SetValue(peerVersion.String())
 	p.gotVersion.SetValue(true)
 
+	// The peer's version determines which peer list it can parse
+	p.SendPeerList()
+
+	if p.supportsCompressionList() {
+		p.CompressionList()
+	}
+
 	p.tryMarkConnected()
 }
 
@@ -585,6 +748,13 @@ func (p *peer) peerList(msg Msg) {
 	p.gotPeerList.SetValue(true)
 	p.tryMarkConnected()
 
+	// Bare IPs can't be verified, so they are only accepted from peers that
+	// predate signed IPs
+	if !p.gotVersion.GetValue() || p.supportsSignedIPs() {
+		p.net.log.Verbo("dropping unsigned peer list from %s", p.id)
+		return
+	}
+
 	for _, ip := range ips {
 		p.net.stateLock.Lock()
 		if !ip.Equal(p.net.ip.IP()) &&
@@ -597,11 +767,52 @@ func (p *peer) peerList(msg Msg) {
 	}
 }
 
+// assumes the stateLock is not held
+func (p *peer) signedPeerList(msg Msg) {
+	claims := msg.Get(SignedPeers).([]SignedIP)
+
+	p.gotPeerList.SetValue(true)
+	p.tryMarkConnected()
+
+	for _, claim := range claims {
+		if err := p.net.trackSignedIP(claim); err != nil {
+			p.net.log.Debug("dropping signed IP %s from %s due to %s", claim.IP, p.id, err)
+		}
+	}
+}
+
+// assumes the stateLock is not held. Chooses the most preferred Compression
+// that both this node and the peer support to compress the messages sent to
+// the peer with.
+func (p *peer) compressionList(msg Msg) {
+	if !p.net.compressionEnabled {
+		return
+	}
+
+	peerCompressions := msg.Get(Compressions).([]Compression)
+	for _, compression := range supportedCompressions {
+		for _, peerCompression := range peerCompressions {
+			if compression == peerCompression {
+				p.compression.SetValue(compression)
+				return
+			}
+		}
+	}
+}
+
 // assumes the stateLock is not held
 func (p *peer) ping(_ Msg) { p.Pong() }
 
 // assumes the stateLock is not held
-func (p *peer) pong(_ Msg) {}
+func (p *peer) pong(_ Msg) {
+	pingSent := atomic.SwapInt64(&p.pingSent, 0)
+	if pingSent == 0 {
+		// we weren't expecting a pong
+		return
+	}
+	roundTripTime := p.net.clock.Time().UnixNano() - pingSent
+	atomic.StoreInt64(&p.roundTripTime, roundTripTime)
+}
 
 // assumes the stateLock is not held
 func (p *peer) getAcceptedFrontier(msg Msg) {
@@ -788,6 +999,36 @@ func (p *peer) chits(msg Msg) {
 	p.net.router.Chits(p.id, chainID, requestID, containerIDs)
 }
 
+// assumes the stateLock is not held
+func (p *peer) appRequest(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+	deadline := p.net.clock.Time().Add(time.Duration(msg.Get(Deadline).(uint64)))
+	appRequestBytes := msg.Get(AppBytes).([]byte)
+
+	p.net.router.AppRequest(p.id, chainID, requestID, deadline, appRequestBytes)
+}
+
+// assumes the stateLock is not held
+func (p *peer) appResponse(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+	appResponseBytes := msg.Get(AppBytes).([]byte)
+
+	p.net.router.AppResponse(p.id, chainID, requestID, appResponseBytes)
+}
+
+// assumes the stateLock is not held
+func (p *peer) appGossip(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	appGossipBytes := msg.Get(AppBytes).([]byte)
+
+	p.net.router.AppGossip(p.id, chainID, appGossipBytes)
+}
+
 // assumes the stateLock is held
 func (p *peer) tryMarkConnected() {
 	if !p.connected.GetValue() && // not already connected
@@ -836,3 +1077,60 @@ func (p *peer) getIP() utils.IPDesc {
 	defer p.ipLock.RUnlock()
 	return p.ip
 }
+
+// assumes the stateLock is not held
+func (p *peer) getStateSummaryFrontier(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+	deadline := p.net.clock.Time().Add(time.Duration(msg.Get(Deadline).(uint64)))
+
+	p.net.router.GetStateSummaryFrontier(p.id, chainID, requestID, deadline)
+}
+
+// assumes the stateLock is not held
+func (p *peer) stateSummaryFrontier(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+	summary := msg.Get(SummaryBytes).([]byte)
+
+	p.net.router.StateSummaryFrontier(p.id, chainID, requestID, summary)
+}
+
+// assumes the stateLock is not held
+func (p *peer) getAcceptedStateSummary(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+	deadline := p.net.clock.Time().Add(time.Duration(msg.Get(Deadline).(uint64)))
+	heights := msg.Get(SummaryHeights).([]uint64)
+
+	p.net.router.GetAcceptedStateSummary(p.id, chainID, requestID, deadline, heights)
+}
+
+// assumes the stateLock is not held
+func (p *peer) acceptedStateSummary(msg Msg) {
+	chainID, err := ids.ToID(msg.Get(ChainID).([]byte))
+	p.net.log.AssertNoError(err)
+	requestID := msg.Get(RequestID).(uint32)
+
+	summaryIDsBytes := msg.Get(ContainerIDs).([][]byte)
+	summaryIDs := make([]ids.ID, len(summaryIDsBytes))
+	summaryIDsSet := ids.Set{} // To prevent duplicates
+	for i, summaryIDBytes := range summaryIDsBytes {
+		summaryID, err := ids.ToID(summaryIDBytes)
+		if err != nil {
+			p.net.log.Debug("error parsing SummaryID 0x%x: %s", summaryIDBytes, err)
+			return
+		}
+		if summaryIDsSet.Contains(summaryID) {
+			p.net.log.Debug("message contains duplicate of summary ID %s", summaryID)
+			return
+		}
+		summaryIDs[i] = summaryID
+		summaryIDsSet.Add(summaryID)
+	}
+
+	p.net.router.AcceptedStateSummary(p.id, chainID, requestID, summaryIDs)
+}
@@ -5,6 +5,8 @@ package network
 
 import (
 	"time"
+
+	"github.com/corpetty/avalanchego/utils/json"
 )
 
 // PeerID ...
@@ -15,4 +17,29 @@ type PeerID struct {
 	Version      string    `json:"version"`
 	LastSent     time.Time `json:"lastSent"`
 	LastReceived time.Time `json:"lastReceived"`
+
+	// Time the connection to the peer was established
+	ConnectedAt time.Time `json:"connectedAt"`
+	// Number of seconds the connection to the peer has been established for
+	Uptime json.Uint64 `json:"uptime"`
+	// Round trip time, in milliseconds, of the last ping that the peer
+	// responded to. Zero if the peer hasn't responded to a ping yet.
+	RoundTripTime json.Uint64 `json:"roundTripTime"`
+
+	MessagesSent     json.Uint64 `json:"messagesSent"`
+	MessagesReceived json.Uint64 `json:"messagesReceived"`
+	BytesSent        json.Uint64 `json:"bytesSent"`
+	BytesReceived    json.Uint64 `json:"bytesReceived"`
+	// Message type --> statistics of the messages of that type exchanged with
+	// the peer
+	Messages map[string]*MessageStats `json:"messages"`
+}
+
+// MessageStats are the statistics of the messages of a type that were
+// exchanged with a peer
+type MessageStats struct {
+	Sent          json.Uint64 `json:"sent"`
+	Received      json.Uint64 `json:"received"`
+	BytesSent     json.Uint64 `json:"bytesSent"`
+	BytesReceived json.Uint64 `json:"bytesReceived"`
 }
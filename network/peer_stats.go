@@ -0,0 +1,68 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"sync"
+
+	"github.com/corpetty/avalanchego/utils/json"
+)
+
+// peerStats tracks the messages that were exchanged with a peer
+type peerStats struct {
+	lock sync.Mutex
+	// Op --> statistics of the messages with that op
+	ops map[Op]*MessageStats
+}
+
+// sent records that a message with [op] of [numBytes] was sent to the peer
+func (s *peerStats) sent(op Op, numBytes int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats := s.get(op)
+	stats.Sent++
+	stats.BytesSent += json.Uint64(numBytes)
+}
+
+// received records that a message with [op] of [numBytes] was received from
+// the peer
+func (s *peerStats) received(op Op, numBytes int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats := s.get(op)
+	stats.Received++
+	stats.BytesReceived += json.Uint64(numBytes)
+}
+
+// fill sets the message statistics of [peerID]
+func (s *peerStats) fill(peerID *PeerID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	peerID.Messages = make(map[string]*MessageStats, len(s.ops))
+	for op, stats := range s.ops {
+		statsCopy := *stats
+		peerID.Messages[op.String()] = &statsCopy
+
+		peerID.MessagesSent += stats.Sent
+		peerID.MessagesReceived += stats.Received
+		peerID.BytesSent += stats.BytesSent
+		peerID.BytesReceived += stats.BytesReceived
+	}
+}
+
+// assumes the lock is held
+func (s *peerStats) get(op Op) *MessageStats {
+	if s.ops == nil {
+		s.ops = make(map[Op]*MessageStats)
+	}
+	stats, ok := s.ops[op]
+	if !ok {
+		stats = &MessageStats{}
+		s.ops[op] = stats
+	}
+	return stats
+}
@@ -0,0 +1,36 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/corpetty/avalanchego/utils/json"
+)
+
+func TestPeerStats(t *testing.T) {
+	stats := peerStats{}
+	stats.sent(Ping, 5)
+	stats.sent(Ping, 5)
+	stats.received(Pong, 5)
+	stats.sent(Put, 100)
+	stats.received(Put, 200)
+
+	peerID := PeerID{}
+	stats.fill(&peerID)
+
+	assert.Equal(t, json.Uint64(3), peerID.MessagesSent)
+	assert.Equal(t, json.Uint64(2), peerID.MessagesReceived)
+	assert.Equal(t, json.Uint64(110), peerID.BytesSent)
+	assert.Equal(t, json.Uint64(205), peerID.BytesReceived)
+	assert.Equal(t, &MessageStats{Sent: 2, BytesSent: 10}, peerID.Messages[Ping.String()])
+	assert.Equal(t, &MessageStats{Received: 1, BytesReceived: 5}, peerID.Messages[Pong.String()])
+	assert.Equal(t, &MessageStats{Sent: 1, Received: 1, BytesSent: 100, BytesReceived: 200}, peerID.Messages[Put.String()])
+
+	// The reported statistics shouldn't change as more messages are exchanged
+	stats.sent(Put, 100)
+	assert.Equal(t, json.Uint64(1), peerID.Messages[Put.String()].Sent)
+}
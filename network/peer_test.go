@@ -0,0 +1,37 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerCompressionList(t *testing.T) {
+	p := &peer{net: &network{compressionEnabled: true}}
+	_, ok := p.sendCompression()
+	assert.False(t, ok, "messages shouldn't be compressed before the peer announces its compressions")
+
+	// Compressions this node doesn't support are ignored
+	msg, err := TestBuilder.CompressionList([]Compression{math.MaxUint8})
+	assert.NoError(t, err)
+	p.compressionList(msg)
+	_, ok = p.sendCompression()
+	assert.False(t, ok)
+
+	msg, err = TestBuilder.CompressionList([]Compression{math.MaxUint8, GzipCompression})
+	assert.NoError(t, err)
+	p.compressionList(msg)
+	compression, ok := p.sendCompression()
+	assert.True(t, ok)
+	assert.Equal(t, GzipCompression, compression)
+
+	// Compression can be disabled regardless of what the peer supports
+	p = &peer{net: &network{compressionEnabled: false}}
+	p.compressionList(msg)
+	_, ok = p.sendCompression()
+	assert.False(t, ok)
+}
@@ -0,0 +1,187 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils"
+	"github.com/corpetty/avalanchego/utils/hashing"
+	"github.com/corpetty/avalanchego/utils/sampler"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+)
+
+const (
+	// The unsigned portion of a claim is the IP (16 byte address and a 2 byte
+	// port) followed by the timestamp
+	unsignedIPLen = 16 + wrappers.ShortLen + wrappers.LongLen
+
+	// A SignedPeerList message starts with its opcode followed by the number
+	// of claims it holds
+	signedPeerListHeaderLen = 1 + wrappers.IntLen
+)
+
+var (
+	errBadType               = errors.New("wrong type passed")
+	errNoStakingCert         = errors.New("no staking certificate provided")
+	errUnsupportedStakingKey = errors.New("unsupported staking key type")
+)
+
+// SignedIP is a node's claim that it was reachable at [IP] as of [Timestamp].
+// The claim is signed with the staking key of the node that made it, and
+// carries the node's staking certificate so that anyone can verify the claim
+// and derive the ID of the node that made it.
+type SignedIP struct {
+	IP        utils.IPDesc
+	Timestamp uint64 // Unix time, in seconds, that the claim was made at
+	Cert      []byte // DER encoding of the node's staking certificate
+	Signature []byte // Signature of the claim's IP and timestamp
+}
+
+// newSignedIP returns a claim to [ip] as of [timestamp] that is signed by the
+// private key of [cert]
+func newSignedIP(cert *tls.Certificate, ip utils.IPDesc, timestamp uint64) (*SignedIP, error) {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil, errNoStakingCert
+	}
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errUnsupportedStakingKey
+	}
+
+	claim := &SignedIP{
+		IP:        ip,
+		Timestamp: timestamp,
+		Cert:      cert.Certificate[0],
+	}
+	unsignedBytes, err := claim.unsignedBytes()
+	if err != nil {
+		return nil, err
+	}
+	claim.Signature, err = signer.Sign(rand.Reader, hashing.ComputeHash256(unsignedBytes), crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't sign IP claim: %w", err)
+	}
+	return claim, nil
+}
+
+// NodeID returns the ID of the node whose staking certificate is in the claim.
+// This doesn't verify that the node actually made the claim.
+func (s *SignedIP) NodeID() ids.ShortID {
+	return ids.ShortID(
+		hashing.ComputeHash160Array(
+			hashing.ComputeHash256(s.Cert)))
+}
+
+// Verify returns nil if the claim was signed by the private key of the staking
+// certificate that it carries.
+func (s *SignedIP) Verify() error {
+	cert, err := x509.ParseCertificate(s.Cert)
+	if err != nil {
+		return fmt.Errorf("couldn't parse staking certificate: %w", err)
+	}
+
+	var algorithm x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		algorithm = x509.SHA256WithRSA
+	case *ecdsa.PublicKey:
+		algorithm = x509.ECDSAWithSHA256
+	default:
+		return errUnsupportedStakingKey
+	}
+
+	unsignedBytes, err := s.unsignedBytes()
+	if err != nil {
+		return err
+	}
+	return cert.CheckSignature(algorithm, unsignedBytes, s.Signature)
+}
+
+// unsignedBytes returns the bytes of the claim that are signed
+func (s *SignedIP) unsignedBytes() ([]byte, error) {
+	p := wrappers.Packer{MaxSize: unsignedIPLen}
+	p.PackIP(s.IP)
+	p.PackLong(s.Timestamp)
+	return p.Bytes, p.Err
+}
+
+// packedLen returns the number of bytes the claim takes up in a SignedPeerList
+// message
+func (s *SignedIP) packedLen() int {
+	return unsignedIPLen +
+		wrappers.IntLen + len(s.Cert) +
+		wrappers.IntLen + len(s.Signature)
+}
+
+// sampleSignedIPs returns [myClaim], if it isn't nil, followed by a random
+// sample of [claims] such that a SignedPeerList message holding them is at most
+// [maxSize] bytes long.
+func sampleSignedIPs(myClaim *SignedIP, claims []SignedIP, maxSize int) ([]SignedIP, error) {
+	size := signedPeerListHeaderLen
+	sampled := make([]SignedIP, 0, len(claims)+1)
+	if myClaim != nil && size+myClaim.packedLen() <= maxSize {
+		size += myClaim.packedLen()
+		sampled = append(sampled, *myClaim)
+	}
+
+	s := sampler.NewUniform()
+	if err := s.Initialize(uint64(len(claims))); err != nil {
+		return nil, err
+	}
+	indices, err := s.Sample(len(claims))
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		claim := claims[int(index)]
+		claimLen := claim.packedLen()
+		if size+claimLen > maxSize {
+			// A smaller claim may still fit
+			continue
+		}
+		size += claimLen
+		sampled = append(sampled, claim)
+	}
+	return sampled, nil
+}
+
+// packSignedIPs attempts to pack the value as a list of signed IPs
+func packSignedIPs(p *wrappers.Packer, valIntf interface{}) {
+	claims, ok := valIntf.([]SignedIP)
+	if !ok {
+		p.Add(errBadType)
+		return
+	}
+	p.PackInt(uint32(len(claims)))
+	for i := 0; i < len(claims) && !p.Errored(); i++ {
+		p.PackIP(claims[i].IP)
+		p.PackLong(claims[i].Timestamp)
+		p.PackBytes(claims[i].Cert)
+		p.PackBytes(claims[i].Signature)
+	}
+}
+
+// unpackSignedIPs attempts to unpack the value as a list of signed IPs
+func unpackSignedIPs(p *wrappers.Packer) interface{} {
+	numClaims := p.UnpackInt()
+	claims := []SignedIP(nil)
+	for i := uint32(0); i < numClaims && !p.Errored(); i++ {
+		claims = append(claims, SignedIP{
+			IP:        p.UnpackIP(),
+			Timestamp: p.UnpackLong(),
+			Cert:      p.UnpackBytes(),
+			Signature: p.UnpackBytes(),
+		})
+	}
+	return claims
+}
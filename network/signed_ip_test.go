@@ -0,0 +1,178 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/validators"
+	"github.com/corpetty/avalanchego/utils"
+	"github.com/corpetty/avalanchego/utils/hashing"
+)
+
+func newTestStakingCert(t *testing.T) *tls.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Now().AddDate(100, 0, 0),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageDataEncipherment,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{certBytes},
+		PrivateKey:  key,
+	}
+}
+
+func TestSignedIP(t *testing.T) {
+	cert := newTestStakingCert(t)
+	ip := utils.IPDesc{IP: net.IPv4(1, 2, 3, 4), Port: 9651}
+
+	claim, err := newSignedIP(cert, ip, 12345)
+	assert.NoError(t, err)
+	assert.NoError(t, claim.Verify())
+
+	// The node ID of a claim must match the ID the TLS upgrader derives
+	expectedID := ids.ShortID(
+		hashing.ComputeHash160Array(
+			hashing.ComputeHash256(cert.Certificate[0])))
+	assert.Equal(t, expectedID, claim.NodeID())
+
+	movedClaim := *claim
+	movedClaim.IP = utils.IPDesc{IP: net.IPv4(5, 6, 7, 8), Port: 9651}
+	assert.Error(t, movedClaim.Verify(), "claim to a different IP shouldn't verify")
+
+	replayedClaim := *claim
+	replayedClaim.Timestamp++
+	assert.Error(t, replayedClaim.Verify(), "claim with a different timestamp shouldn't verify")
+
+	otherClaim, err := newSignedIP(newTestStakingCert(t), ip, 12345)
+	assert.NoError(t, err)
+	stolenClaim := *claim
+	stolenClaim.Signature = otherClaim.Signature
+	assert.Error(t, stolenClaim.Verify(), "claim signed by a different key shouldn't verify")
+
+	_, err = newSignedIP(nil, ip, 12345)
+	assert.Error(t, err)
+}
+
+func TestBuildSignedPeerList(t *testing.T) {
+	claim, err := newSignedIP(newTestStakingCert(t), utils.IPDesc{IP: net.IPv6loopback, Port: 12345}, 12345)
+	assert.NoError(t, err)
+	claims := []SignedIP{*claim}
+
+	msg, err := TestBuilder.SignedPeerList(claims)
+	assert.NoError(t, err)
+	assert.NotNil(t, msg)
+	assert.Equal(t, SignedPeerList, msg.Op())
+	assert.Equal(t, claims, msg.Get(SignedPeers))
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.NotNil(t, parsedMsg)
+	assert.Equal(t, SignedPeerList, parsedMsg.Op())
+	parsedClaims := parsedMsg.Get(SignedPeers).([]SignedIP)
+	assert.Len(t, parsedClaims, 1)
+	assert.NoError(t, parsedClaims[0].Verify())
+}
+
+func TestTrackSignedIP(t *testing.T) {
+	vdrs := validators.NewSet()
+	n := &network{
+		id:                 ids.GenerateTestShortID(),
+		ip:                 utils.NewDynamicIPDesc(net.IPv6loopback, 9651),
+		vdrs:               vdrs,
+		maxClockDifference: time.Minute,
+		disconnectedIPs:    make(map[string]struct{}),
+		connectedIPs:       make(map[string]struct{}),
+		retryDelay:         make(map[string]time.Duration),
+		myIPs:              make(map[string]struct{}),
+		peers:              make(map[ids.ShortID]*peer),
+		peerIPClaims:       make(map[ids.ShortID]*SignedIP),
+	}
+	// Don't dial the claimed IPs
+	n.closed.SetValue(true)
+	n.clock.Set(time.Unix(1000, 0))
+
+	cert := newTestStakingCert(t)
+	oldClaim, err := newSignedIP(cert, utils.IPDesc{IP: net.IPv4(1, 2, 3, 4), Port: 9651}, 900)
+	assert.NoError(t, err)
+	newClaim, err := newSignedIP(cert, utils.IPDesc{IP: net.IPv4(5, 6, 7, 8), Port: 9651}, 950)
+	assert.NoError(t, err)
+	futureClaim, err := newSignedIP(cert, utils.IPDesc{IP: net.IPv4(9, 9, 9, 9), Port: 9651}, 2000)
+	assert.NoError(t, err)
+	nodeID := oldClaim.NodeID()
+
+	assert.Equal(t, errNotValidator, n.trackSignedIP(*oldClaim), "only validators should be able to claim IPs")
+	assert.Empty(t, n.peerIPClaims)
+
+	assert.NoError(t, vdrs.AddWeight(nodeID, 1))
+	assert.Equal(t, errFutureClaim, n.trackSignedIP(*futureClaim))
+	assert.Empty(t, n.peerIPClaims)
+
+	forgedClaim := *oldClaim
+	forgedClaim.IP = utils.IPDesc{IP: net.IPv4(6, 6, 6, 6), Port: 9651}
+	assert.Error(t, n.trackSignedIP(forgedClaim))
+	assert.Empty(t, n.peerIPClaims)
+
+	assert.NoError(t, n.trackSignedIP(*oldClaim))
+	assert.Equal(t, oldClaim.IP, n.peerIPClaims[nodeID].IP)
+
+	assert.NoError(t, n.trackSignedIP(*newClaim))
+	assert.Equal(t, newClaim.IP, n.peerIPClaims[nodeID].IP, "newer claim should replace the older one")
+
+	assert.NoError(t, n.trackSignedIP(*oldClaim))
+	assert.Equal(t, newClaim.IP, n.peerIPClaims[nodeID].IP, "older claim shouldn't replace the newer one")
+}
+
+func TestSampleSignedIPs(t *testing.T) {
+	cert := newTestStakingCert(t)
+	myClaim, err := newSignedIP(cert, utils.IPDesc{IP: net.IPv4(1, 2, 3, 4), Port: 9651}, 12345)
+	assert.NoError(t, err)
+
+	// Far more claims than fit in a single message. They only differ in their
+	// timestamp, so their signatures don't verify, but their sizes are right.
+	claims := make([]SignedIP, 2*int(DefaultMaxMessageSize)/myClaim.packedLen())
+	for i := range claims {
+		claims[i] = *myClaim
+		claims[i].Timestamp = uint64(i)
+	}
+
+	sampled, err := sampleSignedIPs(myClaim, claims, int(DefaultMaxMessageSize))
+	assert.NoError(t, err)
+	assert.Equal(t, *myClaim, sampled[0], "this node's claim should always be sent")
+	assert.Less(t, len(sampled), len(claims))
+
+	msg, err := TestBuilder.SignedPeerList(sampled)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, len(msg.Bytes()), int(DefaultMaxMessageSize))
+	assert.Greater(t, len(msg.Bytes())+myClaim.packedLen(), int(DefaultMaxMessageSize), "the message should be full")
+
+	parsedMsg, err := TestBuilder.Parse(msg.Bytes())
+	assert.NoError(t, err)
+	assert.Len(t, parsedMsg.Get(SignedPeers), len(sampled))
+
+	// Claims that fit are all sent
+	sampled, err = sampleSignedIPs(nil, claims[:10], int(DefaultMaxMessageSize))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, claims[:10], sampled)
+}
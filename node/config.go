@@ -44,6 +44,8 @@ type Config struct {
 
 	// Database to use for the node
 	DB database.Database
+	// Backend of [DB]. One of backend.Types.
+	DBType string
 
 	// Staking configuration
 	StakingIP             utils.DynamicIPDesc
@@ -60,6 +62,9 @@ type Config struct {
 	SendQueueSize           uint32
 	MaxPendingMsgs          uint32
 
+	// Compression
+	NetworkCompressionEnabled bool
+
 	// Network configuration
 	NetworkConfig timer.AdaptiveTimeoutConfig
 
@@ -92,6 +97,15 @@ type Config struct {
 	// Plugin directory
 	PluginDir string
 
+	// VM ID --> Aliases of the VM, which plugins may be named after
+	VMAliases map[ids.ID][]string
+
+	// Directory holding the config files of chains
+	ChainConfigDir string
+
+	// Directory holding the config files of subnets
+	SubnetConfigDir string
+
 	// Consensus configuration
 	ConsensusParams avalanche.Parameters
 
@@ -104,6 +118,14 @@ type Config struct {
 	IPCPath            string
 	IPCDefaultChainIDs []string
 
+	// Events API configuration
+	EventsAPIEnabled bool
+
+	// Index configuration
+	IndexEnabled         bool
+	IndexTransactions    bool
+	IndexAllowIncomplete bool
+
 	// Router that is used to handle incoming consensus messages
 	ConsensusRouter          router.Router
 	ConsensusGossipFrequency time.Duration
@@ -19,6 +19,7 @@ import (
 
 	"github.com/corpetty/avalanchego/api"
 	"github.com/corpetty/avalanchego/api/admin"
+	"github.com/corpetty/avalanchego/api/events"
 	"github.com/corpetty/avalanchego/api/health"
 	"github.com/corpetty/avalanchego/api/info"
 	"github.com/corpetty/avalanchego/api/keystore"
@@ -27,9 +28,11 @@ import (
 	"github.com/corpetty/avalanchego/chains/atomic"
 	"github.com/corpetty/avalanchego/database"
 	"github.com/corpetty/avalanchego/database/meterdb"
+	"github.com/corpetty/avalanchego/database/pebble"
 	"github.com/corpetty/avalanchego/database/prefixdb"
 	"github.com/corpetty/avalanchego/genesis"
 	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/indexer"
 	"github.com/corpetty/avalanchego/ipcs"
 	"github.com/corpetty/avalanchego/network"
 	"github.com/corpetty/avalanchego/snow/networking/benchlist"
@@ -51,6 +54,7 @@ import (
 	"github.com/corpetty/avalanchego/vms/nftfx"
 	"github.com/corpetty/avalanchego/vms/platformvm"
 	"github.com/corpetty/avalanchego/vms/propertyfx"
+	"github.com/corpetty/avalanchego/vms/registry"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm"
 	"github.com/corpetty/avalanchego/vms/secp256k1fx"
 	"github.com/corpetty/avalanchego/vms/timestampvm"
@@ -68,7 +72,7 @@ var (
 	genesisHashKey = []byte("genesisID")
 
 	// Version is the version of this code
-	Version                 = version.NewDefaultVersion(constants.PlatformName, 1, 2, 0)
+	Version                 = version.NewDefaultVersion(constants.PlatformName, 1, 2, 2)
 	versionParser           = version.NewDefaultParser()
 	beaconConnectionTimeout = 1 * time.Minute
 )
@@ -85,6 +89,8 @@ type Node struct {
 
 	// Storage for this node
 	DB database.Database
+	// True if [DB] was created by this run of the node
+	newDB bool
 
 	// Handles calls to Keystore API
 	keystoreServer keystore.Keystore
@@ -101,12 +107,24 @@ type Node struct {
 	// Manages Virtual Machines
 	vmManager vms.Manager
 
+	// Registers the VMs in the plugin directory
+	vmRegistry registry.VMRegistry
+
+	// Manages the benchlists of the chains
+	benchlistManager benchlist.Manager
+
 	// dispatcher for events as they happen in consensus
 	DecisionDispatcher  *triggers.EventDispatcher
 	ConsensusDispatcher *triggers.EventDispatcher
 
 	IPCs *ipcs.ChainIPCs
 
+	// Indexes the containers accepted by each chain
+	Indexer *indexer.Indexer
+
+	// Streams the decisions of each chain over websockets
+	EventsAPI *events.Manager
+
 	// Net runs the networking stack
 	Net network.Network
 
@@ -152,12 +170,18 @@ func (n *Node) initNetworking() error {
 	}
 	dialer := network.NewDialer(TCP)
 
-	var serverUpgrader, clientUpgrader network.Upgrader
+	var (
+		serverUpgrader, clientUpgrader network.Upgrader
+		// Used to sign this node's IP. nil if TLS is disabled, since this
+		// node's ID isn't derived from its staking certificate then.
+		stakingCert *tls.Certificate
+	)
 	if n.Config.EnableP2PTLS {
 		cert, err := tls.LoadX509KeyPair(n.Config.StakingCertFile, n.Config.StakingKeyFile)
 		if err != nil {
 			return err
 		}
+		stakingCert = &cert
 
 		// #nosec G402
 		tlsConfig := &tls.Config{
@@ -247,6 +271,8 @@ func (n *Node) initNetworking() error {
 		n.Config.DisconnectedRestartTimeout,
 		n.Config.ApricotPhase0Time,
 		n.Config.SendQueueSize,
+		n.Config.NetworkCompressionEnabled,
+		stakingCert,
 	)
 
 	n.nodeCloser = utils.HandleSignals(func(os.Signal) {
@@ -378,6 +404,7 @@ func (n *Node) initDatabase() error {
 
 	rawGenesisHash, err := n.DB.Get(genesisHashKey)
 	if err == database.ErrNotFound {
+		n.newDB = true
 		rawGenesisHash = rawExpectedGenesisHash
 		err = n.DB.Put(genesisHashKey, rawGenesisHash)
 	}
@@ -467,6 +494,52 @@ func (n *Node) initIPCs() error {
 	return err
 }
 
+// initIndexer initializes the indexer, which indexes the containers accepted by
+// each chain and exposes them through the Index API.
+// Assumes n.DB, n.chainManager and the event dispatchers are already
+// initialized
+func (n *Node) initIndexer() error {
+	indexerDB := prefixdb.New([]byte("indexer"), n.DB)
+	if err := indexer.CheckHistory(indexerDB, n.Log, n.Config.IndexEnabled, n.Config.IndexAllowIncomplete, n.newDB); err != nil {
+		return err
+	}
+	if !n.Config.IndexEnabled {
+		n.Log.Info("skipping indexer initialization because it has been disabled")
+		return nil
+	}
+	n.Log.Info("initializing indexer")
+	n.Indexer = indexer.NewIndexer(indexer.Config{
+		DB:                  indexerDB,
+		Log:                 n.Log,
+		HTTPLog:             n.HTTPLog,
+		DecisionDispatcher:  n.DecisionDispatcher,
+		ConsensusDispatcher: n.ConsensusDispatcher,
+		APIServer:           &n.APIServer,
+	})
+	n.chainManager.AddRegistrant(n.Indexer)
+	return nil
+}
+
+// initEventsAPI initializes the events API, which streams the decisions of each
+// chain over websockets.
+// Assumes n.APIServer, n.chainManager and the event dispatchers are already
+// initialized
+func (n *Node) initEventsAPI() error {
+	if !n.Config.EventsAPIEnabled {
+		n.Log.Info("skipping events API initialization because it has been disabled")
+		return nil
+	}
+	n.Log.Info("initializing events API")
+	n.EventsAPI = events.NewManager(events.Config{
+		Log:                n.Log,
+		HTTPLog:            n.HTTPLog,
+		DecisionDispatcher: n.DecisionDispatcher,
+		APIServer:          &n.APIServer,
+	})
+	n.chainManager.AddRegistrant(n.EventsAPI)
+	return nil
+}
+
 // Initializes the Platform chain.
 // Its genesis data specifies the other chains that should
 // be created.
@@ -504,6 +577,7 @@ func (n *Node) initAPIServer() error {
 // Assumes n.DB, n.vdrs all initialized (non-nil)
 func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 	n.vmManager = vms.NewManager(&n.APIServer, n.HTTPLog)
+	n.vmRegistry = registry.NewVMRegistry(n.Config.PluginDir, n.vmManager, n.Log)
 
 	createAVMTx, err := genesis.VMGenesis(n.Config.GenesisBytes, avm.ID)
 	if err != nil {
@@ -521,11 +595,11 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 
 	// Configure benchlist
 	n.Config.BenchlistConfig.Validators = n.vdrs
-	benchlistManager := benchlist.NewManager(&n.Config.BenchlistConfig)
+	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
 
 	// Manages network timeouts
 	timeoutManager := timeout.Manager{}
-	if err := timeoutManager.Initialize(&n.Config.NetworkConfig, benchlistManager); err != nil {
+	if err := timeoutManager.Initialize(&n.Config.NetworkConfig, n.benchlistManager); err != nil {
 		return err
 	}
 	go n.Log.RecoverAndPanic(timeoutManager.Dispatch)
@@ -570,6 +644,8 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 		TimeoutManager:          &timeoutManager,
 		HealthService:           n.healthService,
 		WhitelistedSubnets:      n.Config.WhitelistedSubnets,
+		ChainConfigDir:          n.Config.ChainConfigDir,
+		SubnetConfigDir:         n.Config.SubnetConfigDir,
 	})
 
 	vdrs := n.vdrs
@@ -599,10 +675,13 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			MaxStakeDuration:   n.Config.MaxStakeDuration,
 			StakeMintingPeriod: n.Config.StakeMintingPeriod,
 			ApricotPhase0Time:  n.Config.ApricotPhase0Time,
+			ApricotPhase1Time:  n.Config.ApricotPhase1Time,
 		}),
 		n.vmManager.RegisterVMFactory(avm.ID, &avm.Factory{
-			CreationFee: n.Config.CreationTxFee,
-			Fee:         n.Config.TxFee,
+			CreationFee:          n.Config.CreationTxFee,
+			Fee:                  n.Config.TxFee,
+			IndexTransactions:    n.Config.IndexTransactions,
+			IndexAllowIncomplete: n.Config.IndexAllowIncomplete,
 		}),
 		n.vmManager.RegisterVMFactory(evm.ID, &rpcchainvm.Factory{
 			Path:   filepath.Join(n.Config.PluginDir, "evm"),
@@ -670,6 +749,13 @@ func (n *Node) initMetricsAPI() error {
 	}
 	n.DB = db
 
+	// Pebble reports its internal stats, such as compactions and write stalls
+	if pebbleDB, ok := n.Config.DB.(*pebble.Database); ok {
+		if err := registry.Register(pebble.NewCollector(dbNamespace, pebbleDB)); err != nil {
+			return err
+		}
+	}
+
 	return n.APIServer.AddRoute(handler, &sync.RWMutex{}, "metrics", "", n.HTTPLog)
 }
 
@@ -681,7 +767,7 @@ func (n *Node) initAdminAPI() error {
 		return nil
 	}
 	n.Log.Info("initializing admin API")
-	service, err := admin.NewService(n.Log, n.chainManager, &n.APIServer)
+	service, err := admin.NewService(n.Log, n.chainManager, &n.APIServer, n.Config.DB, n.Config.DBType, n.vmRegistry)
 	if err != nil {
 		return err
 	}
@@ -701,6 +787,7 @@ func (n *Node) initInfoAPI() error {
 		n.Config.NetworkID,
 		n.chainManager,
 		n.Net,
+		n.benchlistManager,
 		n.Config.CreationTxFee,
 		n.Config.TxFee,
 	)
@@ -791,6 +878,13 @@ func (n *Node) initAliases(genesisBytes []byte) error {
 			}
 		}
 	}
+	for vmID, aliases := range n.Config.VMAliases {
+		for _, alias := range aliases {
+			if err := n.vmManager.Alias(vmID, alias); err != nil {
+				return err
+			}
+		}
+	}
 	for url, aliases := range defaultAliases {
 		if err := n.APIServer.AddAliases(url, aliases...); err != nil {
 			return err
@@ -799,6 +893,21 @@ func (n *Node) initAliases(genesisBytes []byte) error {
 	return nil
 }
 
+// initPluginVMs registers the VMs in the plugin directory that weren't
+// registered by initChainManager. A plugin that can't be registered doesn't
+// prevent the node from starting.
+// Assumes n.vmRegistry is initialized and VM aliases have been set
+func (n *Node) initPluginVMs() error {
+	n.Log.Info("initializing plugin VMs")
+	if _, err := os.Stat(n.Config.PluginDir); os.IsNotExist(err) {
+		n.Log.Info("skipping plugin VM initialization because plugin directory %s doesn't exist", n.Config.PluginDir)
+		return nil
+	}
+	// The registry logs each plugin it registers or fails to register
+	_, _, err := n.vmRegistry.Reload()
+	return err
+}
+
 // Initialize this node
 func (n *Node) Initialize(
 	config *Config,
@@ -870,9 +979,18 @@ func (n *Node) Initialize(
 	if err := n.initIPCAPI(); err != nil { // Start the IPC API
 		return fmt.Errorf("couldn't initialize the IPC API: %w", err)
 	}
+	if err := n.initIndexer(); err != nil { // Start the indexer
+		return fmt.Errorf("couldn't initialize indexer: %w", err)
+	}
+	if err := n.initEventsAPI(); err != nil { // Start the events API
+		return fmt.Errorf("couldn't initialize events API: %w", err)
+	}
 	if err := n.initAliases(n.Config.GenesisBytes); err != nil { // Set up aliases
 		return fmt.Errorf("couldn't initialize aliases: %w", err)
 	}
+	if err := n.initPluginVMs(); err != nil { // Register the VMs in the plugin directory
+		return fmt.Errorf("couldn't initialize plugin VMs: %w", err)
+	}
 	if err := n.initChains(n.Config.GenesisBytes, n.Config.AvaxAssetID); err != nil { // Start the Platform chain
 		return fmt.Errorf("couldn't initialize chains: %w", err)
 	}
@@ -896,6 +1014,14 @@ func (n *Node) shutdown() {
 	if n.chainManager != nil {
 		n.chainManager.Shutdown()
 	}
+	if n.Indexer != nil {
+		if err := n.Indexer.Close(); err != nil {
+			n.Log.Debug("error during indexer shutdown: %s", err)
+		}
+	}
+	if n.EventsAPI != nil {
+		n.EventsAPI.Close()
+	}
 	if n.Net != nil {
 		// Close already logs its own error if one occurs, so the error is ignored here
 		_ = n.Net.Close()
@@ -41,6 +41,16 @@ type SubnetLookup interface {
 	SubnetID(chainID ids.ID) (ids.ID, error)
 }
 
+// ValidatorLookup ...
+type ValidatorLookup interface {
+	// GetCurrentHeight returns the height of the last accepted P-chain block
+	GetCurrentHeight() (uint64, error)
+
+	// GetValidatorSet returns the weight of each validator of [subnetID] as
+	// of the P-chain block at [height]
+	GetValidatorSet(height uint64, subnetID ids.ID) (map[ids.ShortID]uint64, error)
+}
+
 // Context is information about the current execution.
 // [NetworkID] is the ID of the network this context exists within.
 // [ChainID] is the ID of the chain this context exists within.
@@ -62,6 +72,7 @@ type Context struct {
 	SharedMemory        atomic.SharedMemory
 	BCLookup            AliasLookup
 	SNLookup            SubnetLookup
+	VDRLookup           ValidatorLookup
 	Namespace           string
 	Metrics             prometheus.Registerer
 
@@ -416,3 +416,23 @@ func (b *Bootstrapper) Disconnected(validatorID ids.ShortID) error {
 	}
 	return b.Bootstrapper.Disconnected(validatorID)
 }
+
+// AppRequest implements the Engine interface.
+func (b *Bootstrapper) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	return b.VM.AppRequest(nodeID, requestID, request)
+}
+
+// AppResponse implements the Engine interface.
+func (b *Bootstrapper) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	return b.VM.AppResponse(nodeID, requestID, response)
+}
+
+// AppRequestFailed implements the Engine interface.
+func (b *Bootstrapper) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	return b.VM.AppRequestFailed(nodeID, requestID)
+}
+
+// AppGossip implements the Engine interface.
+func (b *Bootstrapper) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	return b.VM.AppGossip(nodeID, msg)
+}
@@ -20,3 +20,11 @@ type Bootstrapable interface {
 	// if they occur.
 	ForceAccepted(acceptedContainerIDs []ids.ID) error
 }
+
+// StateSyncer may optionally be implemented by a Bootstrapable that is able to
+// sync its state from a summary before bootstrapping the remaining containers.
+type StateSyncer interface {
+	// Start the state sync phase. Once state sync has completed, or if it is
+	// skipped, the StateSyncer is responsible for starting bootstrapping.
+	StartStateSync() error
+}
@@ -78,6 +78,15 @@ func (b *Bootstrapper) Initialize(config Config) error {
 // Startup implements the Engine interface.
 func (b *Bootstrapper) Startup() error {
 	b.started = true
+	if syncer, ok := b.Bootstrapable.(StateSyncer); ok {
+		return syncer.StartStateSync()
+	}
+	return b.StartBootstrapping()
+}
+
+// StartBootstrapping asks the bootstrap validators for their accepted
+// frontier.
+func (b *Bootstrapper) StartBootstrapping() error {
 	if b.pendingAcceptedFrontier.Len() == 0 {
 		b.Ctx.Log.Info("Bootstrapping skipped due to no provided bootstraps")
 		return b.Bootstrapable.ForceAccepted(nil)
@@ -188,6 +197,46 @@ func (b *Bootstrapper) Accepted(validatorID ids.ShortID, requestID uint32, conta
 	return b.Bootstrapable.ForceAccepted(accepted)
 }
 
+// GetStateSummaryFrontier implements the Engine interface.
+func (b *Bootstrapper) GetStateSummaryFrontier(validatorID ids.ShortID, requestID uint32) error {
+	// This chain doesn't support state sync, so report that there is no state
+	// summary to sync to.
+	b.Sender.StateSummaryFrontier(validatorID, requestID, nil)
+	return nil
+}
+
+// StateSummaryFrontier implements the Engine interface.
+func (b *Bootstrapper) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) error {
+	b.Ctx.Log.Debug("Received a StateSummaryFrontier message from %s unexpectedly", validatorID)
+	return nil
+}
+
+// GetStateSummaryFrontierFailed implements the Engine interface.
+func (b *Bootstrapper) GetStateSummaryFrontierFailed(validatorID ids.ShortID, requestID uint32) error {
+	b.Ctx.Log.Debug("Received a GetStateSummaryFrontierFailed message from %s unexpectedly", validatorID)
+	return nil
+}
+
+// GetAcceptedStateSummary implements the Engine interface.
+func (b *Bootstrapper) GetAcceptedStateSummary(validatorID ids.ShortID, requestID uint32, heights []uint64) error {
+	// This chain doesn't support state sync, so none of the requested state
+	// summaries are accepted.
+	b.Sender.AcceptedStateSummary(validatorID, requestID, nil)
+	return nil
+}
+
+// AcceptedStateSummary implements the Engine interface.
+func (b *Bootstrapper) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) error {
+	b.Ctx.Log.Debug("Received an AcceptedStateSummary message from %s unexpectedly", validatorID)
+	return nil
+}
+
+// GetAcceptedStateSummaryFailed implements the Engine interface.
+func (b *Bootstrapper) GetAcceptedStateSummaryFailed(validatorID ids.ShortID, requestID uint32) error {
+	b.Ctx.Log.Debug("Received a GetAcceptedStateSummaryFailed message from %s unexpectedly", validatorID)
+	return nil
+}
+
 // Connected implements the Engine interface.
 func (b *Bootstrapper) Connected(validatorID ids.ShortID) error {
 	if b.started {
@@ -36,6 +36,8 @@ type ExternalHandler interface {
 	AcceptedHandler
 	FetchHandler
 	QueryHandler
+	AppHandler
+	StateSyncHandler
 }
 
 // FrontierHandler defines how a consensus engine reacts to frontier messages
@@ -276,6 +278,117 @@ type QueryHandler interface {
 	QueryFailed(validatorID ids.ShortID, requestID uint32) error
 }
 
+// StateSyncHandler defines how a consensus engine reacts to state sync
+// messages from other validators. Functions only return fatal errors if they
+// occur.
+type StateSyncHandler interface {
+	// Notify this engine of a request for the most recent state summary.
+	//
+	// This function can be called by any validator. It is not safe to assume
+	// this message is utilizing a unique requestID. However, the validatorID is
+	// assumed to be authenticated.
+	//
+	// This engine should respond with a StateSummaryFrontier message with the
+	// same requestID, and the engine's most recent state summary. If the engine
+	// doesn't support state sync, the summary should be empty.
+	GetStateSummaryFrontier(validatorID ids.ShortID, requestID uint32) error
+
+	// Notify this engine of a state summary.
+	//
+	// This function can be called by any validator. It is not safe to assume
+	// this message is in response to a GetStateSummaryFrontier message, is
+	// utilizing a unique requestID, or that the summary bytes are from a valid
+	// state summary. However, the validatorID is assumed to be authenticated.
+	StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) error
+
+	// Notify this engine that a get state summary frontier request it issued
+	// has failed.
+	//
+	// This function will be called if the engine sent a
+	// GetStateSummaryFrontier message that is not anticipated to be responded
+	// to. This could be because the recipient of the message is unknown or if
+	// the message request has timed out.
+	//
+	// The validatorID and requestID are assumed to be the same as those sent in
+	// the GetStateSummaryFrontier message.
+	GetStateSummaryFrontierFailed(validatorID ids.ShortID, requestID uint32) error
+
+	// Notify this engine of a request to filter the provided state summary
+	// heights down to the ones this engine has accepted summaries for.
+	//
+	// This function can be called by any validator. It is not safe to assume
+	// this message is utilizing a unique requestID. However, the validatorID is
+	// assumed to be authenticated.
+	//
+	// This engine should respond with an AcceptedStateSummary message with the
+	// same requestID, and the IDs of the state summaries it has at the provided
+	// heights.
+	GetAcceptedStateSummary(validatorID ids.ShortID, requestID uint32, heights []uint64) error
+
+	// Notify this engine of a set of accepted state summaries.
+	//
+	// This function can be called by any validator. It is not safe to assume
+	// this message is in response to a GetAcceptedStateSummary message, is
+	// utilizing a unique requestID, or that the summaryIDs are a subset of the
+	// requested heights. However, the validatorID is assumed to be
+	// authenticated.
+	AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) error
+
+	// Notify this engine that a get accepted state summary request it issued
+	// has failed.
+	//
+	// This function will be called if the engine sent a
+	// GetAcceptedStateSummary message that is not anticipated to be responded
+	// to. This could be because the recipient of the message is unknown or if
+	// the message request has timed out.
+	//
+	// The validatorID and requestID are assumed to be the same as those sent in
+	// the GetAcceptedStateSummary message.
+	GetAcceptedStateSummaryFailed(validatorID ids.ShortID, requestID uint32) error
+}
+
+// AppHandler defines how a consensus engine reacts to application level
+// messages from other nodes. These messages are opaque to the consensus engine
+// and are handed directly to the VM. Functions only return fatal errors if
+// they occur.
+type AppHandler interface {
+	// Notify this engine of a request for data from [nodeID].
+	//
+	// This function can be called by any node. It is not safe to assume this
+	// message is utilizing a unique requestID. However, the nodeID is assumed
+	// to be authenticated.
+	//
+	// The VM may respond to this request by calling SendAppResponse with the
+	// same requestID.
+	AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error
+
+	// Notify this engine that an AppRequest message it sent to [nodeID] with
+	// request ID [requestID] failed.
+	//
+	// This function will be called if the engine sent an AppRequest message
+	// that is not anticipated to be responded to. This could be because the
+	// recipient of the message is unknown or if the message request has timed
+	// out.
+	//
+	// The nodeID and the requestID are assumed to be the same as those sent in
+	// the AppRequest message.
+	AppRequestFailed(nodeID ids.ShortID, requestID uint32) error
+
+	// Notify this engine of a response to the AppRequest message it sent to
+	// [nodeID] with request ID [requestID].
+	//
+	// This function can be called by any node. It is not safe to assume this
+	// message is in response to an AppRequest message. However, the nodeID is
+	// assumed to be authenticated.
+	AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error
+
+	// Notify this engine of a gossip message from [nodeID].
+	//
+	// This function can be called by any node. The nodeID is assumed to be
+	// authenticated.
+	AppGossip(nodeID ids.ShortID, msg []byte) error
+}
+
 // InternalHandler defines how this consensus engine reacts to messages from
 // other components of this validator. Functions only return fatal errors if
 // they occur.
@@ -15,6 +15,8 @@ type Sender interface {
 	FetchSender
 	QuerySender
 	Gossiper
+	AppSender
+	StateSyncSender
 }
 
 // FrontierSender defines how a consensus engine sends frontier messages to
@@ -86,3 +88,52 @@ type Gossiper interface {
 	// Gossip gossips the provided container throughout the network
 	Gossip(containerID ids.ID, container []byte)
 }
+
+// StateSyncSender defines how a consensus engine sends state sync messages to
+// other validators
+type StateSyncSender interface {
+	// GetStateSummaryFrontier requests that every validator in [validatorIDs]
+	// sends a StateSummaryFrontier message.
+	GetStateSummaryFrontier(validatorIDs ids.ShortSet, requestID uint32)
+
+	// StateSummaryFrontier responds to a GetStateSummaryFrontier message with
+	// this engine's most recent state summary.
+	StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte)
+
+	// GetAcceptedStateSummary requests that every validator in [validatorIDs]
+	// sends an AcceptedStateSummary message with the IDs of the state
+	// summaries it has at the provided [heights].
+	GetAcceptedStateSummary(validatorIDs ids.ShortSet, requestID uint32, heights []uint64)
+
+	// AcceptedStateSummary responds to a GetAcceptedStateSummary message with
+	// the IDs of the state summaries that this engine has accepted.
+	AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID)
+}
+
+// AppSender sends application (VM) level messages.
+// See also common.AppHandler.
+type AppSender interface {
+	// Send an application-level request.
+	// A nil return value guarantees that for each nodeID in [nodeIDs],
+	// the VM corresponding to this AppSender eventually receives either:
+	// * An AppResponse from nodeID with ID [requestID]
+	// * An AppRequestFailed from nodeID with ID [requestID]
+	// Exactly one of the above messages will eventually be received per nodeID.
+	// A non-nil error should be considered fatal.
+	SendAppRequest(nodeIDs ids.ShortSet, requestID uint32, appRequestBytes []byte) error
+
+	// Send an application-level response to a request.
+	// This response must be in response to an AppRequest that the VM
+	// corresponding to this AppSender received from [nodeID] with ID
+	// [requestID].
+	// A non-nil error should be considered fatal.
+	SendAppResponse(nodeID ids.ShortID, requestID uint32, appResponseBytes []byte) error
+
+	// Gossip an application-level message.
+	// A non-nil error should be considered fatal.
+	SendAppGossip(appGossipBytes []byte) error
+
+	// Gossip an application-level message to the nodes in [nodeIDs].
+	// A non-nil error should be considered fatal.
+	SendAppGossipSpecific(nodeIDs ids.ShortSet, appGossipBytes []byte) error
+}
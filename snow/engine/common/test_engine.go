@@ -44,6 +44,18 @@ type EngineTest struct {
 	CantQueryFailed,
 	CantChits,
 
+	CantAppRequest,
+	CantAppRequestFailed,
+	CantAppResponse,
+	CantAppGossip,
+
+	CantGetStateSummaryFrontier,
+	CantStateSummaryFrontier,
+	CantGetStateSummaryFrontierFailed,
+	CantGetAcceptedStateSummary,
+	CantAcceptedStateSummary,
+	CantGetAcceptedStateSummaryFailed,
+
 	CantConnected,
 	CantDisconnected,
 
@@ -61,6 +73,15 @@ type EngineTest struct {
 	QueryFailedF, GetAcceptedFrontierFailedF, GetAcceptedFailedF func(validatorID ids.ShortID, requestID uint32) error
 	ConnectedF, DisconnectedF func(validatorID ids.ShortID) error
 	HealthF                   func() (interface{}, error)
+	AppRequestF, AppResponseF func(nodeID ids.ShortID, requestID uint32, msg []byte) error
+	AppRequestFailedF         func(nodeID ids.ShortID, requestID uint32) error
+	AppGossipF                func(nodeID ids.ShortID, msg []byte) error
+
+	GetStateSummaryFrontierF, GetStateSummaryFrontierFailedF,
+	GetAcceptedStateSummaryFailedF func(validatorID ids.ShortID, requestID uint32) error
+	StateSummaryFrontierF    func(validatorID ids.ShortID, requestID uint32, summary []byte) error
+	GetAcceptedStateSummaryF func(validatorID ids.ShortID, requestID uint32, heights []uint64) error
+	AcceptedStateSummaryF    func(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) error
 }
 
 var _ Engine = &EngineTest{}
@@ -97,6 +118,18 @@ func (e *EngineTest) Default(cant bool) {
 	e.CantQueryFailed = cant
 	e.CantChits = cant
 
+	e.CantAppRequest = cant
+	e.CantAppRequestFailed = cant
+	e.CantAppResponse = cant
+	e.CantAppGossip = cant
+
+	e.CantGetStateSummaryFrontier = cant
+	e.CantStateSummaryFrontier = cant
+	e.CantGetStateSummaryFrontierFailed = cant
+	e.CantGetAcceptedStateSummary = cant
+	e.CantAcceptedStateSummary = cant
+	e.CantGetAcceptedStateSummaryFailed = cant
+
 	e.CantConnected = cant
 	e.CantDisconnected = cant
 
@@ -423,6 +456,146 @@ func (e *EngineTest) Disconnected(validatorID ids.ShortID) error {
 	return errors.New("unexpectedly called Disconnected")
 }
 
+// AppRequest ...
+func (e *EngineTest) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	if e.AppRequestF != nil {
+		return e.AppRequestF(nodeID, requestID, request)
+	}
+	if !e.CantAppRequest {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called AppRequest")
+	}
+	return errors.New("unexpectedly called AppRequest")
+}
+
+// AppResponse ...
+func (e *EngineTest) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	if e.AppResponseF != nil {
+		return e.AppResponseF(nodeID, requestID, response)
+	}
+	if !e.CantAppResponse {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called AppResponse")
+	}
+	return errors.New("unexpectedly called AppResponse")
+}
+
+// AppRequestFailed ...
+func (e *EngineTest) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	if e.AppRequestFailedF != nil {
+		return e.AppRequestFailedF(nodeID, requestID)
+	}
+	if !e.CantAppRequestFailed {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called AppRequestFailed")
+	}
+	return errors.New("unexpectedly called AppRequestFailed")
+}
+
+// AppGossip ...
+func (e *EngineTest) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	if e.AppGossipF != nil {
+		return e.AppGossipF(nodeID, msg)
+	}
+	if !e.CantAppGossip {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called AppGossip")
+	}
+	return errors.New("unexpectedly called AppGossip")
+}
+
+// GetStateSummaryFrontier ...
+func (e *EngineTest) GetStateSummaryFrontier(validatorID ids.ShortID, requestID uint32) error {
+	if e.GetStateSummaryFrontierF != nil {
+		return e.GetStateSummaryFrontierF(validatorID, requestID)
+	}
+	if !e.CantGetStateSummaryFrontier {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called GetStateSummaryFrontier")
+	}
+	return errors.New("unexpectedly called GetStateSummaryFrontier")
+}
+
+// StateSummaryFrontier ...
+func (e *EngineTest) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) error {
+	if e.StateSummaryFrontierF != nil {
+		return e.StateSummaryFrontierF(validatorID, requestID, summary)
+	}
+	if !e.CantStateSummaryFrontier {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called StateSummaryFrontier")
+	}
+	return errors.New("unexpectedly called StateSummaryFrontier")
+}
+
+// GetStateSummaryFrontierFailed ...
+func (e *EngineTest) GetStateSummaryFrontierFailed(validatorID ids.ShortID, requestID uint32) error {
+	if e.GetStateSummaryFrontierFailedF != nil {
+		return e.GetStateSummaryFrontierFailedF(validatorID, requestID)
+	}
+	if !e.CantGetStateSummaryFrontierFailed {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called GetStateSummaryFrontierFailed")
+	}
+	return errors.New("unexpectedly called GetStateSummaryFrontierFailed")
+}
+
+// GetAcceptedStateSummary ...
+func (e *EngineTest) GetAcceptedStateSummary(validatorID ids.ShortID, requestID uint32, heights []uint64) error {
+	if e.GetAcceptedStateSummaryF != nil {
+		return e.GetAcceptedStateSummaryF(validatorID, requestID, heights)
+	}
+	if !e.CantGetAcceptedStateSummary {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called GetAcceptedStateSummary")
+	}
+	return errors.New("unexpectedly called GetAcceptedStateSummary")
+}
+
+// AcceptedStateSummary ...
+func (e *EngineTest) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) error {
+	if e.AcceptedStateSummaryF != nil {
+		return e.AcceptedStateSummaryF(validatorID, requestID, summaryIDs)
+	}
+	if !e.CantAcceptedStateSummary {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called AcceptedStateSummary")
+	}
+	return errors.New("unexpectedly called AcceptedStateSummary")
+}
+
+// GetAcceptedStateSummaryFailed ...
+func (e *EngineTest) GetAcceptedStateSummaryFailed(validatorID ids.ShortID, requestID uint32) error {
+	if e.GetAcceptedStateSummaryFailedF != nil {
+		return e.GetAcceptedStateSummaryFailedF(validatorID, requestID)
+	}
+	if !e.CantGetAcceptedStateSummaryFailed {
+		return nil
+	}
+	if e.T != nil {
+		e.T.Fatalf("Unexpectedly called GetAcceptedStateSummaryFailed")
+	}
+	return errors.New("unexpectedly called GetAcceptedStateSummaryFailed")
+}
+
 // IsBootstrapped ...
 func (e *EngineTest) IsBootstrapped() bool {
 	if e.IsBootstrappedF != nil {
@@ -4,6 +4,7 @@
 package common
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/corpetty/avalanchego/ids"
@@ -17,20 +18,32 @@ type SenderTest struct {
 	CantGetAccepted, CantAccepted,
 	CantGet, CantGetAncestors, CantPut, CantMultiPut,
 	CantPullQuery, CantPushQuery, CantChits,
-	CantGossip bool
-
-	GetAcceptedFrontierF func(ids.ShortSet, uint32)
-	AcceptedFrontierF    func(ids.ShortID, uint32, []ids.ID)
-	GetAcceptedF         func(ids.ShortSet, uint32, []ids.ID)
-	AcceptedF            func(ids.ShortID, uint32, []ids.ID)
-	GetF                 func(ids.ShortID, uint32, ids.ID)
-	GetAncestorsF        func(ids.ShortID, uint32, ids.ID)
-	PutF                 func(ids.ShortID, uint32, ids.ID, []byte)
-	MultiPutF            func(ids.ShortID, uint32, [][]byte)
-	PushQueryF           func(ids.ShortSet, uint32, ids.ID, []byte)
-	PullQueryF           func(ids.ShortSet, uint32, ids.ID)
-	ChitsF               func(ids.ShortID, uint32, []ids.ID)
-	GossipF              func(ids.ID, []byte)
+	CantGossip,
+	CantSendAppRequest, CantSendAppResponse, CantSendAppGossip, CantSendAppGossipSpecific,
+	CantGetStateSummaryFrontier, CantStateSummaryFrontier,
+	CantGetAcceptedStateSummary, CantAcceptedStateSummary bool
+
+	GetAcceptedFrontierF   func(ids.ShortSet, uint32)
+	AcceptedFrontierF      func(ids.ShortID, uint32, []ids.ID)
+	GetAcceptedF           func(ids.ShortSet, uint32, []ids.ID)
+	AcceptedF              func(ids.ShortID, uint32, []ids.ID)
+	GetF                   func(ids.ShortID, uint32, ids.ID)
+	GetAncestorsF          func(ids.ShortID, uint32, ids.ID)
+	PutF                   func(ids.ShortID, uint32, ids.ID, []byte)
+	MultiPutF              func(ids.ShortID, uint32, [][]byte)
+	PushQueryF             func(ids.ShortSet, uint32, ids.ID, []byte)
+	PullQueryF             func(ids.ShortSet, uint32, ids.ID)
+	ChitsF                 func(ids.ShortID, uint32, []ids.ID)
+	GossipF                func(ids.ID, []byte)
+	SendAppRequestF        func(ids.ShortSet, uint32, []byte) error
+	SendAppResponseF       func(ids.ShortID, uint32, []byte) error
+	SendAppGossipF         func([]byte) error
+	SendAppGossipSpecificF func(ids.ShortSet, []byte) error
+
+	GetStateSummaryFrontierF func(ids.ShortSet, uint32)
+	StateSummaryFrontierF    func(ids.ShortID, uint32, []byte)
+	GetAcceptedStateSummaryF func(ids.ShortSet, uint32, []uint64)
+	AcceptedStateSummaryF    func(ids.ShortID, uint32, []ids.ID)
 }
 
 // Default set the default callable value to [cant]
@@ -47,6 +60,14 @@ func (s *SenderTest) Default(cant bool) {
 	s.CantPushQuery = cant
 	s.CantChits = cant
 	s.CantGossip = cant
+	s.CantSendAppRequest = cant
+	s.CantSendAppResponse = cant
+	s.CantSendAppGossip = cant
+	s.CantSendAppGossipSpecific = cant
+	s.CantGetStateSummaryFrontier = cant
+	s.CantStateSummaryFrontier = cant
+	s.CantGetAcceptedStateSummary = cant
+	s.CantAcceptedStateSummary = cant
 }
 
 // GetAcceptedFrontier calls GetAcceptedFrontierF if it was initialized. If it
@@ -180,3 +201,99 @@ func (s *SenderTest) Gossip(containerID ids.ID, container []byte) {
 		s.T.Fatalf("Unexpectedly called Gossip")
 	}
 }
+
+// SendAppRequest calls SendAppRequestF if it was initialized. If it wasn't
+// initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *SenderTest) SendAppRequest(nodeIDs ids.ShortSet, requestID uint32, appRequestBytes []byte) error {
+	switch {
+	case s.SendAppRequestF != nil:
+		return s.SendAppRequestF(nodeIDs, requestID, appRequestBytes)
+	case s.CantSendAppRequest && s.T != nil:
+		s.T.Fatalf("Unexpectedly called SendAppRequest")
+	}
+	return errors.New("unexpectedly called SendAppRequest")
+}
+
+// SendAppResponse calls SendAppResponseF if it was initialized. If it wasn't
+// initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *SenderTest) SendAppResponse(nodeID ids.ShortID, requestID uint32, appResponseBytes []byte) error {
+	switch {
+	case s.SendAppResponseF != nil:
+		return s.SendAppResponseF(nodeID, requestID, appResponseBytes)
+	case s.CantSendAppResponse && s.T != nil:
+		s.T.Fatalf("Unexpectedly called SendAppResponse")
+	}
+	return errors.New("unexpectedly called SendAppResponse")
+}
+
+// SendAppGossip calls SendAppGossipF if it was initialized. If it wasn't
+// initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *SenderTest) SendAppGossip(appGossipBytes []byte) error {
+	switch {
+	case s.SendAppGossipF != nil:
+		return s.SendAppGossipF(appGossipBytes)
+	case s.CantSendAppGossip && s.T != nil:
+		s.T.Fatalf("Unexpectedly called SendAppGossip")
+	}
+	return errors.New("unexpectedly called SendAppGossip")
+}
+
+// SendAppGossipSpecific calls SendAppGossipSpecificF if it was initialized. If
+// it wasn't initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *SenderTest) SendAppGossipSpecific(nodeIDs ids.ShortSet, appGossipBytes []byte) error {
+	switch {
+	case s.SendAppGossipSpecificF != nil:
+		return s.SendAppGossipSpecificF(nodeIDs, appGossipBytes)
+	case s.CantSendAppGossipSpecific && s.T != nil:
+		s.T.Fatalf("Unexpectedly called SendAppGossipSpecific")
+	}
+	return errors.New("unexpectedly called SendAppGossipSpecific")
+}
+
+// GetStateSummaryFrontier calls GetStateSummaryFrontierF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *SenderTest) GetStateSummaryFrontier(validatorIDs ids.ShortSet, requestID uint32) {
+	if s.GetStateSummaryFrontierF != nil {
+		s.GetStateSummaryFrontierF(validatorIDs, requestID)
+	} else if s.CantGetStateSummaryFrontier && s.T != nil {
+		s.T.Fatalf("Unexpectedly called GetStateSummaryFrontier")
+	}
+}
+
+// StateSummaryFrontier calls StateSummaryFrontierF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *SenderTest) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) {
+	if s.StateSummaryFrontierF != nil {
+		s.StateSummaryFrontierF(validatorID, requestID, summary)
+	} else if s.CantStateSummaryFrontier && s.T != nil {
+		s.T.Fatalf("Unexpectedly called StateSummaryFrontier")
+	}
+}
+
+// GetAcceptedStateSummary calls GetAcceptedStateSummaryF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *SenderTest) GetAcceptedStateSummary(validatorIDs ids.ShortSet, requestID uint32, heights []uint64) {
+	if s.GetAcceptedStateSummaryF != nil {
+		s.GetAcceptedStateSummaryF(validatorIDs, requestID, heights)
+	} else if s.CantGetAcceptedStateSummary && s.T != nil {
+		s.T.Fatalf("Unexpectedly called GetAcceptedStateSummary")
+	}
+}
+
+// AcceptedStateSummary calls AcceptedStateSummaryF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *SenderTest) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) {
+	if s.AcceptedStateSummaryF != nil {
+		s.AcceptedStateSummaryF(validatorID, requestID, summaryIDs)
+	} else if s.CantAcceptedStateSummary && s.T != nil {
+		s.T.Fatalf("Unexpectedly called AcceptedStateSummary")
+	}
+}
@@ -8,11 +8,16 @@ import (
 	"testing"
 
 	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow"
 )
 
 var (
-	errInitialize = errors.New("unexpectedly called Initialize")
+	errInitialize       = errors.New("unexpectedly called Initialize")
+	errAppRequest       = errors.New("unexpectedly called AppRequest")
+	errAppResponse      = errors.New("unexpectedly called AppResponse")
+	errAppGossip        = errors.New("unexpectedly called AppGossip")
+	errAppRequestFailed = errors.New("unexpectedly called AppRequestFailed")
 )
 
 // TestVM is a test vm
@@ -21,13 +26,17 @@ type TestVM struct {
 
 	CantInitialize, CantBootstrapping, CantBootstrapped,
 	CantShutdown, CantCreateHandlers, CantCreateStaticHandlers,
-	CantHealth bool
+	CantHealth,
+	CantAppRequest, CantAppResponse, CantAppGossip, CantAppRequestFailed bool
 
-	InitializeF                              func(*snow.Context, database.Database, []byte, chan<- Message, []*Fx) error
+	InitializeF                              func(*snow.Context, database.Database, []byte, []byte, chan<- Message, []*Fx, AppSender) error
 	BootstrappingF, BootstrappedF, ShutdownF func() error
 	CreateHandlersF                          func() map[string]*HTTPHandler
 	CreateStaticHandlersF                    func() map[string]*HTTPHandler
 	HealthF                                  func() (interface{}, error)
+	AppRequestF, AppResponseF                func(nodeID ids.ShortID, requestID uint32, msg []byte) error
+	AppGossipF                               func(nodeID ids.ShortID, msg []byte) error
+	AppRequestFailedF                        func(nodeID ids.ShortID, requestID uint32) error
 }
 
 // Default ...
@@ -39,12 +48,16 @@ func (vm *TestVM) Default(cant bool) {
 	vm.CantCreateHandlers = cant
 	vm.CantCreateStaticHandlers = cant
 	vm.CantHealth = cant
+	vm.CantAppRequest = cant
+	vm.CantAppResponse = cant
+	vm.CantAppGossip = cant
+	vm.CantAppRequestFailed = cant
 }
 
 // Initialize ...
-func (vm *TestVM) Initialize(ctx *snow.Context, db database.Database, initState []byte, msgChan chan<- Message, fxs []*Fx) error {
+func (vm *TestVM) Initialize(ctx *snow.Context, db database.Database, initState, configBytes []byte, msgChan chan<- Message, fxs []*Fx, appSender AppSender) error {
 	if vm.InitializeF != nil {
-		return vm.InitializeF(ctx, db, initState, msgChan, fxs)
+		return vm.InitializeF(ctx, db, initState, configBytes, msgChan, fxs, appSender)
 	}
 	if vm.CantInitialize && vm.T != nil {
 		vm.T.Fatal(errInitialize)
@@ -123,3 +136,59 @@ func (vm *TestVM) Health() (interface{}, error) {
 	}
 	return nil, errors.New("Unexpectedly called Health")
 }
+
+// AppRequest ...
+func (vm *TestVM) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	if vm.AppRequestF != nil {
+		return vm.AppRequestF(nodeID, requestID, request)
+	}
+	if !vm.CantAppRequest {
+		return nil
+	}
+	if vm.T != nil {
+		vm.T.Fatal(errAppRequest)
+	}
+	return errAppRequest
+}
+
+// AppRequestFailed ...
+func (vm *TestVM) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	if vm.AppRequestFailedF != nil {
+		return vm.AppRequestFailedF(nodeID, requestID)
+	}
+	if !vm.CantAppRequestFailed {
+		return nil
+	}
+	if vm.T != nil {
+		vm.T.Fatal(errAppRequestFailed)
+	}
+	return errAppRequestFailed
+}
+
+// AppResponse ...
+func (vm *TestVM) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	if vm.AppResponseF != nil {
+		return vm.AppResponseF(nodeID, requestID, response)
+	}
+	if !vm.CantAppResponse {
+		return nil
+	}
+	if vm.T != nil {
+		vm.T.Fatal(errAppResponse)
+	}
+	return errAppResponse
+}
+
+// AppGossip ...
+func (vm *TestVM) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	if vm.AppGossipF != nil {
+		return vm.AppGossipF(nodeID, msg)
+	}
+	if !vm.CantAppGossip {
+		return nil
+	}
+	if vm.T != nil {
+		vm.T.Fatal(errAppGossip)
+	}
+	return errAppGossip
+}
@@ -10,6 +10,9 @@ import (
 
 // VM describes the interface that all consensus VMs must implement
 type VM interface {
+	// Contains handlers for VM-to-VM specific messages
+	AppHandler
+
 	// Initialize this VM.
 	// [ctx]: Metadata about this VM.
 	//     [ctx.networkID]: The ID of the network this VM's chain is running on.
@@ -26,14 +29,21 @@ type VM interface {
 	//                 system, `genesisBytes` would probably contain a genesis
 	//                 transaction that gives coins to some accounts, and this
 	//                 transaction would be in the genesis block.
+	// [configBytes]: The byte-encoding of the configuration of this VM's
+	//                chain on this node. It is read from the chain's config
+	//                file, so it is empty if the chain doesn't have one.
 	// [toEngine]: The channel used to send messages to the consensus engine.
 	// [fxs]: Feature extensions that attach to this VM.
+	// [appSender]: Used to send application level messages to the VMs of
+	//              this chain on other nodes.
 	Initialize(
 		ctx *snow.Context,
 		db database.Database,
 		genesisBytes []byte,
+		configBytes []byte,
 		toEngine chan<- Message,
 		fxs []*Fx,
+		appSender AppSender,
 	) error
 
 	// Bootstrapping is called when the node is starting to bootstrap this chain.
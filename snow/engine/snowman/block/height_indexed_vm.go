@@ -0,0 +1,19 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"github.com/corpetty/avalanchego/ids"
+)
+
+// HeightIndexedChainVM defines the optional functionality a Snowman VM can
+// implement to look up its accepted blocks by height.
+type HeightIndexedChainVM interface {
+	ChainVM
+
+	// GetBlockIDAtHeight returns the ID of the accepted block at [height].
+	//
+	// If no block has been accepted at [height], an error should be returned.
+	GetBlockIDAtHeight(height uint64) (ids.ID, error)
+}
@@ -0,0 +1,57 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"github.com/corpetty/avalanchego/ids"
+)
+
+// Summary represents the state of a chain at a specific block height.
+type Summary interface {
+	// ID uniquely identifies this summary.
+	ID() ids.ID
+
+	// Height returns the height of the block this summary represents the
+	// state after.
+	Height() uint64
+
+	// Bytes returns the byte representation of this summary.
+	Bytes() []byte
+}
+
+// StateSyncableVM defines the optional functionality a Snowman VM can
+// implement to sync its state from a summary, rather than by executing every
+// block since genesis.
+//
+// Before bootstrapping, the engine asks a sample of the beacons for their most
+// recent state summary and then asks all the beacons which of those summaries
+// they have accepted. The summaries with a sufficient amount of stake behind
+// them are handed to the VM, which is responsible for fetching the state they
+// represent. Once the VM has finished, it must send a StateSyncDone message to
+// the engine, after which bootstrapping resumes from the VM's last accepted
+// block.
+type StateSyncableVM interface {
+	ChainVM
+
+	// StateSyncEnabled returns true if the VM wants to state sync before
+	// bootstrapping.
+	StateSyncEnabled() (bool, error)
+
+	// GetLastStateSummary returns the most recent state summary this VM is
+	// able to serve to other nodes.
+	GetLastStateSummary() (Summary, error)
+
+	// ParseStateSummary parses a state summary out of [summaryBytes].
+	ParseStateSummary(summaryBytes []byte) (Summary, error)
+
+	// GetStateSummary returns the accepted state summary at [height]. If this
+	// VM doesn't have a summary at [height], an error should be returned.
+	GetStateSummary(height uint64) (Summary, error)
+
+	// StateSync starts syncing the VM's state to one of the provided
+	// summaries. Each of the summaries has been accepted by a sufficient
+	// amount of stake. This function should not block until the sync has
+	// completed.
+	StateSync(summaries []Summary) error
+}
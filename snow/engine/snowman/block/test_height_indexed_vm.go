@@ -0,0 +1,41 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"errors"
+
+	"github.com/corpetty/avalanchego/ids"
+)
+
+var (
+	errGetBlockIDAtHeight = errors.New("unexpectedly called GetBlockIDAtHeight")
+)
+
+// TestHeightIndexedVM ...
+type TestHeightIndexedVM struct {
+	TestVM
+
+	CantGetBlockIDAtHeight bool
+
+	GetBlockIDAtHeightF func(uint64) (ids.ID, error)
+}
+
+// Default ...
+func (vm *TestHeightIndexedVM) Default(cant bool) {
+	vm.TestVM.Default(cant)
+
+	vm.CantGetBlockIDAtHeight = cant
+}
+
+// GetBlockIDAtHeight ...
+func (vm *TestHeightIndexedVM) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
+	if vm.GetBlockIDAtHeightF != nil {
+		return vm.GetBlockIDAtHeightF(height)
+	}
+	if vm.CantGetBlockIDAtHeight && vm.T != nil {
+		vm.T.Fatal(errGetBlockIDAtHeight)
+	}
+	return ids.ID{}, errGetBlockIDAtHeight
+}
@@ -0,0 +1,117 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"errors"
+
+	"github.com/corpetty/avalanchego/ids"
+)
+
+var (
+	errStateSyncEnabled    = errors.New("unexpectedly called StateSyncEnabled")
+	errGetLastStateSummary = errors.New("unexpectedly called GetLastStateSummary")
+	errParseStateSummary   = errors.New("unexpectedly called ParseStateSummary")
+	errGetStateSummary     = errors.New("unexpectedly called GetStateSummary")
+	errStateSync           = errors.New("unexpectedly called StateSync")
+)
+
+// TestSummary is a useful test state summary
+type TestSummary struct {
+	IDV     ids.ID
+	HeightV uint64
+	BytesV  []byte
+}
+
+// ID implements the Summary interface
+func (s *TestSummary) ID() ids.ID { return s.IDV }
+
+// Height implements the Summary interface
+func (s *TestSummary) Height() uint64 { return s.HeightV }
+
+// Bytes implements the Summary interface
+func (s *TestSummary) Bytes() []byte { return s.BytesV }
+
+// TestStateSyncableVM ...
+type TestStateSyncableVM struct {
+	TestVM
+
+	CantStateSyncEnabled,
+	CantGetLastStateSummary,
+	CantParseStateSummary,
+	CantGetStateSummary,
+	CantStateSync bool
+
+	StateSyncEnabledF    func() (bool, error)
+	GetLastStateSummaryF func() (Summary, error)
+	ParseStateSummaryF   func([]byte) (Summary, error)
+	GetStateSummaryF     func(uint64) (Summary, error)
+	StateSyncF           func([]Summary) error
+}
+
+// Default ...
+func (vm *TestStateSyncableVM) Default(cant bool) {
+	vm.TestVM.Default(cant)
+
+	vm.CantStateSyncEnabled = cant
+	vm.CantGetLastStateSummary = cant
+	vm.CantParseStateSummary = cant
+	vm.CantGetStateSummary = cant
+	vm.CantStateSync = cant
+}
+
+// StateSyncEnabled ...
+func (vm *TestStateSyncableVM) StateSyncEnabled() (bool, error) {
+	if vm.StateSyncEnabledF != nil {
+		return vm.StateSyncEnabledF()
+	}
+	if vm.CantStateSyncEnabled && vm.T != nil {
+		vm.T.Fatal(errStateSyncEnabled)
+	}
+	return false, errStateSyncEnabled
+}
+
+// GetLastStateSummary ...
+func (vm *TestStateSyncableVM) GetLastStateSummary() (Summary, error) {
+	if vm.GetLastStateSummaryF != nil {
+		return vm.GetLastStateSummaryF()
+	}
+	if vm.CantGetLastStateSummary && vm.T != nil {
+		vm.T.Fatal(errGetLastStateSummary)
+	}
+	return nil, errGetLastStateSummary
+}
+
+// ParseStateSummary ...
+func (vm *TestStateSyncableVM) ParseStateSummary(b []byte) (Summary, error) {
+	if vm.ParseStateSummaryF != nil {
+		return vm.ParseStateSummaryF(b)
+	}
+	if vm.CantParseStateSummary && vm.T != nil {
+		vm.T.Fatal(errParseStateSummary)
+	}
+	return nil, errParseStateSummary
+}
+
+// GetStateSummary ...
+func (vm *TestStateSyncableVM) GetStateSummary(height uint64) (Summary, error) {
+	if vm.GetStateSummaryF != nil {
+		return vm.GetStateSummaryF(height)
+	}
+	if vm.CantGetStateSummary && vm.T != nil {
+		vm.T.Fatal(errGetStateSummary)
+	}
+	return nil, errGetStateSummary
+}
+
+// StateSync ...
+func (vm *TestStateSyncableVM) StateSync(summaries []Summary) error {
+	if vm.StateSyncF != nil {
+		return vm.StateSyncF(summaries)
+	}
+	if vm.CantStateSync && vm.T != nil {
+		vm.T.Fatal(errStateSync)
+	}
+	return errStateSync
+}
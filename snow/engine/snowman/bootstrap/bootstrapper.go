@@ -45,6 +45,21 @@ type Bootstrapper struct {
 
 	// true if all of the vertices in the original accepted frontier have been processed
 	processedStartingAcceptedFrontier bool
+
+	// IDs of validators we have requested the state summary frontier from but
+	// haven't received a reply from
+	pendingSummaryFrontier ids.ShortSet
+	summaries              map[ids.ID]block.Summary
+
+	pendingAcceptedSummary ids.ShortSet
+	acceptedSummaryVotes   map[ids.ID]uint64
+
+	// ID of the outstanding state sync request. Responses to earlier requests
+	// are dropped.
+	stateSyncRequestID uint32
+
+	// true if the VM is currently syncing its state
+	stateSyncing bool
 }
 
 // Initialize this engine.
@@ -84,13 +99,28 @@ func (b *Bootstrapper) CurrentAcceptedFrontier() []ids.ID {
 func (b *Bootstrapper) FilterAccepted(containerIDs []ids.ID) []ids.ID {
 	acceptedIDs := make([]ids.ID, 0, len(containerIDs))
 	for _, blkID := range containerIDs {
-		if blk, err := b.VM.GetBlock(blkID); err == nil && blk.Status() == choices.Accepted {
+		if b.isAccepted(blkID) {
 			acceptedIDs = append(acceptedIDs, blkID)
 		}
 	}
 	return acceptedIDs
 }
 
+// isAccepted returns true if [blkID] has been accepted. If the VM indexes its
+// accepted blocks by height, the index is used as the source of truth.
+func (b *Bootstrapper) isAccepted(blkID ids.ID) bool {
+	blk, err := b.VM.GetBlock(blkID)
+	if err != nil {
+		return false
+	}
+	vm, ok := b.VM.(block.HeightIndexedChainVM)
+	if !ok {
+		return blk.Status() == choices.Accepted
+	}
+	acceptedID, err := vm.GetBlockIDAtHeight(blk.Height())
+	return err == nil && acceptedID == blkID
+}
+
 // ForceAccepted ...
 func (b *Bootstrapper) ForceAccepted(acceptedContainerIDs []ids.ID) error {
 	if err := b.VM.Bootstrapping(); err != nil {
@@ -300,3 +330,23 @@ func (b *Bootstrapper) Disconnected(validatorID ids.ShortID) error {
 	}
 	return b.Bootstrapper.Disconnected(validatorID)
 }
+
+// AppRequest implements the Engine interface.
+func (b *Bootstrapper) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	return b.VM.AppRequest(nodeID, requestID, request)
+}
+
+// AppResponse implements the Engine interface.
+func (b *Bootstrapper) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	return b.VM.AppResponse(nodeID, requestID, response)
+}
+
+// AppRequestFailed implements the Engine interface.
+func (b *Bootstrapper) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	return b.VM.AppRequestFailed(nodeID, requestID)
+}
+
+// AppGossip implements the Engine interface.
+func (b *Bootstrapper) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	return b.VM.AppGossip(nodeID, msg)
+}
@@ -674,6 +674,71 @@ func TestBootstrapperFilterAccepted(t *testing.T) {
 	}
 }
 
+func TestBootstrapperFilterAcceptedHeightIndex(t *testing.T) {
+	config, _, _, _ := newConfig(t)
+
+	vm := &block.TestHeightIndexedVM{}
+	vm.T = t
+	vm.Default(true)
+	config.VM = vm
+
+	blkID0 := ids.GenerateTestID()
+	blkID1 := ids.GenerateTestID()
+
+	// [blk1] is reported as accepted, but a different block is indexed at its
+	// height
+	blk0 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     blkID0,
+			StatusV: choices.Accepted,
+		},
+		HeightV: 0,
+	}
+	blk1 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     blkID1,
+			StatusV: choices.Accepted,
+		},
+		HeightV: 1,
+	}
+
+	bs := Bootstrapper{}
+	err := bs.Initialize(
+		config,
+		nil,
+		fmt.Sprintf("%s_%s", constants.PlatformName, config.Ctx.ChainID),
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vm.GetBlockF = func(blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case blkID0:
+			return blk0, nil
+		case blkID1:
+			return blk1, nil
+		}
+		t.Fatal(errUnknownBlock)
+		return nil, errUnknownBlock
+	}
+	vm.GetBlockIDAtHeightF = func(height uint64) (ids.ID, error) {
+		switch height {
+		case 0:
+			return blkID0, nil
+		case 1:
+			return ids.GenerateTestID(), nil
+		}
+		return ids.ID{}, errUnknownBlock
+	}
+
+	accepted := bs.FilterAccepted([]ids.ID{blkID0, blkID1})
+	if len(accepted) != 1 || accepted[0] != blkID0 {
+		t.Fatalf("Only %s should be accepted", blkID0)
+	}
+}
+
 func TestBootstrapperFinalized(t *testing.T) {
 	config, peerID, sender, vm := newConfig(t)
 
@@ -809,3 +874,147 @@ func TestBootstrapperFinalized(t *testing.T) {
 		t.Fatalf("Block should be accepted")
 	}
 }
+
+func TestBootstrapperStateSync(t *testing.T) {
+	config, peerID, sender, _ := newConfig(t)
+
+	vm := &block.TestStateSyncableVM{}
+	vm.T = t
+	vm.Default(true)
+	config.VM = vm
+
+	summary := &block.TestSummary{
+		IDV:     ids.Empty.Prefix(1),
+		HeightV: 100,
+		BytesV:  []byte{1},
+	}
+
+	vm.StateSyncEnabledF = func() (bool, error) { return true, nil }
+	vm.ParseStateSummaryF = func(summaryBytes []byte) (block.Summary, error) {
+		if !bytes.Equal(summaryBytes, summary.Bytes()) {
+			t.Fatalf("Parsed unexpected summary")
+		}
+		return summary, nil
+	}
+
+	sender.CantGetAcceptedFrontier = true
+
+	requestID := new(uint32)
+	sender.GetStateSummaryFrontierF = func(vdrs ids.ShortSet, reqID uint32) {
+		if !vdrs.Contains(peerID) {
+			t.Fatalf("Should have requested the state summary frontier from %s", peerID)
+		}
+		*requestID = reqID
+	}
+
+	bs := Bootstrapper{}
+	err := bs.Initialize(
+		config,
+		func() error { return nil },
+		fmt.Sprintf("%s_%s", constants.PlatformName, config.Ctx.ChainID),
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sender.GetAcceptedStateSummaryF = func(vdrs ids.ShortSet, reqID uint32, heights []uint64) {
+		if !vdrs.Contains(peerID) {
+			t.Fatalf("Should have requested the accepted state summaries from %s", peerID)
+		}
+		if len(heights) != 1 || heights[0] != summary.Height() {
+			t.Fatalf("Requested unexpected heights %v", heights)
+		}
+		*requestID = reqID
+	}
+
+	if err := bs.StateSummaryFrontier(peerID, *requestID, summary.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	synced := new(bool)
+	vm.StateSyncF = func(summaries []block.Summary) error {
+		if len(summaries) != 1 || summaries[0].ID() != summary.ID() {
+			t.Fatalf("Should have synced to %s", summary.ID())
+		}
+		*synced = true
+		return nil
+	}
+
+	// A late response to the state summary frontier request shouldn't be
+	// counted as a response to the accepted state summary request
+	if err := bs.AcceptedStateSummary(peerID, *requestID-1, []ids.ID{summary.ID()}); err != nil {
+		t.Fatal(err)
+	}
+	if *synced {
+		t.Fatalf("Shouldn't have counted a response to an earlier request")
+	}
+
+	if err := bs.AcceptedStateSummary(peerID, *requestID, []ids.ID{summary.ID()}); err != nil {
+		t.Fatal(err)
+	}
+	if !*synced {
+		t.Fatalf("Should have started syncing the state")
+	}
+
+	requestedFrontier := new(bool)
+	sender.GetAcceptedFrontierF = func(vdrs ids.ShortSet, _ uint32) {
+		*requestedFrontier = true
+	}
+	vm.CantLastAccepted = false
+
+	if err := bs.Notify(common.StateSyncDone); err != nil {
+		t.Fatal(err)
+	}
+	if !*requestedFrontier {
+		t.Fatalf("Should have started bootstrapping after state sync")
+	}
+}
+
+func TestBootstrapperGetAcceptedStateSummary(t *testing.T) {
+	config, peerID, sender, _ := newConfig(t)
+
+	vm := &block.TestStateSyncableVM{}
+	vm.T = t
+	vm.Default(true)
+	config.VM = vm
+
+	vm.StateSyncEnabledF = func() (bool, error) { return false, nil }
+
+	bs := Bootstrapper{}
+	err := bs.Initialize(
+		config,
+		func() error { return nil },
+		fmt.Sprintf("%s_%s", constants.PlatformName, config.Ctx.ChainID),
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summaryID := ids.Empty.Prefix(1)
+	vm.GetStateSummaryF = func(height uint64) (block.Summary, error) {
+		if height != 100 {
+			return nil, errUnknownBlock
+		}
+		return &block.TestSummary{IDV: summaryID, HeightV: height}, nil
+	}
+
+	responded := new(bool)
+	sender.AcceptedStateSummaryF = func(vdr ids.ShortID, _ uint32, summaryIDs []ids.ID) {
+		if vdr != peerID {
+			t.Fatalf("Should have responded to %s", peerID)
+		}
+		if len(summaryIDs) != 1 || summaryIDs[0] != summaryID {
+			t.Fatalf("Responded with unexpected summaries %v", summaryIDs)
+		}
+		*responded = true
+	}
+
+	if err := bs.GetAcceptedStateSummary(peerID, 0, []uint64{100, 200}); err != nil {
+		t.Fatal(err)
+	}
+	if !*responded {
+		t.Fatalf("Should have responded with the accepted state summaries")
+	}
+}
@@ -0,0 +1,232 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	stdmath "math"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
+	"github.com/corpetty/avalanchego/utils/math"
+)
+
+// StartStateSync implements the StateSyncer interface. If the VM supports
+// state sync, the beacons are asked for their most recent state summary.
+// Otherwise, bootstrapping is started immediately.
+func (b *Bootstrapper) StartStateSync() error {
+	vm, ok := b.VM.(block.StateSyncableVM)
+	if !ok {
+		return b.StartBootstrapping()
+	}
+
+	enabled, err := vm.StateSyncEnabled()
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return b.StartBootstrapping()
+	}
+
+	beacons, err := b.Beacons.Sample(b.SampleK)
+	if err != nil {
+		b.Ctx.Log.Error("couldn't sample beacons to state sync from: %s", err)
+		return err
+	}
+	if len(beacons) == 0 {
+		b.Ctx.Log.Info("State sync skipped due to no provided bootstraps")
+		return b.StartBootstrapping()
+	}
+
+	b.pendingSummaryFrontier.Clear()
+	for _, vdr := range beacons {
+		b.pendingSummaryFrontier.Add(vdr.ID())
+	}
+
+	b.pendingAcceptedSummary.Clear()
+	for _, vdr := range b.Beacons.List() {
+		b.pendingAcceptedSummary.Add(vdr.ID())
+	}
+
+	b.summaries = make(map[ids.ID]block.Summary)
+	b.acceptedSummaryVotes = make(map[ids.ID]uint64)
+
+	// Ask each of the sampled bootstrap validators for their most recent state
+	// summary
+	vdrs := ids.ShortSet{}
+	vdrs.Union(b.pendingSummaryFrontier)
+
+	b.RequestID++
+	b.stateSyncRequestID = b.RequestID
+	b.Sender.GetStateSummaryFrontier(vdrs, b.RequestID)
+	return nil
+}
+
+// GetStateSummaryFrontier implements the Engine interface.
+func (b *Bootstrapper) GetStateSummaryFrontier(validatorID ids.ShortID, requestID uint32) error {
+	var summaryBytes []byte
+	if vm, ok := b.VM.(block.StateSyncableVM); ok {
+		summary, err := vm.GetLastStateSummary()
+		if err != nil {
+			b.Ctx.Log.Debug("couldn't get the last state summary due to: %s", err)
+		} else {
+			summaryBytes = summary.Bytes()
+		}
+	}
+	b.Sender.StateSummaryFrontier(validatorID, requestID, summaryBytes)
+	return nil
+}
+
+// GetStateSummaryFrontierFailed implements the Engine interface.
+func (b *Bootstrapper) GetStateSummaryFrontierFailed(validatorID ids.ShortID, requestID uint32) error {
+	// If we can't get a response from [validatorID], act as though they said
+	// they don't have a state summary
+	return b.StateSummaryFrontier(validatorID, requestID, nil)
+}
+
+// StateSummaryFrontier implements the Engine interface.
+func (b *Bootstrapper) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summaryBytes []byte) error {
+	if requestID != b.stateSyncRequestID {
+		b.Ctx.Log.Debug("Received a StateSummaryFrontier message from %s with an unexpected requestID %d", validatorID, requestID)
+		return nil
+	}
+	if !b.pendingSummaryFrontier.Contains(validatorID) {
+		b.Ctx.Log.Debug("Received a StateSummaryFrontier message from %s unexpectedly", validatorID)
+		return nil
+	}
+	// Mark that we received a response from [validatorID]
+	b.pendingSummaryFrontier.Remove(validatorID)
+
+	// An empty summary means that [validatorID] doesn't have one to offer
+	if len(summaryBytes) != 0 {
+		summary, err := b.VM.(block.StateSyncableVM).ParseStateSummary(summaryBytes)
+		if err != nil {
+			b.Ctx.Log.Debug("Failed to parse state summary from %s: %s", validatorID, err)
+		} else {
+			b.summaries[summary.ID()] = summary
+		}
+	}
+
+	if b.pendingSummaryFrontier.Len() != 0 {
+		return nil
+	}
+
+	if len(b.summaries) == 0 {
+		b.Ctx.Log.Info("State sync skipped as no state summaries were received")
+		return b.StartBootstrapping()
+	}
+
+	// We've received the state summary frontier from every sampled bootstrap
+	// validator. Ask each bootstrap validator which of the reported heights
+	// they have accepted summaries for.
+	heightsSet := make(map[uint64]struct{}, len(b.summaries))
+	heights := make([]uint64, 0, len(b.summaries))
+	for _, summary := range b.summaries {
+		height := summary.Height()
+		if _, ok := heightsSet[height]; ok {
+			continue
+		}
+		heightsSet[height] = struct{}{}
+		heights = append(heights, height)
+	}
+
+	vdrs := ids.ShortSet{}
+	vdrs.Union(b.pendingAcceptedSummary)
+
+	b.RequestID++
+	b.stateSyncRequestID = b.RequestID
+	b.Sender.GetAcceptedStateSummary(vdrs, b.RequestID, heights)
+	return nil
+}
+
+// GetAcceptedStateSummary implements the Engine interface.
+func (b *Bootstrapper) GetAcceptedStateSummary(validatorID ids.ShortID, requestID uint32, heights []uint64) error {
+	summaryIDs := make([]ids.ID, 0, len(heights))
+	if vm, ok := b.VM.(block.StateSyncableVM); ok {
+		for _, height := range heights {
+			summary, err := vm.GetStateSummary(height)
+			if err != nil {
+				continue
+			}
+			summaryIDs = append(summaryIDs, summary.ID())
+		}
+	}
+	b.Sender.AcceptedStateSummary(validatorID, requestID, summaryIDs)
+	return nil
+}
+
+// GetAcceptedStateSummaryFailed implements the Engine interface.
+func (b *Bootstrapper) GetAcceptedStateSummaryFailed(validatorID ids.ShortID, requestID uint32) error {
+	// If we can't get a response from [validatorID], act as though they said
+	// that they haven't accepted any of the summaries we asked about
+	return b.AcceptedStateSummary(validatorID, requestID, nil)
+}
+
+// AcceptedStateSummary implements the Engine interface.
+func (b *Bootstrapper) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) error {
+	if requestID != b.stateSyncRequestID {
+		b.Ctx.Log.Debug("Received an AcceptedStateSummary message from %s with an unexpected requestID %d", validatorID, requestID)
+		return nil
+	}
+	if !b.pendingAcceptedSummary.Contains(validatorID) || b.pendingSummaryFrontier.Len() != 0 {
+		b.Ctx.Log.Debug("Received an AcceptedStateSummary message from %s unexpectedly", validatorID)
+		return nil
+	}
+	// Mark that we received a response from [validatorID]
+	b.pendingAcceptedSummary.Remove(validatorID)
+
+	weight := uint64(0)
+	if w, ok := b.Beacons.GetWeight(validatorID); ok {
+		weight = w
+	}
+
+	for _, summaryID := range summaryIDs {
+		previousWeight := b.acceptedSummaryVotes[summaryID]
+		newWeight, err := math.Add64(weight, previousWeight)
+		if err != nil {
+			newWeight = stdmath.MaxUint64
+		}
+		b.acceptedSummaryVotes[summaryID] = newWeight
+	}
+
+	if b.pendingAcceptedSummary.Len() != 0 {
+		return nil
+	}
+
+	// We've received the accepted summaries from every bootstrap validator.
+	// Sync to the summaries that have a sufficient weight behind them.
+	accepted := make([]block.Summary, 0, len(b.acceptedSummaryVotes))
+	for summaryID, weight := range b.acceptedSummaryVotes {
+		summary, ok := b.summaries[summaryID]
+		if ok && weight >= b.Alpha {
+			accepted = append(accepted, summary)
+		}
+	}
+
+	if len(accepted) == 0 {
+		b.Ctx.Log.Info("State sync skipped as no state summaries were accepted")
+		return b.StartBootstrapping()
+	}
+
+	b.Ctx.Log.Info("State sync started with %d accepted state summaries", len(accepted))
+	b.stateSyncing = true
+	return b.VM.(block.StateSyncableVM).StateSync(accepted)
+}
+
+// Notify is called when the VM sends a message to the engine before
+// bootstrapping has finished.
+func (b *Bootstrapper) Notify(msg common.Message) error {
+	if msg != common.StateSyncDone {
+		b.Ctx.Log.Debug("dropping Notify due to bootstrapping")
+		return nil
+	}
+	if !b.stateSyncing {
+		b.Ctx.Log.Debug("Received a StateSyncDone message unexpectedly")
+		return nil
+	}
+	b.stateSyncing = false
+
+	b.Ctx.Log.Info("State sync finished. Bootstrapping from block %s", b.VM.LastAccepted())
+	return b.StartBootstrapping()
+}
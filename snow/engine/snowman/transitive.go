@@ -369,8 +369,7 @@ func (t *Transitive) QueryFailed(vdr ids.ShortID, requestID uint32) error {
 func (t *Transitive) Notify(msg common.Message) error {
 	// if the engine hasn't been bootstrapped, we shouldn't build/issue blocks from the VM
 	if !t.Ctx.IsBootstrapped() {
-		t.Ctx.Log.Debug("dropping Notify due to bootstrapping")
-		return nil
+		return t.Bootstrapper.Notify(msg)
 	}
 
 	t.Ctx.Log.Verbo("snowman engine notified of %s from the vm", msg)
@@ -33,6 +33,8 @@ type QueryBenchlist interface {
 	RegisterResponse(validatorID ids.ShortID, requstID uint32)
 	// QueryFailed registers that a query did not receive a response within our synchrony bound
 	QueryFailed(validatorID ids.ShortID, requestID uint32)
+	// IsBenched returns true if [validatorID] is currently benched
+	IsBenched(validatorID ids.ShortID) bool
 }
 
 type queryBenchlist struct {
@@ -160,6 +162,14 @@ func (b *queryBenchlist) QueryFailed(validatorID ids.ShortID, requestID uint32)
 	}
 }
 
+// IsBenched returns true if [validatorID] is currently benched
+func (b *queryBenchlist) IsBenched(validatorID ids.ShortID) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.benched(validatorID)
+}
+
 func (b *queryBenchlist) bench(validatorID ids.ShortID) {
 	if b.benchlistSet.Contains(validatorID) {
 		return
@@ -26,6 +26,9 @@ type Manager interface {
 	RegisterResponse(ids.ID, ids.ShortID, uint32)
 	// QueryFailed registers that a query did not receive a response within our synchrony bound
 	QueryFailed(ids.ID, ids.ShortID, uint32)
+	// GetBenched returns the IDs of the chains that [validatorID] is currently
+	// benched on
+	GetBenched(validatorID ids.ShortID) []ids.ID
 	// RegisterChain registers a new chain with metrics under [namespac]
 	RegisterChain(*snow.Context, string) error
 }
@@ -136,6 +139,20 @@ func (bm *benchlistManager) QueryFailed(chainID ids.ID, validatorID ids.ShortID,
 	chain.QueryFailed(validatorID, requestID)
 }
 
+// GetBenched implements the Manager interface
+func (bm *benchlistManager) GetBenched(validatorID ids.ShortID) []ids.ID {
+	bm.lock.RLock()
+	defer bm.lock.RUnlock()
+
+	benched := []ids.ID{}
+	for chainID, benchlist := range bm.chainBenchlists {
+		if benchlist.IsBenched(validatorID) {
+			benched = append(benched, chainID)
+		}
+	}
+	return benched
+}
+
 type noBenchlist struct{}
 
 // NewNoBenchlist returns an empty benchlist that will never stop any queries
@@ -145,3 +162,4 @@ func (noBenchlist) RegisterChain(*snow.Context, string) error
 func (noBenchlist) RegisterQuery(ids.ID, ids.ShortID, uint32, constants.MsgType) bool { return true }
 func (noBenchlist) RegisterResponse(ids.ID, ids.ShortID, uint32)                      {}
 func (noBenchlist) QueryFailed(ids.ID, ids.ShortID, uint32)                           {}
+func (noBenchlist) GetBenched(ids.ShortID) []ids.ID                                   { return []ids.ID{} }
@@ -74,6 +74,12 @@ func TestBenchlist(t *testing.T) {
 	if ok := b.RegisterQuery(vdr2.ID(), requestID, constants.PullQueryMsg); ok {
 		t.Fatal("RegisterQuery should have benchlisted query from unresponsive peer: vdr2")
 	}
+	if !b.IsBenched(vdr0.ID()) || !b.IsBenched(vdr2.ID()) {
+		t.Fatal("unresponsive peers should be reported as benched")
+	}
+	if b.IsBenched(vdr1.ID()) {
+		t.Fatal("responsive peer shouldn't be reported as benched")
+	}
 	requestID++
 	if ok := b.RegisterQuery(vdr1.ID(), requestID, constants.PullQueryMsg); !ok {
 		t.Fatal("RegisterQuery should have been successful for responsive peer: vdr1")
@@ -86,6 +92,9 @@ func TestBenchlist(t *testing.T) {
 	if ok := b.RegisterQuery(vdr2.ID(), requestID, constants.PullQueryMsg); !ok {
 		t.Fatal("RegisterQuery should have succeeded after benchlisting time elapsed for vdr2")
 	}
+	if b.IsBenched(vdr0.ID()) || b.IsBenched(vdr2.ID()) {
+		t.Fatal("peers shouldn't be reported as benched after benchlisting time elapsed")
+	}
 }
 
 func TestBenchlistDoesNotGetStuck(t *testing.T) {
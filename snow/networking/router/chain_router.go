@@ -392,6 +392,164 @@ func (sr *ChainRouter) QueryFailed(validatorID ids.ShortID, chainID ids.ID, requ
 	}
 }
 
+// AppRequest routes an incoming AppRequest message from the validator with ID
+// [validatorID] to the consensus engine working on the chain with ID [chainID]
+func (sr *ChainRouter) AppRequest(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.AppRequest(validatorID, requestID, deadline, appRequestBytes)
+	} else {
+		sr.log.Debug("AppRequest(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+		sr.log.Verbo("message:\n%s", formatting.DumpBytes{Bytes: appRequestBytes})
+	}
+}
+
+// AppResponse routes an incoming AppResponse message from the validator with
+// ID [validatorID] to the consensus engine working on the chain with ID
+// [chainID]
+func (sr *ChainRouter) AppResponse(validatorID ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	// This message came in response to an AppRequest message from this node,
+	// and when we sent that message we set a timeout. Since we got a response,
+	// cancel the timeout.
+	if chain, exists := sr.chains[chainID]; exists {
+		if chain.AppResponse(validatorID, requestID, appResponseBytes) {
+			sr.timeouts.CancelAppRequest(validatorID, chainID, requestID)
+		}
+	} else {
+		sr.log.Debug("AppResponse(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+		sr.log.Verbo("message:\n%s", formatting.DumpBytes{Bytes: appResponseBytes})
+	}
+}
+
+// AppRequestFailed routes an incoming AppRequestFailed message from the
+// validator with ID [validatorID] to the consensus engine working on the chain
+// with ID [chainID]
+func (sr *ChainRouter) AppRequestFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	sr.timeouts.CancelAppRequest(validatorID, chainID, requestID)
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.AppRequestFailed(validatorID, requestID)
+	} else {
+		sr.log.Debug("AppRequestFailed(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+	}
+}
+
+// AppGossip routes an incoming AppGossip message from the validator with ID
+// [validatorID] to the consensus engine working on the chain with ID [chainID]
+func (sr *ChainRouter) AppGossip(validatorID ids.ShortID, chainID ids.ID, appGossipBytes []byte) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.AppGossip(validatorID, appGossipBytes)
+	} else {
+		sr.log.Verbo("AppGossip(%s, %s) dropped due to unknown chain. Message:\n%s",
+			validatorID, chainID, formatting.DumpBytes{Bytes: appGossipBytes},
+		)
+	}
+}
+
+// GetStateSummaryFrontier routes an incoming GetStateSummaryFrontier request
+// from the validator with ID [validatorID] to the consensus engine working on
+// the chain with ID [chainID]
+func (sr *ChainRouter) GetStateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.GetStateSummaryFrontier(validatorID, requestID, deadline)
+	} else {
+		sr.log.Debug("GetStateSummaryFrontier(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+	}
+}
+
+// StateSummaryFrontier routes an incoming StateSummaryFrontier response from
+// the validator with ID [validatorID] to the consensus engine working on the
+// chain with ID [chainID]. The request's timeout is cancelled once the
+// response is queued for the engine.
+func (sr *ChainRouter) StateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		if chain.StateSummaryFrontier(validatorID, requestID, summary) {
+			sr.timeouts.Cancel(validatorID, chainID, requestID)
+		}
+	} else {
+		sr.log.Debug("StateSummaryFrontier(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+	}
+}
+
+// GetStateSummaryFrontierFailed cancels the timeout of the
+// GetStateSummaryFrontier request sent to the validator with ID [validatorID]
+// and notifies the consensus engine working on the chain with ID [chainID]
+// that no response will arrive
+func (sr *ChainRouter) GetStateSummaryFrontierFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	sr.timeouts.Cancel(validatorID, chainID, requestID)
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.GetStateSummaryFrontierFailed(validatorID, requestID)
+	} else {
+		sr.log.Debug("GetStateSummaryFrontierFailed(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+	}
+}
+
+// GetAcceptedStateSummary routes an incoming GetAcceptedStateSummary request
+// from the validator with ID [validatorID] to the consensus engine working on
+// the chain with ID [chainID]
+func (sr *ChainRouter) GetAcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.GetAcceptedStateSummary(validatorID, requestID, deadline, heights)
+	} else {
+		sr.log.Debug("GetAcceptedStateSummary(%s, %s, %d, %v) dropped due to unknown chain", validatorID, chainID, requestID, heights)
+	}
+}
+
+// AcceptedStateSummary routes an incoming AcceptedStateSummary response from
+// the validator with ID [validatorID] to the consensus engine working on the
+// chain with ID [chainID]. The request's timeout is cancelled once the
+// response is queued for the engine.
+func (sr *ChainRouter) AcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	if chain, exists := sr.chains[chainID]; exists {
+		if chain.AcceptedStateSummary(validatorID, requestID, summaryIDs) {
+			sr.timeouts.Cancel(validatorID, chainID, requestID)
+		}
+	} else {
+		sr.log.Debug("AcceptedStateSummary(%s, %s, %d, %s) dropped due to unknown chain", validatorID, chainID, requestID, summaryIDs)
+	}
+}
+
+// GetAcceptedStateSummaryFailed cancels the timeout of the
+// GetAcceptedStateSummary request sent to the validator with ID [validatorID]
+// and notifies the consensus engine working on the chain with ID [chainID]
+// that no response will arrive
+func (sr *ChainRouter) GetAcceptedStateSummaryFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32) {
+	sr.lock.RLock()
+	defer sr.lock.RUnlock()
+
+	sr.timeouts.Cancel(validatorID, chainID, requestID)
+	if chain, exists := sr.chains[chainID]; exists {
+		chain.GetAcceptedStateSummaryFailed(validatorID, requestID)
+	} else {
+		sr.log.Debug("GetAcceptedStateSummaryFailed(%s, %s, %d) dropped due to unknown chain", validatorID, chainID, requestID)
+	}
+}
+
 // Connected routes an incoming notification that a validator was just connected
 func (sr *ChainRouter) Connected(validatorID ids.ShortID) {
 	sr.lock.Lock()
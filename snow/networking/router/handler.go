@@ -454,6 +454,125 @@ func (h *Handler) QueryFailed(validatorID ids.ShortID, requestID uint32) {
 	})
 }
 
+// AppRequest passes an AppRequest message received from the network to the
+// consensus engine.
+func (h *Handler) AppRequest(validatorID ids.ShortID, requestID uint32, deadline time.Time, appRequestBytes []byte) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.AppRequestMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+		deadline:    deadline,
+		appMsgBytes: appRequestBytes,
+		received:    h.clock.Time(),
+	})
+}
+
+// AppResponse passes an AppResponse message received from the network to the
+// consensus engine.
+func (h *Handler) AppResponse(validatorID ids.ShortID, requestID uint32, appResponseBytes []byte) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.AppResponseMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+		appMsgBytes: appResponseBytes,
+		received:    h.clock.Time(),
+	})
+}
+
+// AppRequestFailed passes an AppRequestFailed message to the consensus engine.
+func (h *Handler) AppRequestFailed(validatorID ids.ShortID, requestID uint32) {
+	h.sendReliableMsg(message{
+		messageType: constants.AppRequestFailedMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+	})
+}
+
+// AppGossip passes an AppGossip message received from the network to the
+// consensus engine.
+func (h *Handler) AppGossip(validatorID ids.ShortID, appGossipBytes []byte) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.AppGossipMsg,
+		validatorID: validatorID,
+		requestID:   constants.GossipMsgRequestID,
+		appMsgBytes: appGossipBytes,
+		received:    h.clock.Time(),
+	})
+}
+
+// GetStateSummaryFrontier passes a GetStateSummaryFrontier message received
+// from the network to the consensus engine. Returns false if the message was
+// dropped.
+func (h *Handler) GetStateSummaryFrontier(validatorID ids.ShortID, requestID uint32, deadline time.Time) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.GetStateSummaryFrontierMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+		deadline:    deadline,
+		received:    h.clock.Time(),
+	})
+}
+
+// StateSummaryFrontier passes a StateSummaryFrontier message received from
+// the network to the consensus engine. Returns false if the message was
+// dropped, in which case the request is left to time out.
+func (h *Handler) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.StateSummaryFrontierMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+		summary:     summary,
+		received:    h.clock.Time(),
+	})
+}
+
+// GetStateSummaryFrontierFailed passes a GetStateSummaryFrontierFailed message
+// to the consensus engine. Unlike network messages, it is never dropped.
+func (h *Handler) GetStateSummaryFrontierFailed(validatorID ids.ShortID, requestID uint32) {
+	h.sendReliableMsg(message{
+		messageType: constants.GetStateSummaryFrontierFailedMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+	})
+}
+
+// GetAcceptedStateSummary passes a GetAcceptedStateSummary message received
+// from the network to the consensus engine. Returns false if the message was
+// dropped.
+func (h *Handler) GetAcceptedStateSummary(validatorID ids.ShortID, requestID uint32, deadline time.Time, heights []uint64) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType: constants.GetAcceptedStateSummaryMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+		deadline:    deadline,
+		heights:     heights,
+		received:    h.clock.Time(),
+	})
+}
+
+// AcceptedStateSummary passes an AcceptedStateSummary message received from
+// the network to the consensus engine. Returns false if the message was
+// dropped, in which case the request is left to time out.
+func (h *Handler) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) bool {
+	return h.serviceQueue.PushMessage(message{
+		messageType:  constants.AcceptedStateSummaryMsg,
+		validatorID:  validatorID,
+		requestID:    requestID,
+		containerIDs: summaryIDs,
+		received:     h.clock.Time(),
+	})
+}
+
+// GetAcceptedStateSummaryFailed passes a GetAcceptedStateSummaryFailed message
+// to the consensus engine. Unlike network messages, it is never dropped.
+func (h *Handler) GetAcceptedStateSummaryFailed(validatorID ids.ShortID, requestID uint32) {
+	h.sendReliableMsg(message{
+		messageType: constants.GetAcceptedStateSummaryFailedMsg,
+		validatorID: validatorID,
+		requestID:   requestID,
+	})
+}
+
 // Connected passes a new connection notification to the consensus engine
 func (h *Handler) Connected(validatorID ids.ShortID) {
 	h.sendReliableMsg(message{
@@ -550,6 +669,26 @@ func (h *Handler) handleValidatorMsg(msg message, startTime time.Time) error {
 		err = h.engine.QueryFailed(msg.validatorID, msg.requestID)
 	case constants.ChitsMsg:
 		err = h.engine.Chits(msg.validatorID, msg.requestID, msg.containerIDs)
+	case constants.AppRequestMsg:
+		err = h.engine.AppRequest(msg.validatorID, msg.requestID, msg.appMsgBytes)
+	case constants.AppRequestFailedMsg:
+		err = h.engine.AppRequestFailed(msg.validatorID, msg.requestID)
+	case constants.AppResponseMsg:
+		err = h.engine.AppResponse(msg.validatorID, msg.requestID, msg.appMsgBytes)
+	case constants.AppGossipMsg:
+		err = h.engine.AppGossip(msg.validatorID, msg.appMsgBytes)
+	case constants.GetStateSummaryFrontierMsg:
+		err = h.engine.GetStateSummaryFrontier(msg.validatorID, msg.requestID)
+	case constants.StateSummaryFrontierMsg:
+		err = h.engine.StateSummaryFrontier(msg.validatorID, msg.requestID, msg.summary)
+	case constants.GetStateSummaryFrontierFailedMsg:
+		err = h.engine.GetStateSummaryFrontierFailed(msg.validatorID, msg.requestID)
+	case constants.GetAcceptedStateSummaryMsg:
+		err = h.engine.GetAcceptedStateSummary(msg.validatorID, msg.requestID, msg.heights)
+	case constants.AcceptedStateSummaryMsg:
+		err = h.engine.AcceptedStateSummary(msg.validatorID, msg.requestID, msg.containerIDs)
+	case constants.GetAcceptedStateSummaryFailedMsg:
+		err = h.engine.GetAcceptedStateSummaryFailed(msg.validatorID, msg.requestID)
 	case constants.ConnectedMsg:
 		err = h.engine.Connected(msg.validatorID)
 	case constants.DisconnectedMsg:
@@ -21,6 +21,9 @@ type message struct {
 	container    []byte
 	containers   [][]byte
 	containerIDs []ids.ID
+	appMsgBytes  []byte
+	summary      []byte
+	heights      []uint64
 	notification common.Message
 	received     time.Time // Time this message was received
 	deadline     time.Time // Time this message must be responded to
@@ -39,12 +42,18 @@ func (m message) String() string {
 	sb.WriteString(fmt.Sprintf("\n    validatorID: %s", m.validatorID))
 	sb.WriteString(fmt.Sprintf("\n    requestID: %d", m.requestID))
 	switch m.messageType {
-	case constants.GetAcceptedMsg, constants.AcceptedMsg, constants.ChitsMsg:
+	case constants.GetAcceptedMsg, constants.AcceptedMsg, constants.ChitsMsg, constants.AcceptedStateSummaryMsg:
 		sb.WriteString(fmt.Sprintf("\n    containerIDs: %s", m.containerIDs))
 	case constants.GetMsg, constants.GetAncestorsMsg, constants.PutMsg, constants.PushQueryMsg, constants.PullQueryMsg:
 		sb.WriteString(fmt.Sprintf("\n    containerID: %s", m.containerID))
 	case constants.MultiPutMsg:
 		sb.WriteString(fmt.Sprintf("\n    numContainers: %d", len(m.containers)))
+	case constants.AppRequestMsg, constants.AppResponseMsg, constants.AppGossipMsg:
+		sb.WriteString(fmt.Sprintf("\n    len(appMsgBytes): %d", len(m.appMsgBytes)))
+	case constants.StateSummaryFrontierMsg:
+		sb.WriteString(fmt.Sprintf("\n    len(summary): %d", len(m.summary)))
+	case constants.GetAcceptedStateSummaryMsg:
+		sb.WriteString(fmt.Sprintf("\n    heights: %v", m.heights))
 	case constants.NotifyMsg:
 		sb.WriteString(fmt.Sprintf("\n    notification: %s", m.notification))
 	}
@@ -42,6 +42,9 @@ type metrics struct {
 	getAncestors, multiPut, getAncestorsFailed,
 	get, put, getFailed,
 	pushQuery, pullQuery, chits, queryFailed,
+	appRequest, appRequestFailed, appResponse, appGossip,
+	getStateSummaryFrontier, stateSummaryFrontier, getStateSummaryFrontierFailed,
+	getAcceptedStateSummary, acceptedStateSummary, getAcceptedStateSummaryFailed,
 	connected, disconnected,
 	notify,
 	gossip,
@@ -108,6 +111,16 @@ func (m *metrics) Initialize(namespace string, registerer prometheus.Registerer)
 	m.pullQuery = initHistogram(namespace, "pull_query", registerer, &errs)
 	m.chits = initHistogram(namespace, "chits", registerer, &errs)
 	m.queryFailed = initHistogram(namespace, "query_failed", registerer, &errs)
+	m.appRequest = initHistogram(namespace, "app_request", registerer, &errs)
+	m.appRequestFailed = initHistogram(namespace, "app_request_failed", registerer, &errs)
+	m.appResponse = initHistogram(namespace, "app_response", registerer, &errs)
+	m.appGossip = initHistogram(namespace, "app_gossip", registerer, &errs)
+	m.getStateSummaryFrontier = initHistogram(namespace, "get_state_summary_frontier", registerer, &errs)
+	m.stateSummaryFrontier = initHistogram(namespace, "state_summary_frontier", registerer, &errs)
+	m.getStateSummaryFrontierFailed = initHistogram(namespace, "get_state_summary_frontier_failed", registerer, &errs)
+	m.getAcceptedStateSummary = initHistogram(namespace, "get_accepted_state_summary", registerer, &errs)
+	m.acceptedStateSummary = initHistogram(namespace, "accepted_state_summary", registerer, &errs)
+	m.getAcceptedStateSummaryFailed = initHistogram(namespace, "get_accepted_state_summary_failed", registerer, &errs)
 	m.connected = initHistogram(namespace, "connected", registerer, &errs)
 	m.disconnected = initHistogram(namespace, "disconnected", registerer, &errs)
 	m.notify = initHistogram(namespace, "notify", registerer, &errs)
@@ -193,6 +206,26 @@ func (m *metrics) getMSGHistogram(msg constants.MsgType) prometheus.Histogram {
 		return m.queryFailed
 	case constants.ChitsMsg:
 		return m.chits
+	case constants.AppRequestMsg:
+		return m.appRequest
+	case constants.AppRequestFailedMsg:
+		return m.appRequestFailed
+	case constants.AppResponseMsg:
+		return m.appResponse
+	case constants.AppGossipMsg:
+		return m.appGossip
+	case constants.GetStateSummaryFrontierMsg:
+		return m.getStateSummaryFrontier
+	case constants.StateSummaryFrontierMsg:
+		return m.stateSummaryFrontier
+	case constants.GetStateSummaryFrontierFailedMsg:
+		return m.getStateSummaryFrontierFailed
+	case constants.GetAcceptedStateSummaryMsg:
+		return m.getAcceptedStateSummary
+	case constants.AcceptedStateSummaryMsg:
+		return m.acceptedStateSummary
+	case constants.GetAcceptedStateSummaryFailedMsg:
+		return m.getAcceptedStateSummaryFailed
 	case constants.ConnectedMsg:
 		return m.connected
 	case constants.DisconnectedMsg:
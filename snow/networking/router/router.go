@@ -45,6 +45,13 @@ type ExternalRouter interface {
 	PushQuery(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, containerID ids.ID, container []byte)
 	PullQuery(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, containerID ids.ID)
 	Chits(validatorID ids.ShortID, chainID ids.ID, requestID uint32, votes []ids.ID)
+	AppRequest(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte)
+	AppResponse(validatorID ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte)
+	AppGossip(validatorID ids.ShortID, chainID ids.ID, appGossipBytes []byte)
+	GetStateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time)
+	StateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte)
+	GetAcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64)
+	AcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID)
 }
 
 // InternalRouter deals with messages internal to this node
@@ -54,6 +61,9 @@ type InternalRouter interface {
 	GetFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
 	GetAncestorsFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
 	QueryFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
+	AppRequestFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
+	GetStateSummaryFrontierFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
+	GetAcceptedStateSummaryFailed(validatorID ids.ShortID, chainID ids.ID, requestID uint32)
 
 	Connected(validatorID ids.ShortID)
 	Disconnected(validatorID ids.ShortID)
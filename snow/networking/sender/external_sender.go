@@ -29,4 +29,15 @@ type ExternalSender interface {
 	Chits(validatorID ids.ShortID, chainID ids.ID, requestID uint32, votes []ids.ID)
 
 	Gossip(chainID ids.ID, containerID ids.ID, container []byte)
+
+	AppRequest(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte)
+	AppResponse(validatorID ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte)
+	AppGossip(chainID ids.ID, appGossipBytes []byte)
+	AppGossipSpecific(validatorIDs ids.ShortSet, chainID ids.ID, appGossipBytes []byte)
+
+	GetStateSummaryFrontier(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time)
+	StateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte)
+
+	GetAcceptedStateSummary(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64)
+	AcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/corpetty/avalanchego/snow/networking/router"
 	"github.com/corpetty/avalanchego/snow/networking/timeout"
 	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/formatting"
 )
 
 // Sender sends consensus messages to other validators
@@ -243,3 +244,152 @@ func (s *Sender) Gossip(containerID ids.ID, container []byte) {
 	s.ctx.Log.Verbo("Gossiping %s", containerID)
 	s.sender.Gossip(s.ctx.ChainID, containerID, container)
 }
+
+// SendAppRequest sends an application level request to the validators in
+// [nodeIDs]
+func (s *Sender) SendAppRequest(nodeIDs ids.ShortSet, requestID uint32, appRequestBytes []byte) error {
+	s.ctx.Log.Verbo("Sending AppRequest. RequestID: %d. Message: %s", requestID, formatting.DumpBytes{Bytes: appRequestBytes})
+
+	currentDeadline := time.Time{}
+	for nodeID := range nodeIDs {
+		nID := nodeID // Prevent overwrite in next loop iteration
+		deadline := s.timeouts.RegisterAppRequest(nID, s.ctx.ChainID, requestID, func() {
+			s.router.AppRequestFailed(nID, s.ctx.ChainID, requestID)
+		})
+		if deadline.After(currentDeadline) {
+			currentDeadline = deadline
+		}
+	}
+
+	// If one of the validators in [nodeIDs] is myself, send this message
+	// directly to my own router rather than sending it over the network
+	if nodeIDs.Contains(s.ctx.NodeID) {
+		nodeIDs = withoutNodeID(nodeIDs, s.ctx.NodeID)
+		// We use a goroutine to avoid a deadlock in the case where the VM sends
+		// a request to itself.
+		go s.router.AppRequest(s.ctx.NodeID, s.ctx.ChainID, requestID, currentDeadline, appRequestBytes)
+	}
+
+	s.sender.AppRequest(nodeIDs, s.ctx.ChainID, requestID, currentDeadline, appRequestBytes)
+	return nil
+}
+
+// SendAppResponse sends a response to an application level request from the
+// validator with ID [nodeID]
+func (s *Sender) SendAppResponse(nodeID ids.ShortID, requestID uint32, appResponseBytes []byte) error {
+	s.ctx.Log.Verbo("Sending AppResponse to validator %s. RequestID: %d. Message: %s",
+		nodeID, requestID, formatting.DumpBytes{Bytes: appResponseBytes})
+
+	if nodeID == s.ctx.NodeID {
+		go s.router.AppResponse(nodeID, s.ctx.ChainID, requestID, appResponseBytes)
+	} else {
+		s.sender.AppResponse(nodeID, s.ctx.ChainID, requestID, appResponseBytes)
+	}
+	return nil
+}
+
+// SendAppGossip gossips an application level message throughout the network
+func (s *Sender) SendAppGossip(appGossipBytes []byte) error {
+	s.ctx.Log.Verbo("Gossiping AppGossip. Message: %s", formatting.DumpBytes{Bytes: appGossipBytes})
+	s.sender.AppGossip(s.ctx.ChainID, appGossipBytes)
+	return nil
+}
+
+// SendAppGossipSpecific gossips an application level message to the nodes in
+// [nodeIDs]
+func (s *Sender) SendAppGossipSpecific(nodeIDs ids.ShortSet, appGossipBytes []byte) error {
+	s.ctx.Log.Verbo("Gossiping AppGossip to %s. Message: %s", nodeIDs, formatting.DumpBytes{Bytes: appGossipBytes})
+	if nodeIDs.Contains(s.ctx.NodeID) {
+		nodeIDs = withoutNodeID(nodeIDs, s.ctx.NodeID)
+	}
+	s.sender.AppGossipSpecific(nodeIDs, s.ctx.ChainID, appGossipBytes)
+	return nil
+}
+
+// withoutNodeID returns a copy of [nodeIDs] that doesn't contain [nodeID].
+// The sets passed to the App methods belong to the VM, so they aren't
+// modified.
+func withoutNodeID(nodeIDs ids.ShortSet, nodeID ids.ShortID) ids.ShortSet {
+	otherIDs := ids.ShortSet{}
+	otherIDs.Union(nodeIDs)
+	otherIDs.Remove(nodeID)
+	return otherIDs
+}
+
+// GetStateSummaryFrontier asks each validator in [validatorIDs] for its most
+// recent state summary. A timeout is registered for each validator, and
+// GetStateSummaryFrontierFailed is routed to the engine for validators that
+// don't respond in time or can't be sent the request. If this node is in
+// [validatorIDs], the request is routed to it directly.
+func (s *Sender) GetStateSummaryFrontier(validatorIDs ids.ShortSet, requestID uint32) {
+	currentDeadline := time.Time{}
+	for validatorID := range validatorIDs {
+		vID := validatorID // Prevent overwrite in next loop iteration
+		deadline, ok := s.timeouts.Register(vID, s.ctx.ChainID, requestID, true, constants.GetStateSummaryFrontierMsg, func() {
+			s.router.GetStateSummaryFrontierFailed(vID, s.ctx.ChainID, requestID)
+		})
+		if deadline.After(currentDeadline) {
+			currentDeadline = deadline
+		}
+		if !ok {
+			validatorIDs.Remove(vID)
+		}
+	}
+
+	if validatorIDs.Contains(s.ctx.NodeID) {
+		validatorIDs.Remove(s.ctx.NodeID)
+		go s.router.GetStateSummaryFrontier(s.ctx.NodeID, s.ctx.ChainID, requestID, currentDeadline)
+	}
+
+	s.sender.GetStateSummaryFrontier(validatorIDs, s.ctx.ChainID, requestID, currentDeadline)
+}
+
+// StateSummaryFrontier responds to the GetStateSummaryFrontier request
+// [requestID] from [validatorID] with this node's most recent state summary.
+// An empty [summary] means that this node doesn't have one.
+func (s *Sender) StateSummaryFrontier(validatorID ids.ShortID, requestID uint32, summary []byte) {
+	if validatorID == s.ctx.NodeID {
+		go s.router.StateSummaryFrontier(validatorID, s.ctx.ChainID, requestID, summary)
+	} else {
+		s.sender.StateSummaryFrontier(validatorID, s.ctx.ChainID, requestID, summary)
+	}
+}
+
+// GetAcceptedStateSummary asks each validator in [validatorIDs] for the IDs of
+// the state summaries it has accepted at [heights]. A timeout is registered for
+// each validator, and GetAcceptedStateSummaryFailed is routed to the engine for
+// validators that don't respond in time or can't be sent the request. If this
+// node is in [validatorIDs], the request is routed to it directly.
+func (s *Sender) GetAcceptedStateSummary(validatorIDs ids.ShortSet, requestID uint32, heights []uint64) {
+	currentDeadline := time.Time{}
+	for validatorID := range validatorIDs {
+		vID := validatorID // Prevent overwrite in next loop iteration
+		deadline, ok := s.timeouts.Register(vID, s.ctx.ChainID, requestID, true, constants.GetAcceptedStateSummaryMsg, func() {
+			s.router.GetAcceptedStateSummaryFailed(vID, s.ctx.ChainID, requestID)
+		})
+		if deadline.After(currentDeadline) {
+			currentDeadline = deadline
+		}
+		if !ok {
+			validatorIDs.Remove(vID)
+		}
+	}
+
+	if validatorIDs.Contains(s.ctx.NodeID) {
+		validatorIDs.Remove(s.ctx.NodeID)
+		go s.router.GetAcceptedStateSummary(s.ctx.NodeID, s.ctx.ChainID, requestID, currentDeadline, heights)
+	}
+
+	s.sender.GetAcceptedStateSummary(validatorIDs, s.ctx.ChainID, requestID, currentDeadline, heights)
+}
+
+// AcceptedStateSummary responds to the GetAcceptedStateSummary request
+// [requestID] from [validatorID] with the IDs of the state summaries this node
+// has accepted at the requested heights.
+func (s *Sender) AcceptedStateSummary(validatorID ids.ShortID, requestID uint32, summaryIDs []ids.ID) {
+	if validatorID == s.ctx.NodeID {
+		go s.router.AcceptedStateSummary(validatorID, s.ctx.ChainID, requestID, summaryIDs)
+	} else {
+		s.sender.AcceptedStateSummary(validatorID, s.ctx.ChainID, requestID, summaryIDs)
+	}
+}
@@ -106,6 +106,137 @@ func TestTimeout(t *testing.T) {
 	}
 }
 
+func TestAppRequestTimeout(t *testing.T) {
+	vdrs := validators.NewSet()
+	benchlist := benchlist.NewNoBenchlist()
+	tm := timeout.Manager{}
+	err := tm.Initialize(&timer.AdaptiveTimeoutConfig{
+		InitialTimeout: time.Millisecond,
+		MinimumTimeout: time.Millisecond,
+		MaximumTimeout: 10 * time.Second,
+		TimeoutInc:     2 * time.Millisecond,
+		TimeoutDec:     time.Millisecond,
+		Namespace:      "",
+		Registerer:     prometheus.NewRegistry(),
+	}, benchlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go tm.Dispatch()
+
+	chainRouter := router.ChainRouter{}
+	chainRouter.Initialize(ids.ShortEmpty, logging.NoLog{}, &tm, time.Hour, time.Second, ids.Set{}, nil)
+
+	sender := Sender{}
+	sender.Initialize(snow.DefaultContextTest(), &ExternalSenderTest{}, &chainRouter, &tm)
+
+	engine := common.EngineTest{T: t}
+	engine.Default(true)
+	engine.CantConnected = false
+
+	engine.ContextF = snow.DefaultContextTest
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	failedVDRs := ids.ShortSet{}
+	engine.AppRequestFailedF = func(nodeID ids.ShortID, _ uint32) error {
+		failedVDRs.Add(nodeID)
+		wg.Done()
+		return nil
+	}
+
+	handler := router.Handler{}
+	handler.Initialize(
+		&engine,
+		vdrs,
+		nil,
+		1,
+		router.DefaultMaxNonStakerPendingMsgs,
+		router.DefaultStakerPortion,
+		router.DefaultStakerPortion,
+		"",
+		prometheus.NewRegistry(),
+	)
+	go handler.Dispatch()
+
+	chainRouter.AddChain(&handler)
+
+	vdrIDs := ids.ShortSet{}
+	vdrIDs.Add(ids.ShortID{255})
+	vdrIDs.Add(ids.ShortID{254})
+
+	if err := sender.SendAppRequest(vdrIDs, 0, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	wg.Wait()
+
+	if !failedVDRs.Equals(vdrIDs) {
+		t.Fatalf("Timeouts should have fired")
+	}
+}
+
+func TestAppSendersKeepNodeIDs(t *testing.T) {
+	tm := timeout.Manager{}
+	err := tm.Initialize(&timer.AdaptiveTimeoutConfig{
+		InitialTimeout: time.Hour,
+		MinimumTimeout: time.Hour,
+		MaximumTimeout: time.Hour,
+		TimeoutInc:     time.Millisecond,
+		TimeoutDec:     time.Millisecond,
+		Namespace:      "",
+		Registerer:     prometheus.NewRegistry(),
+	}, benchlist.NewNoBenchlist())
+	if err != nil {
+		t.Fatal(err)
+	}
+	go tm.Dispatch()
+
+	chainRouter := router.ChainRouter{}
+	chainRouter.Initialize(ids.ShortEmpty, logging.NoLog{}, &tm, time.Hour, time.Second, ids.Set{}, nil)
+
+	ctx := snow.DefaultContextTest()
+	ctx.NodeID = ids.ShortID{1}
+	otherID := ids.ShortID{2}
+
+	sentTo := ids.ShortSet{}
+	externalSender := &ExternalSenderTest{T: t}
+	externalSender.AppRequestF = func(nodeIDs ids.ShortSet, _ ids.ID, _ uint32, _ time.Time, _ []byte) {
+		sentTo = nodeIDs
+	}
+	externalSender.AppGossipSpecificF = func(nodeIDs ids.ShortSet, _ ids.ID, _ []byte) {
+		sentTo = nodeIDs
+	}
+
+	sender := Sender{}
+	sender.Initialize(ctx, externalSender, &chainRouter, &tm)
+
+	nodeIDs := ids.ShortSet{}
+	nodeIDs.Add(ctx.NodeID, otherID)
+
+	if err := sender.SendAppRequest(nodeIDs, 0, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if sentTo.Len() != 1 || !sentTo.Contains(otherID) {
+		t.Fatalf("should have only sent the request to %s but sent it to %s", otherID, sentTo)
+	}
+	if nodeIDs.Len() != 2 {
+		t.Fatalf("SendAppRequest modified the set of node IDs")
+	}
+
+	sentTo = nil
+	if err := sender.SendAppGossipSpecific(nodeIDs, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if sentTo.Len() != 1 || !sentTo.Contains(otherID) {
+		t.Fatalf("should have only gossiped to %s but gossiped to %s", otherID, sentTo)
+	}
+	if nodeIDs.Len() != 2 {
+		t.Fatalf("SendAppGossipSpecific modified the set of node IDs")
+	}
+}
+
 func TestReliableMessages(t *testing.T) {
 	vdrs := validators.NewSet()
 	benchlist := benchlist.NewNoBenchlist()
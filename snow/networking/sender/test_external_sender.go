@@ -20,7 +20,10 @@ type ExternalSenderTest struct {
 	CantGetAncestors, CantMultiPut,
 	CantGet, CantPut,
 	CantPullQuery, CantPushQuery, CantChits,
-	CantGossip bool
+	CantGossip,
+	CantAppRequest, CantAppResponse, CantAppGossip, CantAppGossipSpecific,
+	CantGetStateSummaryFrontier, CantStateSummaryFrontier,
+	CantGetAcceptedStateSummary, CantAcceptedStateSummary bool
 
 	GetAcceptedFrontierF func(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time)
 	AcceptedFrontierF    func(validatorID ids.ShortID, chainID ids.ID, requestID uint32, containerIDs []ids.ID)
@@ -39,6 +42,17 @@ type ExternalSenderTest struct {
 	ChitsF     func(validatorID ids.ShortID, chainID ids.ID, requestID uint32, votes []ids.ID)
 
 	GossipF func(chainID ids.ID, containerID ids.ID, container []byte)
+
+	AppRequestF        func(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte)
+	AppResponseF       func(validatorID ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte)
+	AppGossipF         func(chainID ids.ID, appGossipBytes []byte)
+	AppGossipSpecificF func(validatorIDs ids.ShortSet, chainID ids.ID, appGossipBytes []byte)
+
+	GetStateSummaryFrontierF func(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time)
+	StateSummaryFrontierF    func(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte)
+
+	GetAcceptedStateSummaryF func(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64)
+	AcceptedStateSummaryF    func(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID)
 }
 
 // Default set the default callable value to [cant]
@@ -60,6 +74,17 @@ func (s *ExternalSenderTest) Default(cant bool) {
 	s.CantChits = cant
 
 	s.CantGossip = cant
+
+	s.CantAppRequest = cant
+	s.CantAppResponse = cant
+	s.CantAppGossip = cant
+	s.CantAppGossipSpecific = cant
+
+	s.CantGetStateSummaryFrontier = cant
+	s.CantStateSummaryFrontier = cant
+
+	s.CantGetAcceptedStateSummary = cant
+	s.CantAcceptedStateSummary = cant
 }
 
 // GetAcceptedFrontier calls GetAcceptedFrontierF if it was initialized. If it
@@ -229,3 +254,115 @@ func (s *ExternalSenderTest) Gossip(chainID ids.ID, containerID ids.ID, containe
 		s.B.Fatalf("Unexpectedly called Gossip")
 	}
 }
+
+// AppRequest calls AppRequestF if it was initialized. If it wasn't initialized
+// and this function shouldn't be called and testing was initialized, then
+// testing will fail.
+func (s *ExternalSenderTest) AppRequest(vdrs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, appRequestBytes []byte) {
+	switch {
+	case s.AppRequestF != nil:
+		s.AppRequestF(vdrs, chainID, requestID, deadline, appRequestBytes)
+	case s.CantAppRequest && s.T != nil:
+		s.T.Fatalf("Unexpectedly called AppRequest")
+	case s.CantAppRequest && s.B != nil:
+		s.B.Fatalf("Unexpectedly called AppRequest")
+	}
+}
+
+// AppResponse calls AppResponseF if it was initialized. If it wasn't
+// initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *ExternalSenderTest) AppResponse(vdr ids.ShortID, chainID ids.ID, requestID uint32, appResponseBytes []byte) {
+	switch {
+	case s.AppResponseF != nil:
+		s.AppResponseF(vdr, chainID, requestID, appResponseBytes)
+	case s.CantAppResponse && s.T != nil:
+		s.T.Fatalf("Unexpectedly called AppResponse")
+	case s.CantAppResponse && s.B != nil:
+		s.B.Fatalf("Unexpectedly called AppResponse")
+	}
+}
+
+// AppGossip calls AppGossipF if it was initialized. If it wasn't initialized
+// and this function shouldn't be called and testing was initialized, then
+// testing will fail.
+func (s *ExternalSenderTest) AppGossip(chainID ids.ID, appGossipBytes []byte) {
+	switch {
+	case s.AppGossipF != nil:
+		s.AppGossipF(chainID, appGossipBytes)
+	case s.CantAppGossip && s.T != nil:
+		s.T.Fatalf("Unexpectedly called AppGossip")
+	case s.CantAppGossip && s.B != nil:
+		s.B.Fatalf("Unexpectedly called AppGossip")
+	}
+}
+
+// AppGossipSpecific calls AppGossipSpecificF if it was initialized. If it
+// wasn't initialized and this function shouldn't be called and testing was
+// initialized, then testing will fail.
+func (s *ExternalSenderTest) AppGossipSpecific(validatorIDs ids.ShortSet, chainID ids.ID, appGossipBytes []byte) {
+	switch {
+	case s.AppGossipSpecificF != nil:
+		s.AppGossipSpecificF(validatorIDs, chainID, appGossipBytes)
+	case s.CantAppGossipSpecific && s.T != nil:
+		s.T.Fatalf("Unexpectedly called AppGossipSpecific")
+	case s.CantAppGossipSpecific && s.B != nil:
+		s.B.Fatalf("Unexpectedly called AppGossipSpecific")
+	}
+}
+
+// GetStateSummaryFrontier calls GetStateSummaryFrontierF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *ExternalSenderTest) GetStateSummaryFrontier(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time) {
+	switch {
+	case s.GetStateSummaryFrontierF != nil:
+		s.GetStateSummaryFrontierF(validatorIDs, chainID, requestID, deadline)
+	case s.CantGetStateSummaryFrontier && s.T != nil:
+		s.T.Fatalf("Unexpectedly called GetStateSummaryFrontier")
+	case s.CantGetStateSummaryFrontier && s.B != nil:
+		s.B.Fatalf("Unexpectedly called GetStateSummaryFrontier")
+	}
+}
+
+// StateSummaryFrontier calls StateSummaryFrontierF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *ExternalSenderTest) StateSummaryFrontier(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summary []byte) {
+	switch {
+	case s.StateSummaryFrontierF != nil:
+		s.StateSummaryFrontierF(validatorID, chainID, requestID, summary)
+	case s.CantStateSummaryFrontier && s.T != nil:
+		s.T.Fatalf("Unexpectedly called StateSummaryFrontier")
+	case s.CantStateSummaryFrontier && s.B != nil:
+		s.B.Fatalf("Unexpectedly called StateSummaryFrontier")
+	}
+}
+
+// GetAcceptedStateSummary calls GetAcceptedStateSummaryF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *ExternalSenderTest) GetAcceptedStateSummary(validatorIDs ids.ShortSet, chainID ids.ID, requestID uint32, deadline time.Time, heights []uint64) {
+	switch {
+	case s.GetAcceptedStateSummaryF != nil:
+		s.GetAcceptedStateSummaryF(validatorIDs, chainID, requestID, deadline, heights)
+	case s.CantGetAcceptedStateSummary && s.T != nil:
+		s.T.Fatalf("Unexpectedly called GetAcceptedStateSummary")
+	case s.CantGetAcceptedStateSummary && s.B != nil:
+		s.B.Fatalf("Unexpectedly called GetAcceptedStateSummary")
+	}
+}
+
+// AcceptedStateSummary calls AcceptedStateSummaryF if it was initialized. If it wasn't initialized and
+// this function shouldn't be called and testing was initialized, then testing
+// will fail.
+func (s *ExternalSenderTest) AcceptedStateSummary(validatorID ids.ShortID, chainID ids.ID, requestID uint32, summaryIDs []ids.ID) {
+	switch {
+	case s.AcceptedStateSummaryF != nil:
+		s.AcceptedStateSummaryF(validatorID, chainID, requestID, summaryIDs)
+	case s.CantAcceptedStateSummary && s.T != nil:
+		s.T.Fatalf("Unexpectedly called AcceptedStateSummary")
+	case s.CantAcceptedStateSummary && s.B != nil:
+		s.B.Fatalf("Unexpectedly called AcceptedStateSummary")
+	}
+}
@@ -15,6 +15,10 @@ import (
 	"github.com/corpetty/avalanchego/utils/wrappers"
 )
 
+// appRequestPrefix separates the request IDs of application level requests
+// from the request IDs of consensus requests.
+const appRequestPrefix byte = 1
+
 // Manager registers and fires timeouts for the snow API.
 type Manager struct {
 	tm        timer.AdaptiveTimeoutManager
@@ -69,9 +73,33 @@ func (m *Manager) Cancel(validatorID ids.ShortID, chainID ids.ID, requestID uint
 	m.tm.Remove(createRequestID(validatorID, chainID, requestID))
 }
 
+// RegisterAppRequest registers an application level request to time out
+// unless Manager.CancelAppRequest is called before the timeout duration
+// passes, with the same request parameters. Application level requests are
+// tracked separately from consensus requests, so the request IDs chosen by a
+// VM can't collide with the request IDs chosen by its consensus engine.
+// Application level requests are not reported to the benchlist.
+func (m *Manager) RegisterAppRequest(validatorID ids.ShortID, chainID ids.ID, requestID uint32, timeout func()) time.Time {
+	return m.tm.Put(createAppRequestID(validatorID, chainID, requestID), timeout)
+}
+
+// CancelAppRequest cancels the application level request timeout with the
+// specified parameters.
+func (m *Manager) CancelAppRequest(validatorID ids.ShortID, chainID ids.ID, requestID uint32) {
+	m.tm.Remove(createAppRequestID(validatorID, chainID, requestID))
+}
+
 func createRequestID(validatorID ids.ShortID, chainID ids.ID, requestID uint32) ids.ID {
 	p := wrappers.Packer{Bytes: make([]byte, wrappers.IntLen)}
 	p.PackInt(requestID)
 
 	return hashing.ByteArraysToHash256Array(validatorID.Bytes(), chainID[:], p.Bytes)
 }
+
+func createAppRequestID(validatorID ids.ShortID, chainID ids.ID, requestID uint32) ids.ID {
+	p := wrappers.Packer{Bytes: make([]byte, wrappers.ByteLen+wrappers.IntLen)}
+	p.PackByte(appRequestPrefix)
+	p.PackInt(requestID)
+
+	return hashing.ByteArraysToHash256Array(validatorID.Bytes(), chainID[:], p.Bytes)
+}
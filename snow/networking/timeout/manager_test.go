@@ -74,3 +74,41 @@ func TestManagerCancel(t *testing.T) {
 		t.Fatalf("Should have cancelled the function")
 	}
 }
+
+func TestManagerAppRequestNamespace(t *testing.T) {
+	manager := Manager{}
+	benchlist := benchlist.NewNoBenchlist()
+	err := manager.Initialize(&timer.AdaptiveTimeoutConfig{
+		InitialTimeout: time.Millisecond,
+		MinimumTimeout: time.Millisecond,
+		MaximumTimeout: 10 * time.Second,
+		TimeoutInc:     2 * time.Millisecond,
+		TimeoutDec:     time.Millisecond,
+		Namespace:      "",
+		Registerer:     prometheus.NewRegistry(),
+	}, benchlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go manager.Dispatch()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	fired := new(bool)
+
+	manager.RegisterAppRequest(ids.ShortID{}, ids.ID{}, 0, func() { *fired = true })
+	manager.Register(ids.ShortID{}, ids.ID{}, 0, true, 0, wg.Done)
+
+	// Cancelling the app request must not cancel the consensus request with
+	// the same request ID.
+	manager.CancelAppRequest(ids.ShortID{}, ids.ID{}, 0)
+
+	manager.RegisterAppRequest(ids.ShortID{}, ids.ID{}, 1, wg.Done)
+
+	wg.Wait()
+
+	if *fired {
+		t.Fatalf("Should have cancelled the function")
+	}
+}
@@ -0,0 +1,121 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bloom
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/corpetty/avalanchego/utils/hashing"
+)
+
+const (
+	// MaxHashes is the maximum number of hash functions a filter may use
+	MaxHashes = 16
+
+	// MaxBytes is the maximum size, in bytes, of a filter's bit array
+	MaxBytes = 128 * 1024
+)
+
+var (
+	errNoHashes       = errors.New("filter must use at least one hash function")
+	errTooManyHashes  = fmt.Errorf("filter may use at most %d hash functions", MaxHashes)
+	errNoBits         = errors.New("filter must have a non-empty bit array")
+	errTooManyBits    = fmt.Errorf("filter's bit array may be at most %d bytes", MaxBytes)
+	errNoElements     = errors.New("expected number of elements must be positive")
+	errInvalidFPRate  = errors.New("false positive rate must be in (0, 1)")
+	errFilterTooLarge = errors.New("filter with the requested parameters would be too large")
+)
+
+// Filter is a bloom filter. It is used by clients to tell a server which
+// elements they are interested in without revealing exactly which ones.
+//
+// Element e is in the filter if, for every i in [0, NumHashes), the bit at
+// (h1 + i*h2) mod (8*len(Bits)) is set, where h1 and h2 are the first and
+// second big-endian uint64s of sha256(e).
+type Filter struct {
+	// Number of hash functions used for each element
+	NumHashes uint32 `json:"numHashes"`
+	// Bit array of the filter
+	Bits []byte `json:"bits"`
+}
+
+// New returns an empty filter that is expected to hold [numElements] elements
+// with a false positive rate of [falsePositiveRate]
+func New(numElements int, falsePositiveRate float64) (*Filter, error) {
+	switch {
+	case numElements <= 0:
+		return nil, errNoElements
+	case falsePositiveRate <= 0 || falsePositiveRate >= 1:
+		return nil, errInvalidFPRate
+	}
+
+	// m = -n*ln(p) / ln(2)^2
+	numBits := math.Ceil(-float64(numElements) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	numBytes := math.Ceil(numBits / 8)
+	if numBytes > MaxBytes {
+		return nil, errFilterTooLarge
+	}
+	// k = (m/n) * ln(2)
+	numHashes := math.Round(8 * numBytes / float64(numElements) * math.Ln2)
+	switch {
+	case numHashes < 1:
+		numHashes = 1
+	case numHashes > MaxHashes:
+		numHashes = MaxHashes
+	}
+	return &Filter{
+		NumHashes: uint32(numHashes),
+		Bits:      make([]byte, int(numBytes)),
+	}, nil
+}
+
+// Verify returns nil iff this filter is well formed
+func (f *Filter) Verify() error {
+	switch {
+	case f.NumHashes == 0:
+		return errNoHashes
+	case f.NumHashes > MaxHashes:
+		return errTooManyHashes
+	case len(f.Bits) == 0:
+		return errNoBits
+	case len(f.Bits) > MaxBytes:
+		return errTooManyBits
+	default:
+		return nil
+	}
+}
+
+// Add [elements] to the filter
+func (f *Filter) Add(elements ...[]byte) {
+	for _, element := range elements {
+		h1, h2 := hash(element)
+		numBits := uint64(len(f.Bits)) * 8
+		for i := uint64(0); i < uint64(f.NumHashes); i++ {
+			bit := (h1 + i*h2) % numBits
+			f.Bits[bit/8] |= 1 << (bit % 8)
+		}
+	}
+}
+
+// Contains returns true if [element] may have been added to the filter. It
+// returns false if [element] was definitely not added to the filter.
+func (f *Filter) Contains(element []byte) bool {
+	h1, h2 := hash(element)
+	numBits := uint64(len(f.Bits)) * 8
+	for i := uint64(0); i < uint64(f.NumHashes); i++ {
+		bit := (h1 + i*h2) % numBits
+		if f.Bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func hash(element []byte) (uint64, uint64) {
+	digest := hashing.ComputeHash256Array(element)
+	return binary.BigEndian.Uint64(digest[:8]), binary.BigEndian.Uint64(digest[8:16])
+}
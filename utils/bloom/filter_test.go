@@ -0,0 +1,97 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bloom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+)
+
+func TestFilter(t *testing.T) {
+	f, err := New(100, .01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	added := make([]ids.ShortID, 100)
+	for i := range added {
+		added[i] = ids.GenerateTestShortID()
+		f.Add(added[i][:])
+	}
+	for _, id := range added {
+		if !f.Contains(id[:]) {
+			t.Fatalf("filter should contain %s", id)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		id := ids.GenerateTestShortID()
+		if f.Contains(id[:]) {
+			falsePositives++
+		}
+	}
+	// The expected number of false positives is 100
+	if falsePositives > 300 {
+		t.Fatalf("too many false positives: %d", falsePositives)
+	}
+}
+
+func TestFilterJSON(t *testing.T) {
+	f, err := New(10, .1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	element := []byte("element")
+	f.Add(element)
+
+	fBytes, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := &Filter{}
+	if err := json.Unmarshal(fBytes, parsed); err != nil {
+		t.Fatal(err)
+	}
+	if err := parsed.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Contains(element) {
+		t.Fatal("parsed filter should contain the added element")
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	if _, err := New(0, .1); err == nil {
+		t.Fatal("should have failed with no elements")
+	}
+	if _, err := New(10, 0); err == nil {
+		t.Fatal("should have failed with a false positive rate of 0")
+	}
+	if _, err := New(10, 1); err == nil {
+		t.Fatal("should have failed with a false positive rate of 1")
+	}
+	if _, err := New(1<<30, .0001); err == nil {
+		t.Fatal("should have failed with a filter that is too large")
+	}
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	tests := []*Filter{
+		{NumHashes: 0, Bits: make([]byte, 1)},
+		{NumHashes: MaxHashes + 1, Bits: make([]byte, 1)},
+		{NumHashes: 1},
+		{NumHashes: 1, Bits: make([]byte, MaxBytes+1)},
+	}
+	for _, f := range tests {
+		if err := f.Verify(); err == nil {
+			t.Fatalf("filter %d/%d should have failed verification", f.NumHashes, len(f.Bits))
+		}
+	}
+}
@@ -30,6 +30,16 @@ const (
 	GetAncestorsMsg
 	MultiPutMsg
 	GetAncestorsFailedMsg
+	AppRequestMsg
+	AppRequestFailedMsg
+	AppResponseMsg
+	AppGossipMsg
+	GetStateSummaryFrontierMsg
+	StateSummaryFrontierMsg
+	GetStateSummaryFrontierFailedMsg
+	GetAcceptedStateSummaryMsg
+	AcceptedStateSummaryMsg
+	GetAcceptedStateSummaryFailedMsg
 )
 
 func (t MsgType) String() string {
@@ -76,6 +86,26 @@ func (t MsgType) String() string {
 		return "Notify Message"
 	case GossipMsg:
 		return "Gossip Message"
+	case AppRequestMsg:
+		return "App Request Message"
+	case AppRequestFailedMsg:
+		return "App Request Failed Message"
+	case AppResponseMsg:
+		return "App Response Message"
+	case AppGossipMsg:
+		return "App Gossip Message"
+	case GetStateSummaryFrontierMsg:
+		return "Get State Summary Frontier Message"
+	case StateSummaryFrontierMsg:
+		return "State Summary Frontier Message"
+	case GetStateSummaryFrontierFailedMsg:
+		return "Get State Summary Frontier Failed Message"
+	case GetAcceptedStateSummaryMsg:
+		return "Get Accepted State Summary Message"
+	case AcceptedStateSummaryMsg:
+		return "Accepted State Summary Message"
+	case GetAcceptedStateSummaryFailedMsg:
+		return "Get Accepted State Summary Failed Message"
 	default:
 		return fmt.Sprintf("Unknown Message Type: %d", t)
 	}
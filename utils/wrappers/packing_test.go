@@ -249,6 +249,41 @@ func TestPackerUnpackLong(t *testing.T) {
 	}
 }
 
+func TestPackerLongs(t *testing.T) {
+	p := Packer{MaxSize: IntLen + 2*LongLen}
+
+	expected := []uint64{0x0102030405060708, 0x090a0b0c0d0e0f00}
+	p.PackLongs(expected)
+	if p.Errored() {
+		t.Fatal(p.Err)
+	}
+
+	expectedBytes := []byte{
+		0x00, 0x00, 0x00, 0x02,
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x00,
+	}
+	if !bytes.Equal(p.Bytes, expectedBytes) {
+		t.Fatalf("Packer.PackLongs wrote:\n%v\nExpected:\n%v", p.Bytes, expectedBytes)
+	}
+
+	p = Packer{Bytes: p.Bytes}
+	actual := p.UnpackLongs()
+	switch {
+	case p.Errored():
+		t.Fatalf("Packer.UnpackLongs unexpectedly raised %s", p.Err)
+	case len(actual) != len(expected) || actual[0] != expected[0] || actual[1] != expected[1]:
+		t.Fatalf("Packer.UnpackLongs returned %v, but expected %v", actual, expected)
+	case p.Offset != len(expectedBytes):
+		t.Fatalf("Packer.UnpackLongs left Offset %d, expected %d", p.Offset, len(expectedBytes))
+	}
+
+	p.UnpackLongs()
+	if !p.Errored() {
+		t.Fatalf("Packer.UnpackLongs should have set error, due to attempted out of bounds read")
+	}
+}
+
 func TestPackerPackFixedBytes(t *testing.T) {
 	p := Packer{MaxSize: 4}
 
@@ -0,0 +1,188 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"errors"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/prefixdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+)
+
+var (
+	addressTxsPrefix = []byte("addressTxs")
+
+	// Maps to the number of transactions indexed for an (address, asset) pair
+	addressTxsCountKey = []byte("count")
+
+	// Maps to whether transactions were indexed the last time the chain ran
+	addressTxsEnabledKey = []byte("addressTxsEnabled")
+
+	errAddressTxsReenabled = errors.New("the transaction index was disabled while this chain accepted transactions, so it would be incomplete")
+	errAddressTxsDisabled  = errors.New("the transaction index was enabled the last time this chain ran, so disabling it would leave it incomplete")
+)
+
+// checkAddressTxsIndexHistory returns an error if running the chain whose
+// database is [db] with the transaction index [enabled] would leave the index
+// missing transactions, because the index was disabled after the chain was
+// created and is now enabled, or because it was enabled the last time the
+// chain ran and is now disabled. [newChain] should be true if the chain was
+// created by this run. If [allowIncomplete], a warning is logged instead. The
+// index's state is recorded so that the next run can be checked.
+func checkAddressTxsIndexHistory(db database.Database, log logging.Logger, enabled, allowIncomplete, newChain bool) error {
+	// If the index has never been checked, nothing has been indexed unless the
+	// chain was just created
+	previouslyEnabled := enabled && newChain
+	enabledBytes, err := db.Get(addressTxsEnabledKey)
+	switch err {
+	case nil:
+		p := wrappers.Packer{Bytes: enabledBytes}
+		previouslyEnabled = p.UnpackBool()
+		if p.Err != nil {
+			return p.Err
+		}
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+
+	switch {
+	case enabled && !previouslyEnabled:
+		if !allowIncomplete {
+			return errAddressTxsReenabled
+		}
+		log.Warn("the transaction index is missing the transactions accepted while it was disabled")
+	case !enabled && previouslyEnabled:
+		if !allowIncomplete {
+			return errAddressTxsDisabled
+		}
+		log.Warn("disabling the transaction index. The transactions accepted while it is disabled won't be indexed")
+	}
+
+	p := wrappers.Packer{MaxSize: wrappers.BoolLen}
+	p.PackBool(enabled)
+	if p.Err != nil {
+		return p.Err
+	}
+	return db.Put(addressTxsEnabledKey, p.Bytes)
+}
+
+// addressTxsIndex indexes, for each (address, asset) pair, the accepted
+// transactions that consumed or produced UTXOs of that asset owned by that
+// address. The transactions of a pair are indexed in the order they were
+// accepted in.
+type addressTxsIndex struct {
+	db database.Database
+}
+
+// newAddressTxsIndex returns a new index that is persisted in [db]
+func newAddressTxsIndex(db database.Database) *addressTxsIndex {
+	return &addressTxsIndex{db: prefixdb.New(addressTxsPrefix, db)}
+}
+
+type addressAssetPair struct {
+	addr    ids.ShortID
+	assetID ids.ID
+}
+
+// Accept indexes [txID] under every (address, asset) pair of the UTXOs it
+// consumed and produced
+func (i *addressTxsIndex) Accept(txID ids.ID, inputUTXOs, outputUTXOs []*avax.UTXO) error {
+	pairs := []addressAssetPair{}
+	seen := map[addressAssetPair]struct{}{}
+	for _, utxos := range [][]*avax.UTXO{inputUTXOs, outputUTXOs} {
+		for _, utxo := range utxos {
+			addressable, ok := utxo.Out.(avax.Addressable)
+			if !ok {
+				continue
+			}
+			assetID := utxo.AssetID()
+			for _, addrBytes := range addressable.Addresses() {
+				addr, err := ids.ToShortID(addrBytes)
+				if err != nil {
+					return err
+				}
+				pair := addressAssetPair{
+					addr:    addr,
+					assetID: assetID,
+				}
+				if _, ok := seen[pair]; ok {
+					continue
+				}
+				seen[pair] = struct{}{}
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+
+	for _, pair := range pairs {
+		db := i.pairDB(pair.addr, pair.assetID)
+		count, err := getCount(db)
+		if err != nil {
+			return err
+		}
+		if err := db.Put(packUint64(count), txID[:]); err != nil {
+			return err
+		}
+		if err := db.Put(addressTxsCountKey, packUint64(count+1)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read returns up to [pageSize] of the transactions indexed under
+// ([addr], [assetID]), starting with the one at [cursor]. It also returns the
+// cursor to use to fetch the next page.
+func (i *addressTxsIndex) Read(addr ids.ShortID, assetID ids.ID, cursor, pageSize uint64) ([]ids.ID, uint64, error) {
+	db := i.pairDB(addr, assetID)
+	count, err := getCount(db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txIDs := []ids.ID{}
+	for ; cursor < count && uint64(len(txIDs)) < pageSize; cursor++ {
+		txIDBytes, err := db.Get(packUint64(cursor))
+		if err != nil {
+			return nil, 0, err
+		}
+		txID, err := ids.ToID(txIDBytes)
+		if err != nil {
+			return nil, 0, err
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, cursor, nil
+}
+
+func (i *addressTxsIndex) pairDB(addr ids.ShortID, assetID ids.ID) database.Database {
+	return prefixdb.New(assetID[:], prefixdb.New(addr[:], i.db))
+}
+
+// getCount returns the number of transactions indexed in [db]
+func getCount(db database.Database) (uint64, error) {
+	countBytes, err := db.Get(addressTxsCountKey)
+	switch err {
+	case nil:
+	case database.ErrNotFound:
+		return 0, nil
+	default:
+		return 0, err
+	}
+
+	p := wrappers.Packer{Bytes: countBytes}
+	count := p.UnpackLong()
+	return count, p.Err
+}
+
+func packUint64(val uint64) []byte {
+	p := wrappers.Packer{Bytes: make([]byte, wrappers.LongLen)}
+	p.PackLong(val)
+	return p.Bytes
+}
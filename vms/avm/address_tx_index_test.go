@@ -0,0 +1,118 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+	"github.com/corpetty/avalanchego/vms/secp256k1fx"
+)
+
+func newTestUTXO(assetID ids.ID, addrs ...ids.ShortID) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     addrs,
+			},
+		},
+	}
+}
+
+func TestAddressTxsIndex(t *testing.T) {
+	index := newAddressTxsIndex(memdb.New())
+
+	addr0 := ids.GenerateTestShortID()
+	addr1 := ids.GenerateTestShortID()
+	assetID := ids.GenerateTestID()
+
+	txIDs := []ids.ID{}
+	for i := 0; i < 5; i++ {
+		txID := ids.GenerateTestID()
+		txIDs = append(txIDs, txID)
+
+		// [addr0] appears in both the inputs and outputs, but the transaction
+		// should only be indexed once
+		inputs := []*avax.UTXO{newTestUTXO(assetID, addr0)}
+		outputs := []*avax.UTXO{newTestUTXO(assetID, addr0, addr1)}
+		if err := index.Accept(txID, inputs, outputs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, addr := range []ids.ShortID{addr0, addr1} {
+		read := []ids.ID{}
+		cursor := uint64(0)
+		for {
+			page, next, err := index.Read(addr, assetID, cursor, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			if len(page) > 2 {
+				t.Fatalf("page size should be at most 2 but is %d", len(page))
+			}
+			read = append(read, page...)
+			cursor = next
+		}
+		if len(read) != len(txIDs) {
+			t.Fatalf("expected %d transactions but got %d", len(txIDs), len(read))
+		}
+		for i, txID := range txIDs {
+			if read[i] != txID {
+				t.Fatalf("expected transaction %d to be %s but got %s", i, txID, read[i])
+			}
+		}
+	}
+
+	txIDs, _, err := index.Read(addr0, ids.GenerateTestID(), 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txIDs) != 0 {
+		t.Fatalf("expected no transactions for an unknown asset but got %d", len(txIDs))
+	}
+}
+
+func TestCheckAddressTxsIndexHistory(t *testing.T) {
+	type run struct {
+		enabled, allowIncomplete, newChain bool
+		expectedErr                        error
+	}
+	tests := map[string][]run{
+		"enabled from the start": {
+			{enabled: true, newChain: true},
+			{enabled: true},
+			{enabled: false, expectedErr: errAddressTxsDisabled},
+			{enabled: false, allowIncomplete: true},
+			{enabled: true, expectedErr: errAddressTxsReenabled},
+			{enabled: true, allowIncomplete: true},
+			{enabled: true},
+		},
+		"enabled on an existing chain": {
+			{enabled: false},
+			{enabled: true, expectedErr: errAddressTxsReenabled},
+			{enabled: false},
+		},
+	}
+	for name, runs := range tests {
+		t.Run(name, func(t *testing.T) {
+			db := memdb.New()
+			for i, r := range runs {
+				if err := checkAddressTxsIndexHistory(db, logging.NoLog{}, r.enabled, r.allowIncomplete, r.newChain); err != r.expectedErr {
+					t.Fatalf("run %d: expected %v but got %v", i, r.expectedErr, err)
+				}
+			}
+		})
+	}
+}
@@ -865,6 +865,7 @@ func TestBaseTxSemanticVerifyUnauthorizedFx(t *testing.T) {
 		ctx,
 		memdb.New(),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -876,6 +877,7 @@ func TestBaseTxSemanticVerifyUnauthorizedFx(t *testing.T) {
 				Fx: fx,
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1286,6 +1288,7 @@ func TestBaseTxSemanticVerifyPendingUnauthorizedFx(t *testing.T) {
 		ctx,
 		memdb.New(),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -1297,6 +1300,7 @@ func TestBaseTxSemanticVerifyPendingUnauthorizedFx(t *testing.T) {
 				Fx: fx,
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1428,6 +1432,7 @@ func TestBaseTxSemanticVerifyPendingInvalidSignature(t *testing.T) {
 		ctx,
 		memdb.New(),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -1439,6 +1444,7 @@ func TestBaseTxSemanticVerifyPendingInvalidSignature(t *testing.T) {
 				Fx: fx,
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
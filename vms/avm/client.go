@@ -145,6 +145,20 @@ func (c *Client) GetBalance(addr string, assetID string, includePartial bool) (*
 	return res, err
 }
 
+// GetAddressTxs returns up to [pageSize] of the transactions that touched
+// [assetID] owned by [addr], starting at [cursor]. It also returns the cursor
+// to pass in to fetch the next page.
+func (c *Client) GetAddressTxs(addr string, assetID string, cursor uint64, pageSize uint64) ([]ids.ID, uint64, error) {
+	res := &GetAddressTxsReply{}
+	err := c.requester.SendRequest("getAddressTxs", &GetAddressTxsArgs{
+		Address:  addr,
+		AssetID:  assetID,
+		Cursor:   cjson.Uint64(cursor),
+		PageSize: cjson.Uint64(pageSize),
+	}, res)
+	return res.TxIDs, uint64(res.Cursor), err
+}
+
 // GetAllBalances returns all asset balances for [addr]
 func (c *Client) GetAllBalances(addr string, includePartial bool) (*GetAllBalancesReply, error) {
 	res := &GetAllBalancesReply{}
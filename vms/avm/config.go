@@ -0,0 +1,39 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Config is the configuration of a chain run by this VM. It is read from the
+// chain's config file, and any field that isn't set keeps its default.
+type Config struct {
+	// If set, overrides whether the transactions accepted by this chain are
+	// indexed by the addresses and assets they touched
+	IndexTransactions *bool `json:"indexTransactions"`
+	// If set, overrides whether the chain starts even if enabling or
+	// disabling the transaction index since the last run leaves the index
+	// missing transactions
+	IndexAllowIncomplete *bool `json:"indexAllowIncomplete"`
+}
+
+// parseConfig returns the config in [configBytes]. Empty [configBytes] means
+// the chain has no config file. Unknown fields are rejected so that typos
+// aren't silently ignored.
+func parseConfig(configBytes []byte) (Config, error) {
+	config := Config{}
+	if len(configBytes) == 0 {
+		return config, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(configBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return Config{}, fmt.Errorf("couldn't parse config: %w", err)
+	}
+	return config, nil
+}
@@ -0,0 +1,44 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	config, err := parseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.IndexTransactions != nil {
+		t.Fatalf("No config should leave the defaults")
+	}
+
+	config, err = parseConfig([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.IndexTransactions != nil {
+		t.Fatalf("An empty config should leave the defaults")
+	}
+
+	config, err = parseConfig([]byte(`{"indexTransactions": false}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.IndexTransactions == nil || *config.IndexTransactions {
+		t.Fatalf("Should have disabled indexing transactions")
+	}
+
+	if _, err := parseConfig([]byte(`{"indexTransactions": "yes"}`)); err == nil {
+		t.Fatalf("Should have errored due to a mistyped field")
+	}
+	if _, err := parseConfig([]byte(`{"indexTransaction": true}`)); err == nil {
+		t.Fatalf("Should have errored due to an unknown field")
+	}
+	if _, err := parseConfig([]byte(`not json`)); err == nil {
+		t.Fatalf("Should have errored due to malformed json")
+	}
+}
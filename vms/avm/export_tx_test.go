@@ -1027,6 +1027,7 @@ func TestExportTxSemanticVerifyInvalidFx(t *testing.T) {
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -1043,6 +1044,7 @@ func TestExportTxSemanticVerifyInvalidFx(t *testing.T) {
 				},
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1198,11 +1200,13 @@ func TestIssueExportTx(t *testing.T) {
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{{
 			ID: ids.Empty,
 			Fx: &secp256k1fx.Fx{},
 		}},
+		nil,
 	); err != nil {
 		t.Fatal(err)
 	}
@@ -1326,11 +1330,13 @@ func TestClearForceAcceptedExportTx(t *testing.T) {
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{{
 			ID: ids.Empty,
 			Fx: &secp256k1fx.Fx{},
 		}},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
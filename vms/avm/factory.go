@@ -17,12 +17,21 @@ var (
 type Factory struct {
 	CreationFee uint64
 	Fee         uint64
+
+	// If true, the transactions accepted by this chain are indexed by the
+	// addresses and assets they touched
+	IndexTransactions bool
+	// If true, the chain starts even if enabling or disabling the transaction
+	// index since the last run leaves the index missing transactions
+	IndexAllowIncomplete bool
 }
 
 // New ...
 func (f *Factory) New(*snow.Context) (interface{}, error) {
 	return &VM{
-		creationTxFee: f.CreationFee,
-		txFee:         f.Fee,
+		creationTxFee:        f.CreationFee,
+		txFee:                f.Fee,
+		indexTransactions:    f.IndexTransactions,
+		indexAllowIncomplete: f.IndexAllowIncomplete,
 	}, nil
 }
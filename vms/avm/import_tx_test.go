@@ -241,11 +241,13 @@ func TestIssueImportTx(t *testing.T) {
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{{
 			ID: ids.Empty,
 			Fx: &secp256k1fx.Fx{},
 		}},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -389,11 +391,13 @@ func TestForceAcceptImportTx(t *testing.T) {
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{{
 			ID: ids.Empty,
 			Fx: &secp256k1fx.Fx{},
 		}},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -31,6 +31,10 @@ const (
 
 	// Max number of addresses allowed for a single keystore user
 	maxKeystoreAddresses = 5000
+
+	// Max number of transactions that can be returned by a single call to
+	// GetAddressTxs
+	maxPageSize = 1024
 )
 
 var (
@@ -47,6 +51,7 @@ var (
 	errNilTxID                = errors.New("nil transaction ID")
 	errNoAddresses            = errors.New("no addresses provided")
 	errNoKeys                 = errors.New("from addresses have no keys or funds")
+	errIndexDisabled          = errors.New("address transaction index is disabled")
 )
 
 // Service defines the base service for the asset vm
@@ -259,6 +264,58 @@ func (service *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescr
 	return nil
 }
 
+// GetAddressTxsArgs are arguments for passing into GetAddressTxs requests
+type GetAddressTxsArgs struct {
+	Address string `json:"address"`
+	AssetID string `json:"assetID"`
+	// Cursor used as a page index / offset
+	Cursor json.Uint64 `json:"cursor"`
+	// PageSize num of items per page, capped at [maxPageSize]
+	PageSize json.Uint64 `json:"pageSize"`
+}
+
+// GetAddressTxsReply defines the GetAddressTxs replies returned from the API
+type GetAddressTxsReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor to pass in to fetch the next page
+	Cursor json.Uint64 `json:"cursor"`
+}
+
+// GetAddressTxs returns, in the order they were accepted, the transactions
+// that consumed or produced UTXOs of an asset owned by an address
+func (service *Service) GetAddressTxs(r *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
+	service.vm.ctx.Log.Info("AVM: GetAddressTxs called with address: %s assetID: %s cursor: %d pageSize: %d",
+		args.Address, args.AssetID, args.Cursor, args.PageSize)
+
+	if service.vm.addressTxs == nil {
+		return errIndexDisabled
+	}
+
+	pageSize := uint64(args.PageSize)
+	if pageSize == 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	addr, err := service.vm.ParseLocalAddress(args.Address)
+	if err != nil {
+		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
+	}
+
+	assetID, err := service.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	txIDs, cursor, err := service.vm.addressTxs.Read(addr, assetID, uint64(args.Cursor), pageSize)
+	if err != nil {
+		return fmt.Errorf("problem reading the transactions of '%s': %w", args.Address, err)
+	}
+
+	reply.TxIDs = txIDs
+	reply.Cursor = json.Uint64(cursor)
+	return nil
+}
+
 // GetBalanceArgs are arguments for passing into GetBalance requests
 type GetBalanceArgs struct {
 	Address        string `json:"address"`
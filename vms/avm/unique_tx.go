@@ -117,13 +117,26 @@ func (tx *UniqueTx) Accept() error {
 
 	defer tx.vm.db.Abort()
 
+	txID := tx.ID()
+
 	// Remove spent utxos
+	spentUTXOs := []*avax.UTXO(nil)
 	for _, utxo := range tx.InputUTXOs() {
 		if utxo.Symbolic() {
 			// If the UTXO is symbolic, it can't be spent
 			continue
 		}
 		utxoID := utxo.InputID()
+		if tx.vm.addressTxs != nil {
+			// The spent UTXO must be fetched before it is removed from state
+			// so that its owners can be indexed
+			spentUTXO, err := tx.vm.state.UTXO(utxoID)
+			if err != nil {
+				tx.vm.ctx.Log.Error("Failed to get utxo %s due to %s", utxoID, err)
+				return err
+			}
+			spentUTXOs = append(spentUTXOs, spentUTXO)
+		}
 		if err := tx.vm.state.SpendUTXO(utxoID); err != nil {
 			tx.vm.ctx.Log.Error("Failed to spend utxo %s due to %s", utxoID, err)
 			return err
@@ -131,19 +144,26 @@ func (tx *UniqueTx) Accept() error {
 	}
 
 	// Add new utxos
-	for _, utxo := range tx.UTXOs() {
+	utxos := tx.UTXOs()
+	for _, utxo := range utxos {
 		if err := tx.vm.state.FundUTXO(utxo); err != nil {
 			tx.vm.ctx.Log.Error("Failed to fund utxo %s due to %s", utxo.InputID(), err)
 			return err
 		}
 	}
 
+	if tx.vm.addressTxs != nil {
+		if err := tx.vm.addressTxs.Accept(txID, spentUTXOs, utxos); err != nil {
+			tx.vm.ctx.Log.Error("Failed to index tx %s due to %s", txID, err)
+			return err
+		}
+	}
+
 	if err := tx.setStatus(choices.Accepted); err != nil {
 		tx.vm.ctx.Log.Error("Failed to accept tx %s due to %s", tx.txID, err)
 		return err
 	}
 
-	txID := tx.ID()
 	commitBatch, err := tx.vm.db.CommitBatch()
 	if err != nil {
 		tx.vm.ctx.Log.Error("Failed to calculate CommitBatch for %s due to %s", txID, err)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gorilla/rpc/v2"
 
+	"github.com/corpetty/avalanchego/api/events"
 	"github.com/corpetty/avalanchego/cache"
 	"github.com/corpetty/avalanchego/codec"
 	"github.com/corpetty/avalanchego/codec/linearcodec"
@@ -61,7 +62,8 @@ var (
 	errBootstrapping             = errors.New("chain is currently bootstrapping")
 	errInsufficientFunds         = errors.New("insufficient funds")
 
-	_ vertex.DAGVM = &VM{}
+	_ vertex.DAGVM   = &VM{}
+	_ events.Decoder = &VM{}
 )
 
 // VM implements the avalanche.DAGVM interface
@@ -92,6 +94,16 @@ type VM struct {
 	// fee that must be burned by every non-state creating transaction
 	txFee uint64
 
+	// If true, accepted transactions are indexed by the addresses and assets
+	// they touched
+	indexTransactions bool
+	// If true, the chain starts even if enabling or disabling the index since
+	// the last run leaves it missing transactions
+	indexAllowIncomplete bool
+	// Indexes accepted transactions by address and asset. Nil if
+	// [indexTransactions] is false.
+	addressTxs *addressTxsIndex
+
 	// Asset ID --> Bit set with fx IDs the asset supports
 	assetToFxCache *cache.LRU
 
@@ -101,6 +113,9 @@ type VM struct {
 	txs          []snowstorm.Tx
 	toEngine     chan<- common.Message
 
+	// Sends application level messages to this chain on other nodes
+	appSender common.AppSender
+
 	baseDB database.Database
 	db     *versiondb.Database
 
@@ -121,11 +136,25 @@ func (vm *VM) Initialize(
 	ctx *snow.Context,
 	db database.Database,
 	genesisBytes []byte,
+	configBytes []byte,
 	toEngine chan<- common.Message,
 	fxs []*common.Fx,
+	appSender common.AppSender,
 ) error {
+	config, err := parseConfig(configBytes)
+	if err != nil {
+		return err
+	}
+	if config.IndexTransactions != nil {
+		vm.indexTransactions = *config.IndexTransactions
+	}
+	if config.IndexAllowIncomplete != nil {
+		vm.indexAllowIncomplete = *config.IndexAllowIncomplete
+	}
+
 	vm.ctx = ctx
 	vm.toEngine = toEngine
+	vm.appSender = appSender
 	vm.baseDB = db
 	vm.db = versiondb.New(db)
 	vm.typeToFxIndex = map[reflect.Type]int{}
@@ -208,12 +237,23 @@ func (vm *VM) Initialize(
 		return err
 	}
 
-	if dbStatus, err := vm.state.DBInitialized(); err != nil || dbStatus == choices.Unknown {
+	dbStatus, err := vm.state.DBInitialized()
+	newChain := err != nil || dbStatus == choices.Unknown
+	if newChain {
 		if err := vm.initState(genesisBytes); err != nil {
 			return err
 		}
 	}
 
+	// A chain created by this run has no transactions the index could be
+	// missing
+	if err := checkAddressTxsIndexHistory(vm.db, ctx.Log, vm.indexTransactions, vm.indexAllowIncomplete, newChain); err != nil {
+		return err
+	}
+	if vm.indexTransactions {
+		vm.addressTxs = newAddressTxsIndex(vm.db)
+	}
+
 	vm.timer = timer.NewTimer(func() {
 		ctx.Lock.Lock()
 		defer ctx.Lock.Unlock()
@@ -342,6 +382,70 @@ func (vm *VM) Get(txID ids.ID) (snowstorm.Tx, error) {
 	return tx, tx.verifyWithoutCacheWrites()
 }
 
+// DecodeEvent implements the events.Decoder interface. The addresses of the
+// consumed UTXOs are only reported if the UTXOs were produced by a transaction
+// on this chain.
+func (vm *VM) DecodeEvent(txBytes []byte) (*events.Decoded, error) {
+	tx, err := vm.parsePrivateTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &events.Decoded{
+		Tx:       tx,
+		UTXOs:    tx.UTXOs(),
+		AssetIDs: tx.AssetIDs(),
+	}
+	addUTXO := func(utxo *avax.UTXO) {
+		addressable, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			return
+		}
+		for _, addrBytes := range addressable.Addresses() {
+			if addr, err := ids.ToShortID(addrBytes); err == nil {
+				decoded.Addresses.Add(addr)
+			}
+		}
+	}
+
+	for _, utxo := range decoded.UTXOs {
+		addUTXO(utxo)
+	}
+	for _, utxoID := range tx.InputUTXOs() {
+		inputTxID, inputIndex := utxoID.InputSource()
+		inputTx, err := vm.state.Tx(inputTxID)
+		if err != nil {
+			// The UTXO was imported from another chain
+			continue
+		}
+		if utxos := inputTx.UTXOs(); int(inputIndex) < len(utxos) {
+			addUTXO(utxos[inputIndex])
+		}
+	}
+	return decoded, nil
+}
+
+// AppRequest implements the common.VM interface. The AVM doesn't send any
+// application level messages, so this is a no-op.
+func (vm *VM) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	return nil
+}
+
+// AppResponse implements the common.VM interface
+func (vm *VM) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	return nil
+}
+
+// AppRequestFailed implements the common.VM interface
+func (vm *VM) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	return nil
+}
+
+// AppGossip implements the common.VM interface
+func (vm *VM) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	return nil
+}
+
 /*
  ******************************************************************************
  ********************************** JSON API **********************************
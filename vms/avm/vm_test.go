@@ -270,6 +270,7 @@ func GenesisVMWithArgs(tb testing.TB, args *BuildGenesisArgs) ([]byte, chan comm
 		ctx,
 		prefixdb.New([]byte{1}, baseDB),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -281,6 +282,7 @@ func GenesisVMWithArgs(tb testing.TB, args *BuildGenesisArgs) ([]byte, chan comm
 				Fx: &nftfx.Fx{},
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		tb.Fatal(err)
@@ -508,14 +510,77 @@ func TestInvalidGenesis(t *testing.T) {
 		/*context=*/ ctx,
 		/*db=*/ memdb.New(),
 		/*genesisState=*/ nil,
+		/*configBytes=*/ nil,
 		/*engineMessenger=*/ make(chan common.Message, 1),
 		/*fxs=*/ nil,
+		nil,
 	)
 	if err == nil {
 		t.Fatalf("Should have errored due to an invalid genesis")
 	}
 }
 
+func TestInvalidConfig(t *testing.T) {
+	vm := &VM{}
+	ctx := NewContext(t)
+	ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		ctx.Lock.Unlock()
+	}()
+
+	genesisBytes := BuildGenesisTest(t)
+	err := vm.Initialize(
+		/*context=*/ ctx,
+		/*db=*/ memdb.New(),
+		/*genesisState=*/ genesisBytes,
+		/*configBytes=*/ []byte(`{"indexTransactions":`),
+		/*engineMessenger=*/ make(chan common.Message, 1),
+		/*fxs=*/ []*common.Fx{{
+			ID: ids.Empty,
+			Fx: &secp256k1fx.Fx{},
+		}},
+		nil,
+	)
+	if err == nil {
+		t.Fatalf("Should have errored due to an invalid config")
+	}
+}
+
+func TestConfigIndexTransactions(t *testing.T) {
+	vm := &VM{}
+	ctx := NewContext(t)
+	ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		ctx.Lock.Unlock()
+	}()
+
+	genesisBytes := BuildGenesisTest(t)
+	err := vm.Initialize(
+		/*context=*/ ctx,
+		/*db=*/ memdb.New(),
+		/*genesisState=*/ genesisBytes,
+		/*configBytes=*/ []byte(`{"indexTransactions": true}`),
+		/*engineMessenger=*/ make(chan common.Message, 1),
+		/*fxs=*/ []*common.Fx{{
+			ID: ids.Empty,
+			Fx: &secp256k1fx.Fx{},
+		}},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.addressTxs == nil {
+		t.Fatalf("Should have indexed transactions as set in the config")
+	}
+}
+
 func TestInvalidFx(t *testing.T) {
 	vm := &VM{}
 	ctx := NewContext(t)
@@ -532,10 +597,12 @@ func TestInvalidFx(t *testing.T) {
 		/*context=*/ ctx,
 		/*db=*/ memdb.New(),
 		/*genesisState=*/ genesisBytes,
+		/*configBytes=*/ nil,
 		/*engineMessenger=*/ make(chan common.Message, 1),
 		/*fxs=*/ []*common.Fx{
 			nil,
 		},
+		nil,
 	)
 	if err == nil {
 		t.Fatalf("Should have errored due to an invalid interface")
@@ -558,6 +625,7 @@ func TestFxInitializationFailure(t *testing.T) {
 		/*context=*/ ctx,
 		/*db=*/ memdb.New(),
 		/*genesisState=*/ genesisBytes,
+		/*configBytes=*/ nil,
 		/*engineMessenger=*/ make(chan common.Message, 1),
 		/*fxs=*/ []*common.Fx{{
 			ID: ids.Empty,
@@ -567,6 +635,7 @@ func TestFxInitializationFailure(t *testing.T) {
 				},
 			},
 		}},
+		nil,
 	)
 	if err == nil {
 		t.Fatalf("Should have errored due to an invalid fx initialization")
@@ -822,6 +891,7 @@ func TestIssueNFT(t *testing.T) {
 		ctx,
 		memdb.New(),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -833,6 +903,7 @@ func TestIssueNFT(t *testing.T) {
 				Fx: &nftfx.Fx{},
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -967,6 +1038,7 @@ func TestIssueProperty(t *testing.T) {
 		ctx,
 		memdb.New(),
 		genesisBytes,
+		nil,
 		issuer,
 		[]*common.Fx{
 			{
@@ -982,6 +1054,7 @@ func TestIssueProperty(t *testing.T) {
 				Fx: &propertyfx.Fx{},
 			},
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -1548,3 +1621,36 @@ func TestTxVerifyAfterVerifyAncestorTx(t *testing.T) {
 		t.Fatalf("Should have errored due to a missing UTXO")
 	}
 }
+
+func TestDecodeEvent(t *testing.T) {
+	genesisBytes, _, vm, _ := GenesisVM(t)
+	ctx := vm.ctx
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		ctx.Lock.Unlock()
+	}()
+
+	avaxTx := GetAVAXTxFromGenesisTest(genesisBytes, t)
+	newTx := NewTx(t, genesisBytes, vm)
+
+	decoded, err := vm.DecodeEvent(newTx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx, ok := decoded.Tx.(*Tx); !ok || tx.ID() != newTx.ID() {
+		t.Fatalf("decoded the wrong transaction")
+	}
+	if !decoded.AssetIDs.Contains(avaxTx.ID()) {
+		t.Fatalf("should have decoded the consumed asset")
+	}
+	// The consumed genesis UTXO is owned by [keys[0]]
+	if !decoded.Addresses.Contains(keys[0].PublicKey().Address()) {
+		t.Fatalf("should have decoded the address of the consumed UTXO")
+	}
+
+	if _, err := vm.DecodeEvent([]byte{1, 2, 3}); err == nil {
+		t.Fatalf("should have failed to decode invalid bytes")
+	}
+}
@@ -67,6 +67,9 @@ func (b *Block) Accept() error {
 	if err := b.VM.State.PutLastAccepted(b.VM.DB, blkID); err != nil {
 		return err
 	}
+	if err := b.VM.State.PutBlockIDAtHeight(b.VM.DB, b.Height(), blkID); err != nil {
+		return err
+	}
 
 	b.VM.LastAcceptedID = blkID // Change state of VM
 	return nil
@@ -4,6 +4,7 @@
 package core
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 
@@ -18,6 +19,9 @@ var errWrongType = errors.New("got unexpected type from database")
 // state.Get(Db, IDTypeID, lastAcceptedID) == ID of last accepted block
 var lastAcceptedID = ids.ID{'l', 'a', 's', 't'}
 
+// state.Get(Db, IDTypeID, heightKey(height)) == ID of accepted block at height
+var heightPrefix = ids.ID{'h', 'e', 'i', 'g', 'h', 't'}
+
 // SnowmanState is a wrapper around state.State
 // In additions to the methods exposed by state.State,
 // SnowmanState exposes a few methods needed for managing
@@ -28,6 +32,8 @@ type SnowmanState interface {
 	PutBlock(database.Database, snowman.Block) error
 	GetLastAccepted(database.Database) (ids.ID, error)
 	PutLastAccepted(database.Database, ids.ID) error
+	GetBlockIDAtHeight(database.Database, uint64) (ids.ID, error)
+	PutBlockIDAtHeight(database.Database, uint64, ids.ID) error
 }
 
 // implements SnowmanState
@@ -67,6 +73,25 @@ func (s *snowmanState) PutLastAccepted(db database.Database, lastAccepted ids.ID
 	return s.PutID(db, lastAcceptedID, lastAccepted)
 }
 
+// GetBlockIDAtHeight returns the ID of the accepted block at [height] in [db]
+func (s *snowmanState) GetBlockIDAtHeight(db database.Database, height uint64) (ids.ID, error) {
+	return s.GetID(db, heightKey(height))
+}
+
+// PutBlockIDAtHeight sets the ID of the accepted block at [height] in [db] to
+// [blkID]
+func (s *snowmanState) PutBlockIDAtHeight(db database.Database, height uint64, blkID ids.ID) error {
+	return s.PutID(db, heightKey(height), blkID)
+}
+
+// heightKey returns the key the ID of the accepted block at [height] is stored
+// under
+func heightKey(height uint64) ids.ID {
+	key := heightPrefix
+	binary.BigEndian.PutUint64(key[len(key)-8:], height)
+	return key
+}
+
 // NewSnowmanState returns a new SnowmanState
 func NewSnowmanState(unmarshalBlockFunc func([]byte) (snowman.Block, error)) (SnowmanState, error) {
 	rawState, err := state.NewState()
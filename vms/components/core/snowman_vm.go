@@ -5,6 +5,7 @@ package core
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -27,6 +28,10 @@ var (
 // the db has not yet been initialized
 var dbInitializedID = ids.ID{'d', 'b', ' ', 'i', 'n', 'i', 't'}
 
+// If the status of this ID is not choices.Accepted,
+// the accepted blocks have not yet been indexed by height
+var heightIndexedID = ids.ID{'h', 'e', 'i', 'g', 'h', 't', ' ', 'i', 'n', 'd', 'e', 'x'}
+
 // SnowmanVM provides the core functionality shared by most snowman vms
 type SnowmanVM struct {
 	State SnowmanState
@@ -79,12 +84,37 @@ func (svm *SnowmanVM) GetBlock(id ids.ID) (snowman.Block, error) {
 	return nil, errBadData // Should never happen
 }
 
+// GetBlockIDAtHeight returns the ID of the accepted block at [height]
+func (svm *SnowmanVM) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
+	return svm.State.GetBlockIDAtHeight(svm.DB, height)
+}
+
 // Bootstrapping marks this VM as bootstrapping
 func (svm *SnowmanVM) Bootstrapping() error { return nil }
 
 // Bootstrapped marks this VM as bootstrapped
 func (svm *SnowmanVM) Bootstrapped() error { return nil }
 
+// AppRequest drops application level requests by default
+func (svm *SnowmanVM) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	return nil
+}
+
+// AppResponse drops application level responses by default
+func (svm *SnowmanVM) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	return nil
+}
+
+// AppRequestFailed drops application level request failures by default
+func (svm *SnowmanVM) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	return nil
+}
+
+// AppGossip drops application level gossip by default
+func (svm *SnowmanVM) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	return nil
+}
+
 // Shutdown this vm
 func (svm *SnowmanVM) Shutdown() error {
 	if svm.DB == nil {
@@ -114,6 +144,40 @@ func (svm *SnowmanVM) SetDBInitialized() error {
 	return svm.State.PutStatus(svm.DB, dbInitializedID, choices.Accepted)
 }
 
+// InitializeHeightIndex indexes the accepted blocks by height if they haven't
+// been indexed yet. This is needed for databases that were created before
+// accepted blocks were indexed by height. Blocks are walked from the last
+// accepted block back to the genesis block, so this must only be called once
+// the VM is able to parse its blocks.
+func (svm *SnowmanVM) InitializeHeightIndex() error {
+	if svm.State.GetStatus(svm.DB, heightIndexedID) == choices.Accepted {
+		return nil
+	}
+
+	svm.Ctx.Log.Info("indexing accepted blocks by height")
+
+	blkID := svm.LastAcceptedID
+	for {
+		blk, err := svm.GetBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("couldn't get accepted block %s: %w", blkID, err)
+		}
+		height := blk.Height()
+		if err := svm.State.PutBlockIDAtHeight(svm.DB, height, blkID); err != nil {
+			return err
+		}
+		if height == 0 {
+			break
+		}
+		blkID = blk.Parent().ID()
+	}
+
+	if err := svm.State.PutStatus(svm.DB, heightIndexedID, choices.Accepted); err != nil {
+		return err
+	}
+	return svm.DB.Commit()
+}
+
 // SaveBlock saves [block] to state
 func (svm *SnowmanVM) SaveBlock(db database.Database, block snowman.Block) error {
 	return svm.State.Put(db, state.BlockTypeID, block.ID(), block)
@@ -0,0 +1,76 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowman"
+)
+
+type testBlock struct{ *Block }
+
+func (b *testBlock) Verify() error { return nil }
+
+func TestInitializeHeightIndex(t *testing.T) {
+	blocks := map[string]*Block{}
+	svm := &SnowmanVM{}
+	unmarshal := func(bytes []byte) (snowman.Block, error) {
+		blk := blocks[string(bytes)]
+		b := NewBlock(blk.PrntID, blk.Hght)
+		b.Initialize(bytes, svm)
+		return &testBlock{Block: b}, nil
+	}
+	if err := svm.Initialize(snow.DefaultContextTest(), memdb.New(), unmarshal, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Write a chain of accepted blocks without indexing them by height, as a
+	// database created before the index existed would have
+	blkIDs := []ids.ID{}
+	parentID := ids.Empty
+	for height := uint64(0); height < 3; height++ {
+		blk := NewBlock(parentID, height)
+		blk.Initialize([]byte{byte(height)}, svm)
+		blocks[string(blk.Bytes())] = blk
+
+		if err := svm.SaveBlock(svm.DB, &testBlock{Block: blk}); err != nil {
+			t.Fatal(err)
+		}
+		if err := svm.State.PutStatus(svm.DB, blk.ID(), choices.Accepted); err != nil {
+			t.Fatal(err)
+		}
+		blkIDs = append(blkIDs, blk.ID())
+		parentID = blk.ID()
+	}
+	svm.LastAcceptedID = parentID
+
+	if _, err := svm.GetBlockIDAtHeight(1); err == nil {
+		t.Fatal("shouldn't have indexed the block at height 1")
+	}
+
+	if err := svm.InitializeHeightIndex(); err != nil {
+		t.Fatal(err)
+	}
+
+	for height, expectedID := range blkIDs {
+		blkID, err := svm.GetBlockIDAtHeight(uint64(height))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if blkID != expectedID {
+			t.Fatalf("expected block %s at height %d but got %s", expectedID, height, blkID)
+		}
+	}
+
+	// Once the index has been initialized, it shouldn't be walked again
+	svm.LastAcceptedID = ids.GenerateTestID()
+	if err := svm.InitializeHeightIndex(); err != nil {
+		t.Fatal(err)
+	}
+}
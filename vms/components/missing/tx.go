@@ -0,0 +1,43 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package missing
+
+import (
+	"errors"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowstorm"
+)
+
+var (
+	errMissingTx = errors.New("missing tx")
+)
+
+// Tx represents a transaction that can't be found
+type Tx struct{ TxID ids.ID }
+
+// ID ...
+func (mt *Tx) ID() ids.ID { return mt.TxID }
+
+// Accept ...
+func (*Tx) Accept() error { return errMissingTx }
+
+// Reject ...
+func (*Tx) Reject() error { return errMissingTx }
+
+// Status ...
+func (*Tx) Status() choices.Status { return choices.Unknown }
+
+// Dependencies ...
+func (*Tx) Dependencies() []snowstorm.Tx { return nil }
+
+// InputIDs ...
+func (*Tx) InputIDs() []ids.ID { return nil }
+
+// Verify ...
+func (*Tx) Verify() error { return errMissingTx }
+
+// Bytes ...
+func (*Tx) Bytes() []byte { return nil }
@@ -0,0 +1,35 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package missing
+
+import (
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+
+	"github.com/corpetty/avalanchego/snow/choices"
+)
+
+func TestMissingTx(t *testing.T) {
+	id := ids.ID{255}
+	mt := Tx{TxID: id}
+
+	if txID := mt.ID(); txID != id {
+		t.Fatalf("missingTx.ID returned %s, expected %s", txID, id)
+	} else if status := mt.Status(); status != choices.Unknown {
+		t.Fatalf("missingTx.Status returned %s, expected %s", status, choices.Unknown)
+	} else if deps := mt.Dependencies(); deps != nil {
+		t.Fatalf("missingTx.Dependencies returned %v, expected %v", deps, nil)
+	} else if inputs := mt.InputIDs(); inputs != nil {
+		t.Fatalf("missingTx.InputIDs returned %v, expected %v", inputs, nil)
+	} else if err := mt.Verify(); err == nil {
+		t.Fatalf("missingTx.Verify returned nil, expected an error")
+	} else if bytes := mt.Bytes(); bytes != nil {
+		t.Fatalf("missingTx.Bytes returned %v, expected %v", bytes, nil)
+	} else if err := mt.Accept(); err == nil {
+		t.Fatalf("missingTx.Accept should have returned an error")
+	} else if err := mt.Reject(); err == nil {
+		t.Fatalf("missingTx.Reject should have returned an error")
+	}
+}
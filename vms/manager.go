@@ -53,6 +53,10 @@ type manager struct {
 	// alias of the VM. That is, [VM].String() is an alias for the VM, too.
 	ids.Aliaser
 
+	// Guards [vmFactories], since VMs can be registered while the node is
+	// running
+	lock sync.RWMutex
+
 	// Key: The key underlying a VM's ID
 	// Value: A factory that creates new instances of that VM
 	vmFactories map[ids.ID]VMFactory
@@ -78,6 +82,9 @@ func NewManager(apiServer *api.Server, log logging.Logger) Manager {
 // Return a factory that can create new instances of the vm whose
 // ID is [vmID]
 func (m *manager) GetVMFactory(vmID ids.ID) (VMFactory, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	if factory, ok := m.vmFactories[vmID]; ok {
 		return factory, nil
 	}
@@ -88,6 +95,19 @@ func (m *manager) GetVMFactory(vmID ids.ID) (VMFactory, error) {
 // Map [vmID] to [factory]. [factory] creates new instances of the vm whose
 // ID is [vmID]
 func (m *manager) RegisterVMFactory(vmID ids.ID, factory VMFactory) error {
+	if err := m.registerVMFactory(vmID, factory); err != nil {
+		return err
+	}
+
+	// add the static API endpoints
+	m.addStaticAPIEndpoints(vmID, factory)
+	return nil
+}
+
+func (m *manager) registerVMFactory(vmID ids.ID, factory VMFactory) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	if _, exists := m.vmFactories[vmID]; exists {
 		return fmt.Errorf("a vm with ID '%v' has already been registered", vmID)
 	}
@@ -96,18 +116,13 @@ func (m *manager) RegisterVMFactory(vmID ids.ID, factory VMFactory) error {
 	}
 
 	m.vmFactories[vmID] = factory
-
-	// add the static API endpoints
-	m.addStaticAPIEndpoints(vmID)
 	return nil
 }
 
 // VMs can expose a static API (one that does not depend on the state of a particular chain.)
 // This method adds to the node's API server the static API of the VM with ID [vmID].
 // This allows clients to call the VM's static API methods.
-func (m *manager) addStaticAPIEndpoints(vmID ids.ID) {
-	vmFactory, err := m.GetVMFactory(vmID)
-	m.log.AssertNoError(err)
+func (m *manager) addStaticAPIEndpoints(vmID ids.ID, vmFactory VMFactory) {
 	m.log.Debug("adding static API for VM with ID %s", vmID)
 	vm, err := vmFactory.New(nil)
 	if err != nil {
@@ -171,12 +171,11 @@ func (tx *UnsignedAddSubnetValidatorTx) SemanticVerify(
 		baseTxCreds := stx.Creds[:baseTxCredsLen]
 		subnetCred := stx.Creds[baseTxCredsLen]
 
-		subnet, timedErr := vm.getSubnet(db, tx.Validator.Subnet)
+		owner, timedErr := vm.getSubnetOwner(db, tx.Validator.Subnet)
 		if timedErr != nil {
 			return nil, nil, nil, nil, timedErr
 		}
-		unsignedSubnet := subnet.UnsignedTx.(*UnsignedCreateSubnetTx)
-		if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, unsignedSubnet.Owner); err != nil {
+		if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, owner); err != nil {
 			return nil, nil, nil, nil, permError{err}
 		}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/corpetty/avalanchego/api"
 	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
 	"github.com/corpetty/avalanchego/utils/formatting"
 	cjson "github.com/corpetty/avalanchego/utils/json"
 	"github.com/corpetty/avalanchego/utils/rpc"
@@ -32,6 +33,21 @@ func (c *Client) GetHeight() (uint64, error) {
 	return uint64(res.Height), err
 }
 
+// GetBlockByHeight returns the ID and byte representation of the accepted
+// block at [height]
+func (c *Client) GetBlockByHeight(height uint64) (ids.ID, []byte, error) {
+	res := &GetBlockByHeightResponse{}
+	err := c.requester.SendRequest("getBlockByHeight", &GetBlockByHeightArgs{
+		Height:   cjson.Uint64(height),
+		Encoding: formatting.Hex,
+	}, res)
+	if err != nil {
+		return ids.ID{}, nil, err
+	}
+	blkBytes, err := formatting.Decode(res.Encoding, res.Block)
+	return res.BlockID, blkBytes, err
+}
+
 // ExportKey returns the private key corresponding to [address] from [user]'s account
 func (c *Client) ExportKey(user api.UserPass, address string) (string, error) {
 	res := &ExportKeyReply{}
@@ -137,6 +153,14 @@ func (c *Client) GetCurrentValidators(subnetID ids.ID) ([]interface{}, error) {
 	return res.Validators, err
 }
 
+// GetUptime returns the uptime of the node as observed by itself and by a
+// sample of the validators it is connected to
+func (c *Client) GetUptime() (*GetUptimeReply, error) {
+	res := &GetUptimeReply{}
+	err := c.requester.SendRequest("getUptime", struct{}{}, res)
+	return res, err
+}
+
 // GetPendingValidators returns the list of pending validators for subnet with ID [subnetID]
 func (c *Client) GetPendingValidators(subnetID ids.ID) ([]interface{}, []interface{}, error) {
 	res := &GetPendingValidatorsReply{}
@@ -163,6 +187,29 @@ func (c *Client) SampleValidators(subnetID ids.ID, sampleSize uint16) ([]string,
 	return res.Validators, err
 }
 
+// GetValidatorsAt returns the weights of the validators of [subnetID] as of the
+// accepted block at [height]
+func (c *Client) GetValidatorsAt(subnetID ids.ID, height uint64) (map[ids.ShortID]uint64, error) {
+	res := &GetValidatorsAtReply{}
+	err := c.requester.SendRequest("getValidatorsAt", &GetValidatorsAtArgs{
+		Height:   cjson.Uint64(height),
+		SubnetID: subnetID,
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+
+	vdrs := make(map[ids.ShortID]uint64, len(res.Validators))
+	for nodeIDStr, weight := range res.Validators {
+		nodeID, err := ids.ShortFromPrefixedString(nodeIDStr, constants.NodeIDPrefix)
+		if err != nil {
+			return nil, err
+		}
+		vdrs[nodeID] = uint64(weight)
+	}
+	return vdrs, nil
+}
+
 // AddValidator issues a transaction to add a validator to the primary network and returns the txID
 func (c *Client) AddValidator(
 	user api.UserPass,
@@ -252,6 +299,52 @@ func (c *Client) AddSubnetValidator(
 	return res.TxID, err
 }
 
+// RemoveSubnetValidator issues a transaction to remove [nodeID] from the
+// validators of [subnetID] and returns the txID
+func (c *Client) RemoveSubnetValidator(
+	user api.UserPass,
+	from []string,
+	changeAddr string,
+	subnetID,
+	nodeID string,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest("removeSubnetValidator", &RemoveSubnetValidatorArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: from},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr},
+		},
+		NodeID:   nodeID,
+		SubnetID: subnetID,
+	}, res)
+	return res.TxID, err
+}
+
+// TransferSubnetOwnership issues a transaction to make [controlKeys] the
+// owners of [subnetID] and returns the txID
+func (c *Client) TransferSubnetOwnership(
+	user api.UserPass,
+	from []string,
+	changeAddr string,
+	subnetID string,
+	controlKeys []string,
+	threshold uint32,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest("transferSubnetOwnership", &TransferSubnetOwnershipArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: from},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr},
+		},
+		SubnetID:    subnetID,
+		ControlKeys: controlKeys,
+		Threshold:   cjson.Uint32(threshold),
+	}, res)
+	return res.TxID, err
+}
+
 // CreateSubnet issues a transaction to create [subnet] and returns the txID
 func (c *Client) CreateSubnet(
 	user api.UserPass,
@@ -412,6 +505,28 @@ func (c *Client) GetTx(txID ids.ID) ([]byte, error) {
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+// GetRewardUTXOs returns the UTXOs rewarded after the staking period started by
+// [txID] ended
+func (c *Client) GetRewardUTXOs(txID ids.ID) ([][]byte, error) {
+	res := &GetRewardUTXOsReply{}
+	err := c.requester.SendRequest("getRewardUTXOs", &api.GetTxArgs{
+		TxID:     txID,
+		Encoding: formatting.Hex,
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxoStr := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxoStr)
+		if err != nil {
+			return nil, err
+		}
+		utxos[i] = utxoBytes
+	}
+	return utxos, nil
+}
+
 // GetTxStatus returns the status of the transaction corresponding to [txID]
 func (c *Client) GetTxStatus(txID ids.ID, includeReason bool) (*GetTxStatusResponse, error) {
 	res := new(GetTxStatusResponse)
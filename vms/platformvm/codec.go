@@ -64,6 +64,9 @@ func init() {
 
 			c.RegisterType(&StakeableLockIn{}),
 			c.RegisterType(&StakeableLockOut{}),
+
+			c.RegisterType(&UnsignedRemoveSubnetValidatorTx{}),
+			c.RegisterType(&UnsignedTransferSubnetOwnershipTx{}),
 		)
 	}
 	errs.Add(
@@ -219,6 +219,11 @@ func (sdb *SingleDecisionBlock) Accept() error {
 		return fmt.Errorf("failed to accept CommonBlock: %w", err)
 	}
 
+	// Record the validator weight changes made by this block at its height
+	if err := sdb.vm.commitWeightDiffs(sdb.onAcceptDB, sdb.Height()); err != nil {
+		return fmt.Errorf("failed to commit validator weight diffs: %w", err)
+	}
+
 	// Update the state of the chain in the database
 	if err := sdb.onAcceptDB.Commit(); err != nil {
 		return fmt.Errorf("failed to commit onAcceptDB: %w", err)
@@ -263,6 +268,11 @@ func (ddb *DoubleDecisionBlock) Accept() error {
 		return fmt.Errorf("failed to accept CommonBlock: %w", err)
 	}
 
+	// Record the validator weight changes made by this block at its height
+	if err := ddb.vm.commitWeightDiffs(ddb.onAcceptDB, ddb.Height()); err != nil {
+		return fmt.Errorf("failed to commit validator weight diffs: %w", err)
+	}
+
 	// Update the state of the chain in the database
 	if err := ddb.onAcceptDB.Commit(); err != nil {
 		return fmt.Errorf("failed to commit onAcceptDB: %w", err)
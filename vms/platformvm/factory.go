@@ -33,6 +33,7 @@ type Factory struct {
 	MaxStakeDuration   time.Duration // Max time allowed for validating
 	StakeMintingPeriod time.Duration // Staking consumption period
 	ApricotPhase0Time  time.Time     // Time of the Phase 0 upgrade
+	ApricotPhase1Time  time.Time     // Time of the Phase 1 upgrade
 }
 
 // New returns a new instance of the Platform Chain
@@ -52,5 +53,6 @@ func (f *Factory) New(*snow.Context) (interface{}, error) {
 		maxStakeDuration:   f.MaxStakeDuration,
 		stakeMintingPeriod: f.StakeMintingPeriod,
 		apricotPhase0Time:  f.ApricotPhase0Time,
+		apricotPhase1Time:  f.ApricotPhase1Time,
 	}, nil
 }
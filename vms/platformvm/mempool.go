@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/versiondb"
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow/consensus/snowman"
 	"github.com/corpetty/avalanchego/utils/constants"
@@ -29,12 +30,25 @@ const (
 	// Time difference between local time and current chain time
 	// at which to attempt to increase the chain timestamp
 	catchUpTime = 2 * time.Hour
+
+	// Number of connected validators that a newly issued tx is gossiped to
+	txGossipSize = 10
+
+	// Maximum size, in bytes, of a tx that will be accepted from a peer's
+	// gossip
+	maxGossipTxSize = 64 * 1024
+
+	// Maximum number of unissued txs for gossiped txs to be added to the
+	// mempool
+	maxGossipMempoolSize = 4096
 )
 
 var (
 	errEndOfTime       = errors.New("program time is suspiciously far in the future. Either this codebase was way more successful than expected, or a critical error has occurred")
 	errNoPendingBlocks = errors.New("no pending blocks")
 	errUnknownTxType   = errors.New("unknown transaction type")
+	errGossipTxTooLong = errors.New("gossiped transaction is too long")
+	errMempoolFull     = errors.New("mempool is full")
 )
 
 // Mempool implements a simple mempool to convert txs into valid blocks
@@ -99,16 +113,134 @@ func (m *Mempool) Initialize(vm *VM) {
 	go m.vm.Ctx.Log.RecoverAndPanic(m.timer.Dispatch)
 }
 
-// IssueTx enqueues the [tx] to be put into a block
+// IssueTx enqueues the [tx] to be put into a block and gossips it to a sample
+// of the connected validators, so that it reaches a validator that will put it
+// into a block even if this node doesn't build blocks.
 func (m *Mempool) IssueTx(tx *Tx) error {
 	// Initialize the transaction
 	if err := tx.Sign(m.vm.codec, nil); err != nil {
 		return err
 	}
+	if m.unissuedTxIDs.Contains(tx.ID()) {
+		return nil
+	}
+	if err := m.issueTx(tx); err != nil {
+		return err
+	}
+	m.gossipTx(tx)
+	return nil
+}
+
+// AppGossip adds the tx gossiped by [nodeID] to the mempool if it is valid.
+// Invalid txs are dropped rather than reported as an error, since the peer
+// that gossiped the tx may be malicious.
+func (m *Mempool) AppGossip(nodeID ids.ShortID, msg []byte) {
+	if len(msg) > maxGossipTxSize {
+		m.vm.Ctx.Log.Debug("dropping tx gossiped by %s due to %s", nodeID, errGossipTxTooLong)
+		return
+	}
+	if m.unissuedTxIDs.Len() >= maxGossipMempoolSize {
+		m.vm.Ctx.Log.Debug("dropping tx gossiped by %s due to %s", nodeID, errMempoolFull)
+		return
+	}
+
+	tx := &Tx{}
+	if _, err := m.vm.codec.Unmarshal(msg, tx); err != nil {
+		m.vm.Ctx.Log.Debug("dropping tx gossiped by %s that couldn't be parsed due to %s", nodeID, err)
+		return
+	}
+	if err := tx.Sign(m.vm.codec, nil); err != nil {
+		m.vm.Ctx.Log.Debug("dropping tx gossiped by %s that couldn't be initialized due to %s", nodeID, err)
+		return
+	}
+
 	txID := tx.ID()
 	if m.unissuedTxIDs.Contains(txID) {
-		return nil
+		return
+	}
+	if _, dropped := m.vm.droppedTxCache.Get(txID); dropped {
+		return
 	}
+	if _, err := m.vm.getStatus(m.vm.DB, txID); err == nil {
+		// the tx was already put into a block
+		return
+	}
+
+	if err := m.verifyTx(tx); err != nil {
+		m.vm.droppedTxCache.Put(txID, err.Error())
+		m.vm.Ctx.Log.Debug("dropping tx %s gossiped by %s due to %s", txID, nodeID, err)
+		return
+	}
+	if err := m.issueTx(tx); err != nil {
+		m.vm.Ctx.Log.Debug("dropping tx %s gossiped by %s due to %s", txID, nodeID, err)
+	}
+}
+
+// verifyTx returns nil if [tx] is valid as of the preferred block
+func (m *Mempool) verifyTx(tx *Tx) error {
+	preferred, err := m.vm.getBlock(m.vm.Preferred())
+	if err != nil {
+		return fmt.Errorf("couldn't get preferred block: %w", err)
+	}
+	// The preferred block should always be a decision block
+	preferredDecision, ok := preferred.(decision)
+	if !ok {
+		return errInvalidBlockType
+	}
+	// Verification may write to the database, so don't write to the preferred
+	// block's state
+	db := versiondb.New(preferredDecision.onAccept())
+	defer db.Abort()
+
+	switch utx := tx.UnsignedTx.(type) {
+	case TimedTx:
+		proposalTx, ok := utx.(UnsignedProposalTx)
+		if !ok {
+			return errUnknownTxType
+		}
+		if _, _, _, _, err := proposalTx.SemanticVerify(m.vm, db, tx); err != nil {
+			return err
+		}
+	case UnsignedDecisionTx:
+		if _, err := utx.SemanticVerify(m.vm, db, tx); err != nil {
+			return err
+		}
+	case UnsignedAtomicTx:
+		if err := utx.SemanticVerify(m.vm, db, tx); err != nil {
+			return err
+		}
+	default:
+		return errUnknownTxType
+	}
+	return nil
+}
+
+// gossipTx sends [tx] to a sample of the connected validators. Gossip is best
+// effort: the network doesn't send the tx to sampled validators that are
+// running a version too old to parse application level messages.
+func (m *Mempool) gossipTx(tx *Tx) {
+	if m.vm.appSender == nil {
+		return
+	}
+
+	nodeIDs, err := m.vm.sampleConnectedValidators(txGossipSize)
+	if err != nil {
+		m.vm.Ctx.Log.Error("failed to sample validators to gossip tx %s to due to %s", tx.ID(), err)
+		return
+	}
+	if nodeIDs.Len() == 0 {
+		m.vm.Ctx.Log.Debug("not gossiping tx %s because no validators are connected", tx.ID())
+		return
+	}
+
+	if err := m.vm.appSender.SendAppGossipSpecific(nodeIDs, tx.Bytes()); err != nil {
+		m.vm.Ctx.Log.Error("failed to gossip tx %s due to %s", tx.ID(), err)
+	}
+}
+
+// issueTx enqueues the initialized [tx] to be put into a block
+func (m *Mempool) issueTx(tx *Tx) error {
+	txID := tx.ID()
 	switch tx.UnsignedTx.(type) {
 	case TimedTx:
 		m.unissuedProposalTxs.Add(tx)
@@ -0,0 +1,166 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/utils/units"
+)
+
+func TestMempoolGossipsIssuedTx(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	validatorID := keys[0].PublicKey().Address()
+	nonValidatorID := ids.GenerateTestShortID()
+	vm.Connected(validatorID)
+	vm.Connected(nonValidatorID)
+
+	var (
+		gossipedTo    ids.ShortSet
+		gossipedBytes []byte
+	)
+	vm.appSender = &common.SenderTest{
+		T: t,
+		SendAppGossipSpecificF: func(nodeIDs ids.ShortSet, msg []byte) error {
+			gossipedTo = nodeIDs
+			gossipedBytes = msg
+			return nil
+		},
+	}
+
+	tx, err := vm.newExportTx(
+		units.MilliAvax,                         // amount
+		vm.Ctx.XChainID,                         // destination chain
+		keys[1].PublicKey().Address(),           // recipient
+		[]*crypto.PrivateKeySECP256K1R{keys[0]}, // payer
+		keys[0].PublicKey().Address(),           // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.mempool.IssueTx(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	switch {
+	case gossipedTo.Len() != 1 || !gossipedTo.Contains(validatorID):
+		t.Fatalf("tx should have only been gossiped to the connected validator but was gossiped to %s", gossipedTo)
+	case !bytes.Equal(gossipedBytes, tx.Bytes()):
+		t.Fatal("gossiped the wrong tx")
+	}
+}
+
+func TestMempoolAppGossip(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	nodeID := ids.GenerateTestShortID()
+
+	tx, err := vm.newExportTx(
+		units.MilliAvax,                         // amount
+		vm.Ctx.XChainID,                         // destination chain
+		keys[1].PublicKey().Address(),           // recipient
+		[]*crypto.PrivateKeySECP256K1R{keys[0]}, // payer
+		keys[0].PublicKey().Address(),           // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Txs that can't be parsed or are too long are dropped
+	if err := vm.AppGossip(nodeID, []byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.AppGossip(nodeID, make([]byte, maxGossipTxSize+1)); err != nil {
+		t.Fatal(err)
+	}
+	if vm.mempool.unissuedTxIDs.Len() != 0 {
+		t.Fatal("invalid gossip shouldn't have been added to the mempool")
+	}
+
+	// Valid txs are added to the mempool once
+	if err := vm.AppGossip(nodeID, tx.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.AppGossip(nodeID, tx.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !vm.mempool.unissuedTxIDs.Contains(tx.ID()) || len(vm.mempool.unissuedAtomicTxs) != 1 {
+		t.Fatal("gossiped tx should have been added to the mempool once")
+	}
+
+	// Txs that conflict with the preferred state are dropped
+	blk, err := vm.BuildBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Accept(); err != nil {
+		t.Fatal(err)
+	}
+	vm.SetPreference(vm.LastAccepted())
+
+	conflictingTx, err := vm.newExportTx(
+		2*units.MilliAvax,                       // amount
+		vm.Ctx.XChainID,                         // destination chain
+		keys[1].PublicKey().Address(),           // recipient
+		[]*crypto.PrivateKeySECP256K1R{keys[0]}, // payer
+		keys[0].PublicKey().Address(),           // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Spend the UTXOs consumed by [conflictingTx]
+	spendingTx, err := vm.newExportTx(
+		3*units.MilliAvax,                       // amount
+		vm.Ctx.XChainID,                         // destination chain
+		keys[1].PublicKey().Address(),           // recipient
+		[]*crypto.PrivateKeySECP256K1R{keys[0]}, // payer
+		keys[0].PublicKey().Address(),           // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.mempool.IssueTx(spendingTx); err != nil {
+		t.Fatal(err)
+	}
+	if blk, err = vm.BuildBlock(); err != nil {
+		t.Fatal(err)
+	} else if err := blk.Verify(); err != nil {
+		t.Fatal(err)
+	} else if err := blk.Accept(); err != nil {
+		t.Fatal(err)
+	}
+	vm.SetPreference(vm.LastAccepted())
+
+	if err := vm.AppGossip(nodeID, conflictingTx.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if vm.mempool.unissuedTxIDs.Contains(conflictingTx.ID()) {
+		t.Fatal("conflicting tx shouldn't have been added to the mempool")
+	}
+	if _, dropped := vm.droppedTxCache.Get(conflictingTx.ID()); !dropped {
+		t.Fatal("conflicting tx should have been marked as dropped")
+	}
+}
@@ -0,0 +1,196 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/corpetty/avalanchego/codec"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+	"github.com/corpetty/avalanchego/vms/components/verify"
+)
+
+var (
+	errRemovePrimaryNetworkValidator = errors.New("can't remove validators of the primary network")
+	errNotSubnetValidator            = errors.New("node isn't a current or pending validator of the subnet")
+	errRemoveSubnetValidatorInactive = errors.New("removing subnet validators isn't allowed until apricot phase 1")
+
+	_ UnsignedDecisionTx = &UnsignedRemoveSubnetValidatorTx{}
+)
+
+// UnsignedRemoveSubnetValidatorTx is an unsigned removeSubnetValidatorTx
+type UnsignedRemoveSubnetValidatorTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the node being removed from the subnet
+	NodeID ids.ShortID `serialize:"true" json:"nodeID"`
+	// ID of the subnet the node is being removed from
+	Subnet ids.ID `serialize:"true" json:"subnet"`
+	// Auth that will be allowing this validator to be removed
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+}
+
+// Verify this transaction is well-formed
+func (tx *UnsignedRemoveSubnetValidatorTx) Verify(
+	ctx *snow.Context,
+	c codec.Manager,
+	feeAmount uint64,
+	feeAssetID ids.ID,
+) error {
+	switch {
+	case tx == nil:
+		return errNilTx
+	case tx.syntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return errRemovePrimaryNetworkValidator
+	}
+
+	if err := tx.BaseTx.Verify(ctx, c); err != nil {
+		return err
+	}
+	if err := tx.SubnetAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.syntacticallyVerified = true
+	return nil
+}
+
+// SemanticVerify returns nil if [tx] is valid given the state in [db]
+func (tx *UnsignedRemoveSubnetValidatorTx) SemanticVerify(
+	vm *VM,
+	db database.Database,
+	stx *Tx,
+) (
+	func() error,
+	TxError,
+) {
+	// Verify the tx is well-formed
+	if len(stx.Creds) == 0 {
+		return nil, permError{errWrongNumberOfCredentials}
+	}
+	if err := tx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		return nil, permError{err}
+	}
+
+	// Rule change for Apricot phase 1 hardfork
+	chainTime, err := vm.getTimestamp(db)
+	if err != nil {
+		return nil, tempError{fmt.Errorf("couldn't get chain timestamp: %w", err)}
+	}
+	if chainTime.Before(vm.apricotPhase1Time) {
+		return nil, permError{errRemoveSubnetValidatorInactive}
+	}
+
+	baseTxCredsLen := len(stx.Creds) - 1
+	baseTxCreds := stx.Creds[:baseTxCredsLen]
+	subnetCred := stx.Creds[baseTxCredsLen]
+
+	owner, timedErr := vm.getSubnetOwner(db, tx.Subnet)
+	if timedErr != nil {
+		return nil, timedErr
+	}
+	if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, owner); err != nil {
+		return nil, permError{err}
+	}
+
+	// Verify the flowcheck
+	if err := vm.semanticVerifySpend(db, tx, tx.Ins, tx.Outs, baseTxCreds, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		return nil, err
+	}
+
+	// Remove the validator from the current validator set if it's validating,
+	// otherwise from the pending validator set
+	vdr, isValidator, err := vm.isValidator(db, tx.Subnet, tx.NodeID)
+	if err != nil {
+		return nil, tempError{err}
+	}
+	if !isValidator {
+		var willBeValidator bool
+		vdr, willBeValidator, err = vm.willBeValidator(db, tx.Subnet, tx.NodeID)
+		if err != nil {
+			return nil, tempError{err}
+		}
+		if !willBeValidator {
+			return nil, permError{errNotSubnetValidator}
+		}
+	}
+	stakerTx, ok := vdr.(*UnsignedAddSubnetValidatorTx)
+	if !ok {
+		return nil, tempError{fmt.Errorf("expected subnet validator but got %T", vdr)}
+	}
+	if isValidator {
+		if err := vm.removeStaker(db, tx.Subnet, &rewardTx{Tx: Tx{UnsignedTx: stakerTx}}); err != nil {
+			return nil, tempError{err}
+		}
+	} else {
+		if err := vm.dequeueStaker(db, tx.Subnet, &Tx{UnsignedTx: stakerTx}); err != nil {
+			return nil, tempError{err}
+		}
+	}
+
+	txID := tx.ID()
+
+	// Consume the UTXOS
+	if err := vm.consumeInputs(db, tx.Ins); err != nil {
+		return nil, tempError{err}
+	}
+	// Produce the UTXOS
+	if err := vm.produceOutputs(db, txID, tx.Outs); err != nil {
+		return nil, tempError{err}
+	}
+
+	if !isValidator {
+		return nil, nil
+	}
+	// Remove the validator from the subnet's validator set
+	onAccept := func() error {
+		return vm.updateVdrMgr(false)
+	}
+	return onAccept, nil
+}
+
+// Create a new transaction
+func (vm *VM) newRemoveSubnetValidatorTx(
+	nodeID ids.ShortID, // ID of the node being removed
+	subnetID ids.ID, // ID of the subnet the node is being removed from
+	keys []*crypto.PrivateKeySECP256K1R, // Keys to use for removing the validator
+	changeAddr ids.ShortID, // Address to send change to, if there is any
+) (*Tx, error) {
+	ins, outs, _, signers, err := vm.stake(vm.DB, keys, 0, vm.txFee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := vm.authorize(vm.DB, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Create the tx
+	utx := &UnsignedRemoveSubnetValidatorTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    vm.Ctx.NetworkID,
+			BlockchainID: vm.Ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NodeID:     nodeID,
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+	}
+	tx := &Tx{UnsignedTx: utx}
+	if err := tx.Sign(vm.codec, signers); err != nil {
+		return nil, err
+	}
+	return tx, utx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID)
+}
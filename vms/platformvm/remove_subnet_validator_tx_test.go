@@ -0,0 +1,209 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/corpetty/avalanchego/database/versiondb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+	"github.com/corpetty/avalanchego/vms/secp256k1fx"
+)
+
+func TestRemoveSubnetValidatorTxSyntacticVerify(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	nodeID := keys[0].PublicKey().Address()
+
+	// Case: tx is nil
+	var unsignedTx *UnsignedRemoveSubnetValidatorTx
+	if err := unsignedTx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err == nil {
+		t.Fatal("should have errored because tx is nil")
+	}
+
+	// Case: Wrong network ID
+	tx, err := vm.newRemoveSubnetValidatorTx(
+		nodeID,
+		testSubnet1.ID(),
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).NetworkID++
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).syntacticallyVerified = false
+	if err := tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err == nil {
+		t.Fatal("should have errored because the wrong network ID was used")
+	}
+
+	// Case: Primary network
+	tx, err = vm.newRemoveSubnetValidatorTx(
+		nodeID,
+		testSubnet1.ID(),
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).Subnet = constants.PrimaryNetworkID
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).syntacticallyVerified = false
+	if err := tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != errRemovePrimaryNetworkValidator {
+		t.Fatalf("expected error %q but got %v", errRemovePrimaryNetworkValidator, err)
+	}
+
+	// Case: Valid
+	tx, err = vm.newRemoveSubnetValidatorTx(
+		nodeID,
+		testSubnet1.ID(),
+		[]*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.UnsignedTx.(*UnsignedRemoveSubnetValidatorTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoveSubnetValidatorTxSemanticVerify(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	controlKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	startTime := defaultValidateStartTime.Add(syncBound).Add(1 * time.Second)
+	endTime := startTime.Add(defaultMinStakingDuration)
+
+	// keys[0] is a pending validator of the subnet
+	pendingNodeID := keys[0].PublicKey().Address()
+	pendingTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,
+		uint64(startTime.Unix()),
+		uint64(endTime.Unix()),
+		pendingNodeID,
+		testSubnet1.ID(),
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.enqueueStaker(vm.DB, testSubnet1.ID(), pendingTx); err != nil {
+		t.Fatal(err)
+	}
+
+	// keys[1] is a current validator of the subnet
+	currentNodeID := keys[1].PublicKey().Address()
+	currentTx, err := vm.newAddSubnetValidatorTx(
+		defaultWeight,
+		uint64(startTime.Unix()),
+		uint64(endTime.Unix()),
+		currentNodeID,
+		testSubnet1.ID(),
+		controlKeys,
+		ids.ShortEmpty, // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.addStaker(vm.DB, testSubnet1.ID(), &rewardTx{Tx: *currentTx}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Case: Before apricot phase 1
+	tx, err := vm.newRemoveSubnetValidatorTx(pendingNodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm.apricotPhase1Time = defaultGenesisTime.Add(time.Second)
+	if _, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, versiondb.New(vm.DB), tx); err == nil {
+		t.Fatal("should have failed because apricot phase 1 isn't active")
+	}
+	vm.apricotPhase1Time = defaultGenesisTime
+
+	// Case: Remove a pending validator
+	db := versiondb.New(vm.DB)
+	if onAccept, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, db, tx); err != nil {
+		t.Fatal(err)
+	} else if onAccept != nil {
+		t.Fatal("removing a pending validator shouldn't change the validator set")
+	}
+	if _, willBeValidator, err := vm.willBeValidator(db, testSubnet1.ID(), pendingNodeID); err != nil {
+		t.Fatal(err)
+	} else if willBeValidator {
+		t.Fatal("should have removed the pending validator")
+	}
+
+	// Case: Remove a current validator
+	tx, err = vm.newRemoveSubnetValidatorTx(currentNodeID, testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db = versiondb.New(vm.DB)
+	if onAccept, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, db, tx); err != nil {
+		t.Fatal(err)
+	} else if onAccept == nil {
+		t.Fatal("removing a current validator should update the validator set")
+	}
+	if _, isValidator, err := vm.isValidator(db, testSubnet1.ID(), currentNodeID); err != nil {
+		t.Fatal(err)
+	} else if isValidator {
+		t.Fatal("should have removed the current validator")
+	}
+
+	// Case: Node isn't a validator of the subnet
+	tx, err = vm.newRemoveSubnetValidatorTx(keys[2].PublicKey().Address(), testSubnet1.ID(), controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, versiondb.New(vm.DB), tx); err == nil {
+		t.Fatal("should have failed because the node isn't a validator of the subnet")
+	}
+
+	// Case: Signed by keys that don't control the subnet
+	ins, outs, _, signers, err := vm.stake(vm.DB, keys, 0, vm.txFee, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signers = append(signers, []*crypto.PrivateKeySECP256K1R{keys[3], keys[4]})
+	utx := &UnsignedRemoveSubnetValidatorTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    vm.Ctx.NetworkID,
+			BlockchainID: vm.Ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		NodeID:     currentNodeID,
+		Subnet:     testSubnet1.ID(),
+		SubnetAuth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+	}
+	tx = &Tx{UnsignedTx: utx}
+	if err := tx.Sign(vm.codec, signers); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utx.SemanticVerify(vm, versiondb.New(vm.DB), tx); err == nil {
+		t.Fatal("should have failed because the tx isn't authorized by the subnet's owner")
+	}
+}
@@ -158,7 +158,7 @@ func (tx *UnsignedRewardValidatorTx) SemanticVerify(
 			if !ok {
 				return nil, nil, nil, nil, permError{errInvalidState}
 			}
-			if err := vm.putUTXO(onCommitDB, &avax.UTXO{
+			if err := vm.putRewardUTXO(onCommitDB, tx.TxID, &avax.UTXO{
 				UTXOID: avax.UTXOID{
 					TxID:        tx.TxID,
 					OutputIndex: uint32(len(uStakerTx.Outs) + len(uStakerTx.Stake)),
@@ -286,7 +286,7 @@ func (tx *UnsignedRewardValidatorTx) SemanticVerify(
 			if !ok {
 				return nil, nil, nil, nil, permError{errInvalidState}
 			}
-			if err := vm.putUTXO(onCommitDB, &avax.UTXO{
+			if err := vm.putRewardUTXO(onCommitDB, tx.TxID, &avax.UTXO{
 				UTXOID: avax.UTXOID{
 					TxID:        tx.TxID,
 					OutputIndex: uint32(len(uStakerTx.Outs) + len(uStakerTx.Stake)),
@@ -314,7 +314,7 @@ func (tx *UnsignedRewardValidatorTx) SemanticVerify(
 			if !ok {
 				return nil, nil, nil, nil, permError{errInvalidState}
 			}
-			if err := vm.putUTXO(onCommitDB, &avax.UTXO{
+			if err := vm.putRewardUTXO(onCommitDB, tx.TxID, &avax.UTXO{
 				UTXOID: avax.UTXOID{
 					TxID:        tx.TxID,
 					OutputIndex: uint32(len(uStakerTx.Outs) + len(uStakerTx.Stake) + offset),
@@ -214,6 +214,23 @@ func TestRewardDelegatorTxSemanticVerify(t *testing.T) {
 		t.Fatalf("expected total reward to be %d but is %d", expectedReward, delReward+vdrReward)
 	}
 
+	rewardUTXOs, err := vm.getRewardUTXOs(onCommitDB, delTx.ID())
+	assert.NoError(t, err)
+	if len(rewardUTXOs) != 2 {
+		t.Fatalf("expected the delegator and delegatee reward UTXOs to be indexed but got %d UTXOs", len(rewardUTXOs))
+	}
+	if amt := rewardUTXOs[0].Out.(*secp256k1fx.TransferOutput).Amount(); amt != delReward {
+		t.Fatalf("expected the first reward UTXO to pay the delegator %d but pays %d", delReward, amt)
+	}
+	if amt := rewardUTXOs[1].Out.(*secp256k1fx.TransferOutput).Amount(); amt != vdrReward {
+		t.Fatalf("expected the second reward UTXO to pay the delegatee %d but pays %d", vdrReward, amt)
+	}
+	if rewardUTXOs, err := vm.getRewardUTXOs(onAbortDB, delTx.ID()); err != nil {
+		t.Fatal(err)
+	} else if len(rewardUTXOs) != 0 {
+		t.Fatal("no reward UTXOs should be indexed if the tx is aborted")
+	}
+
 	abortVdrBalance, err := vm.getBalance(onAbortDB, vdrDestSet)
 	assert.NoError(t, err)
 	vdrReward, err = math.Sub64(abortVdrBalance, oldVdrBalance)
@@ -254,7 +271,7 @@ func TestOptimisticUptime(t *testing.T) {
 	firstCtx.Lock.Lock()
 
 	firstMsgChan := make(chan common.Message, 1)
-	if err := firstVM.Initialize(firstCtx, db, genesisBytes, firstMsgChan, nil); err != nil {
+	if err := firstVM.Initialize(firstCtx, db, genesisBytes, nil, firstMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -293,7 +310,7 @@ func TestOptimisticUptime(t *testing.T) {
 	}()
 
 	secondMsgChan := make(chan common.Message, 1)
-	if err := secondVM.Initialize(secondCtx, db, genesisBytes, secondMsgChan, nil); err != nil {
+	if err := secondVM.Initialize(secondCtx, db, genesisBytes, nil, secondMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -397,7 +414,7 @@ func TestObservedUptime(t *testing.T) {
 	firstCtx.Lock.Lock()
 
 	firstMsgChan := make(chan common.Message, 1)
-	if err := firstVM.Initialize(firstCtx, db, genesisBytes, firstMsgChan, nil); err != nil {
+	if err := firstVM.Initialize(firstCtx, db, genesisBytes, nil, firstMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -440,7 +457,7 @@ func TestObservedUptime(t *testing.T) {
 	}()
 
 	secondMsgChan := make(chan common.Message, 1)
-	if err := secondVM.Initialize(secondCtx, db, genesisBytes, secondMsgChan, nil); err != nil {
+	if err := secondVM.Initialize(secondCtx, db, genesisBytes, nil, secondMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -544,7 +561,7 @@ func TestUptimeDisallowed(t *testing.T) {
 	firstCtx.Lock.Lock()
 
 	firstMsgChan := make(chan common.Message, 1)
-	if err := firstVM.Initialize(firstCtx, db, genesisBytes, firstMsgChan, nil); err != nil {
+	if err := firstVM.Initialize(firstCtx, db, genesisBytes, nil, firstMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -583,7 +600,7 @@ func TestUptimeDisallowed(t *testing.T) {
 	}()
 
 	secondMsgChan := make(chan common.Message, 1)
-	if err := secondVM.Initialize(secondCtx, db, genesisBytes, secondMsgChan, nil); err != nil {
+	if err := secondVM.Initialize(secondCtx, db, genesisBytes, nil, secondMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
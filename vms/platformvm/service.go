@@ -43,6 +43,8 @@ var (
 	errInvalidDelegationRate = errors.New("argument 'delegationFeeRate' must be between 0 and 100, inclusive")
 	errNoAddresses           = errors.New("no addresses provided")
 	errNoKeys                = errors.New("user has no keys or funds")
+	errNotPrimaryValidator   = errors.New("this node isn't a primary network validator")
+	errNoPrimaryValidators   = errors.New("couldn't get the primary network validators")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -63,6 +65,41 @@ func (service *Service) GetHeight(r *http.Request, args *struct{}, response *Get
 	return nil
 }
 
+// GetBlockByHeightArgs are the arguments for calls to GetBlockByHeight
+type GetBlockByHeightArgs struct {
+	Height   json.Uint64         `json:"height"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetBlockByHeightResponse is the response from calls to GetBlockByHeight
+type GetBlockByHeightResponse struct {
+	BlockID  ids.ID              `json:"blockID"`
+	Block    string              `json:"block"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetBlockByHeight returns the accepted block at the provided height
+func (service *Service) GetBlockByHeight(_ *http.Request, args *GetBlockByHeightArgs, response *GetBlockByHeightResponse) error {
+	service.vm.Ctx.Log.Info("Platform: GetBlockByHeight called with height %d", args.Height)
+
+	blkID, err := service.vm.GetBlockIDAtHeight(uint64(args.Height))
+	if err != nil {
+		return fmt.Errorf("couldn't get block at height %d: %w", args.Height, err)
+	}
+	blk, err := service.vm.getBlock(blkID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+	}
+
+	response.BlockID = blkID
+	response.Block, err = formatting.Encode(args.Encoding, blk.Bytes())
+	if err != nil {
+		return fmt.Errorf("couldn't encode block as a string: %w", err)
+	}
+	response.Encoding = args.Encoding
+	return nil
+}
+
 // ExportKeyArgs are arguments for ExportKey
 type ExportKeyArgs struct {
 	api.UserPass
@@ -508,8 +545,14 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 	if getAll {
 		response.Subnets = make([]APISubnet, len(subnets)+1)
 		for i, subnet := range subnets {
-			unsignedTx := subnet.UnsignedTx.(*UnsignedCreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			ownerIntf, err := service.vm.getSubnetOwner(service.vm.DB, subnet.ID())
+			if err != nil {
+				return fmt.Errorf("couldn't get owner of subnet %s: %w", subnet.ID(), err)
+			}
+			owner, ok := ownerIntf.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return errUnknownOwners
+			}
 			controlAddrs := []string{}
 			for _, controlKeyID := range owner.Addrs {
 				addr, err := service.vm.FormatLocalAddress(controlKeyID)
@@ -537,8 +580,14 @@ func (service *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, respon
 	idsSet.Add(args.IDs...)
 	for _, subnet := range subnets {
 		if idsSet.Contains(subnet.ID()) {
-			unsignedTx := subnet.UnsignedTx.(*UnsignedCreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			ownerIntf, err := service.vm.getSubnetOwner(service.vm.DB, subnet.ID())
+			if err != nil {
+				return fmt.Errorf("couldn't get owner of subnet %s: %w", subnet.ID(), err)
+			}
+			owner, ok := ownerIntf.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return errUnknownOwners
+			}
 			controlAddrs := []string{}
 			for _, controlKeyID := range owner.Addrs {
 				addr, err := service.vm.FormatLocalAddress(controlKeyID)
@@ -706,6 +755,7 @@ func (service *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentVa
 				return err
 			}
 			uptime := json.Float32(rawUptime)
+			rewardEligible := rawUptime >= service.vm.uptimePercentage
 
 			_, connected := service.vm.connections[nodeID]
 
@@ -734,6 +784,7 @@ func (service *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentVa
 					StakeAmount: &weight,
 				},
 				Uptime:          &uptime,
+				RewardEligible:  &rewardEligible,
 				Connected:       &connected,
 				PotentialReward: &potentialReward,
 				RewardOwner:     rewardOwner,
@@ -774,6 +825,74 @@ func (service *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentVa
 	return stopDB.Close()
 }
 
+// GetUptimeReply is the response from calling GetUptime
+type GetUptimeReply struct {
+	// Uptime of this node as observed by itself
+	LocalUptime json.Float32 `json:"localUptime"`
+	// Stake weighted average of the uptimes of this node reported by the
+	// sampled validators
+	WeightedAveragePeerUptime json.Float32 `json:"weightedAveragePeerUptime"`
+	// Node ID --> Uptime of this node reported by that validator
+	PeerUptimes map[string]json.Float32 `json:"peerUptimes"`
+	// Minimum uptime required to be rewarded for validating
+	RequiredUptime json.Float32 `json:"requiredUptime"`
+	// True if this node would be rewarded if its staking period ended now.
+	// Uses the uptime reported by the sampled validators if any have replied,
+	// and this node's own view of its uptime otherwise.
+	RewardEligible bool `json:"rewardEligible"`
+}
+
+// GetUptime returns the uptime of this node as observed by itself and by a
+// sample of the validators it is connected to
+func (service *Service) GetUptime(_ *http.Request, _ *struct{}, reply *GetUptimeReply) error {
+	service.vm.Ctx.Log.Info("Platform: GetUptime called")
+
+	nodeID := service.vm.Ctx.NodeID
+	vdr, isValidator, err := service.vm.isValidator(service.vm.DB, constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get validator %s: %w", nodeID.PrefixedString(constants.NodeIDPrefix), err)
+	}
+	if !isValidator {
+		return errNotPrimaryValidator
+	}
+
+	localUptime, err := service.vm.calculateLocalUptime(service.vm.DB, vdr.StartTime())
+	if err != nil {
+		return fmt.Errorf("couldn't calculate uptime: %w", err)
+	}
+
+	vdrs, ok := service.vm.vdrMgr.GetValidators(constants.PrimaryNetworkID)
+	if !ok {
+		return errNoPrimaryValidators
+	}
+
+	// Only count the views of nodes that are still validators
+	var (
+		totalWeight   uint64
+		weightedTotal float64
+	)
+	reply.PeerUptimes = make(map[string]json.Float32, len(service.vm.uptimeSampler.peerUptimes))
+	for peerID, uptime := range service.vm.uptimeSampler.peerUptimes {
+		weight, ok := vdrs.GetWeight(peerID)
+		if !ok {
+			continue
+		}
+		totalWeight += weight
+		weightedTotal += float64(weight) * uptime
+		reply.PeerUptimes[peerID.PrefixedString(constants.NodeIDPrefix)] = json.Float32(uptime)
+	}
+
+	uptime := localUptime
+	if totalWeight > 0 {
+		uptime = weightedTotal / float64(totalWeight)
+		reply.WeightedAveragePeerUptime = json.Float32(uptime)
+	}
+	reply.LocalUptime = json.Float32(localUptime)
+	reply.RequiredUptime = json.Float32(service.vm.uptimePercentage)
+	reply.RewardEligible = uptime >= service.vm.uptimePercentage
+	return nil
+}
+
 // GetPendingValidatorsArgs are the arguments for calling GetPendingValidators
 type GetPendingValidatorsArgs struct {
 	// Subnet we're getting the pending validators of
@@ -943,6 +1062,38 @@ func (service *Service) SampleValidators(_ *http.Request, args *SampleValidators
 	return nil
 }
 
+// GetValidatorsAtArgs are the arguments for calls to GetValidatorsAt
+type GetValidatorsAtArgs struct {
+	Height json.Uint64 `json:"height"`
+
+	// ID of the subnet to get the validators of
+	// If omitted, defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// GetValidatorsAtReply is the response from calls to GetValidatorsAt
+type GetValidatorsAtReply struct {
+	// Node ID --> Weight of the validator, including delegations
+	Validators map[string]json.Uint64 `json:"validators"`
+}
+
+// GetValidatorsAt returns the weights of the validators of a subnet as of the
+// accepted block at the provided height
+func (service *Service) GetValidatorsAt(_ *http.Request, args *GetValidatorsAtArgs, reply *GetValidatorsAtReply) error {
+	service.vm.Ctx.Log.Info("Platform: GetValidatorsAt called with Height = %d, SubnetID = %s", args.Height, args.SubnetID)
+
+	vdrs, err := service.vm.GetValidatorSet(uint64(args.Height), args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get validator set at height %d: %w", args.Height, err)
+	}
+
+	reply.Validators = make(map[string]json.Uint64, len(vdrs))
+	for nodeID, weight := range vdrs {
+		reply.Validators[nodeID.PrefixedString(constants.NodeIDPrefix)] = json.Uint64(weight)
+	}
+	return nil
+}
+
 /*
  ******************************************************
  ************ Add Validators to Subnets ***************
@@ -1290,6 +1441,219 @@ func (service *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValid
 	return errs.Err
 }
 
+// RemoveSubnetValidatorArgs are the arguments to RemoveSubnetValidator
+type RemoveSubnetValidatorArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// ID of the node to remove from the subnet
+	NodeID string `json:"nodeID"`
+	// ID of the subnet to remove the node from
+	SubnetID string `json:"subnetID"`
+}
+
+// RemoveSubnetValidator creates and signs and issues a transaction to remove a
+// current or pending validator from a subnet other than the primary network
+func (service *Service) RemoveSubnetValidator(_ *http.Request, args *RemoveSubnetValidatorArgs, response *api.JSONTxIDChangeAddr) error {
+	service.vm.SnowmanVM.Ctx.Log.Info("Platform: RemoveSubnetValidator called")
+	if args.SubnetID == "" {
+		return errNoSubnetID
+	}
+
+	// Parse the node ID
+	nodeID, err := ids.ShortFromPrefixedString(args.NodeID, constants.NodeIDPrefix)
+	if err != nil {
+		return fmt.Errorf("error parsing nodeID: %q: %w", args.NodeID, err)
+	}
+
+	// Parse the subnet ID
+	subnetID, err := ids.FromString(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return errRemovePrimaryNetworkValidator
+	}
+
+	// Get the keys controlled by the user
+	db, err := service.vm.Ctx.Keystore.GetDatabase(args.Username, args.Password)
+	if err != nil {
+		return fmt.Errorf("problem retrieving user %q: %w", args.Username, err)
+	}
+	defer db.Close()
+
+	user := user{db: db}
+	keys, err := user.getKeys()
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = service.vm.ParseLocalAddress(args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Parse the from addresses
+	fromAddrs := ids.ShortSet{}
+	for _, addrStr := range args.From {
+		addr, err := service.vm.ParseLocalAddress(addrStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse 'from' address %s: %w", addrStr, err)
+		}
+		fromAddrs.Add(addr)
+	}
+
+	// If fromAddrs given, only use those addrs to pay fee
+	filteredPrivKeys := []*crypto.PrivateKeySECP256K1R{}
+	if fromAddrs.Len() == 0 {
+		filteredPrivKeys = keys
+	} else {
+		for _, key := range keys {
+			if fromAddrs.Contains(key.PublicKey().Address()) {
+				filteredPrivKeys = append(filteredPrivKeys, key)
+			}
+		}
+	}
+
+	// Create the transaction
+	tx, err := service.vm.newRemoveSubnetValidatorTx(
+		nodeID,           // Node ID
+		subnetID,         // Subnet ID
+		filteredPrivKeys, // Keys
+		changeAddr,       // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = service.vm.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		service.vm.mempool.IssueTx(tx),
+		db.Close(),
+	)
+	return errs.Err
+}
+
+// TransferSubnetOwnershipArgs are the arguments to TransferSubnetOwnership
+type TransferSubnetOwnershipArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// ID of the subnet whose ownership is being transferred
+	SubnetID string `json:"subnetID"`
+	// Addresses that will control the subnet
+	ControlKeys []string `json:"controlKeys"`
+	// Number of control keys needed to manage the subnet
+	Threshold json.Uint32 `json:"threshold"`
+}
+
+// TransferSubnetOwnership creates and signs and issues a transaction to replace
+// the owner of a subnet other than the primary network
+func (service *Service) TransferSubnetOwnership(_ *http.Request, args *TransferSubnetOwnershipArgs, response *api.JSONTxIDChangeAddr) error {
+	service.vm.SnowmanVM.Ctx.Log.Info("Platform: TransferSubnetOwnership called")
+	if args.SubnetID == "" {
+		return errNoSubnetID
+	}
+
+	// Parse the subnet ID
+	subnetID, err := ids.FromString(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return errTransferPrimaryNetwork
+	}
+
+	// Parse the new control keys
+	controlKeys := []ids.ShortID{}
+	for _, controlKey := range args.ControlKeys {
+		controlKeyID, err := service.vm.ParseLocalAddress(controlKey)
+		if err != nil {
+			return fmt.Errorf("problem parsing control key %q: %w", controlKey, err)
+		}
+		controlKeys = append(controlKeys, controlKeyID)
+	}
+
+	// Get the keys controlled by the user
+	db, err := service.vm.Ctx.Keystore.GetDatabase(args.Username, args.Password)
+	if err != nil {
+		return fmt.Errorf("problem retrieving user %q: %w", args.Username, err)
+	}
+	defer db.Close()
+
+	user := user{db: db}
+	keys, err := user.getKeys()
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = service.vm.ParseLocalAddress(args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Parse the from addresses
+	fromAddrs := ids.ShortSet{}
+	for _, addrStr := range args.From {
+		addr, err := service.vm.ParseLocalAddress(addrStr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse 'from' address %s: %w", addrStr, err)
+		}
+		fromAddrs.Add(addr)
+	}
+
+	// If fromAddrs given, only use those addrs to pay fee
+	filteredPrivKeys := []*crypto.PrivateKeySECP256K1R{}
+	if fromAddrs.Len() == 0 {
+		filteredPrivKeys = keys
+	} else {
+		for _, key := range keys {
+			if fromAddrs.Contains(key.PublicKey().Address()) {
+				filteredPrivKeys = append(filteredPrivKeys, key)
+			}
+		}
+	}
+
+	// Create the transaction
+	tx, err := service.vm.newTransferSubnetOwnershipTx(
+		subnetID,               // Subnet ID
+		uint32(args.Threshold), // Threshold
+		controlKeys,            // Control Addresses
+		filteredPrivKeys,       // Keys
+		changeAddr,             // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = service.vm.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		service.vm.mempool.IssueTx(tx),
+		db.Close(),
+	)
+	return errs.Err
+}
+
 // CreateSubnetArgs are the arguments to CreateSubnet
 type CreateSubnetArgs struct {
 	// User, password, from addrs, change addr
@@ -1915,6 +2279,51 @@ func (service *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *ap
 	return nil
 }
 
+// GetRewardUTXOsReply defines the GetRewardUTXOs replies returned from the API
+type GetRewardUTXOsReply struct {
+	// Number of UTXOs returned
+	NumFetched json.Uint64 `json:"numFetched"`
+	// The UTXOs
+	UTXOs []string `json:"utxos"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetRewardUTXOs returns the UTXOs that were rewarded after the provided
+// transaction's staking period ended. Errors if the staking period was decided
+// before this node indexed reward UTXOs.
+func (service *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *GetRewardUTXOsReply) error {
+	service.vm.Ctx.Log.Info("Platform: GetRewardUTXOs called")
+
+	stakerTx, err := service.vm.getStakerTx(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get staker tx: %w", err)
+	}
+	if err := service.vm.rewardUTXOsIndexed(service.vm.DB, stakerTx); err != nil {
+		return fmt.Errorf("couldn't get reward UTXOs: %w", err)
+	}
+
+	utxos, err := service.vm.getRewardUTXOs(service.vm.DB, args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get reward UTXOs: %w", err)
+	}
+
+	reply.NumFetched = json.Uint64(len(utxos))
+	reply.UTXOs = make([]string, len(utxos))
+	for i, utxo := range utxos {
+		utxoBytes, err := service.vm.codec.Marshal(codecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+		}
+		reply.UTXOs[i], err = formatting.Encode(args.Encoding, utxoBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as string: %s", utxo.InputID(), err)
+		}
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 // GetTxStatusArgs ...
 type GetTxStatusArgs struct {
 	TxID ids.ID `json:"txID"`
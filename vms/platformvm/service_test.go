@@ -6,6 +6,7 @@ package platformvm
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"strings"
@@ -277,6 +278,49 @@ func TestGetTxStatus(t *testing.T) {
 	}
 }
 
+// Test retrieving the last accepted block by its height
+func TestGetBlockByHeight(t *testing.T) {
+	service := defaultService(t)
+	service.vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := service.vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		service.vm.Ctx.Lock.Unlock()
+	}()
+
+	lastAcceptedID := service.vm.LastAccepted()
+	lastAccepted, err := service.vm.getBlock(lastAcceptedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	height := lastAccepted.Height()
+
+	response := GetBlockByHeightResponse{}
+	args := &GetBlockByHeightArgs{
+		Height:   cjson.Uint64(height),
+		Encoding: formatting.Hex,
+	}
+	if err := service.GetBlockByHeight(nil, args, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.BlockID != lastAcceptedID {
+		t.Fatalf("expected block %s but got %s", lastAcceptedID, response.BlockID)
+	}
+	blkBytes, err := formatting.Decode(response.Encoding, response.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(blkBytes, lastAccepted.Bytes()) {
+		t.Fatal("returned the wrong block bytes")
+	}
+
+	args.Height = cjson.Uint64(height + 1)
+	if err := service.GetBlockByHeight(nil, args, &response); err == nil {
+		t.Fatalf("shouldn't have an accepted block at height %d", height+1)
+	}
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	service := defaultService(t)
@@ -697,3 +741,79 @@ func TestGetCurrentValidators(t *testing.T) {
 		t.Fatalf("didnt find delegator")
 	}
 }
+
+// Test that the reward UTXOs of staking periods decided before reward UTXOs
+// were indexed are reported as not indexed
+func TestGetRewardUTXOsNotIndexed(t *testing.T) {
+	service := defaultService(t)
+	service.vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := service.vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		service.vm.Ctx.Lock.Unlock()
+	}()
+
+	// Fast forward clock to time for genesis validators to leave
+	service.vm.clock.Set(defaultValidateEndTime)
+
+	acceptCommit := func() *ProposalBlock {
+		blk, err := service.vm.BuildBlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := blk.Verify(); err != nil {
+			t.Fatal(err)
+		}
+		block := blk.(*ProposalBlock)
+		options, err := block.Options()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := block.Accept(); err != nil {
+			t.Fatal(err)
+		}
+		if err := options[0].Verify(); err != nil {
+			t.Fatal(err)
+		}
+		if err := options[0].Accept(); err != nil {
+			t.Fatal(err)
+		}
+		return block
+	}
+	acceptCommit() // advance the timestamp
+	rewardTx := acceptCommit().Tx.UnsignedTx.(*UnsignedRewardValidatorTx)
+
+	// Simulate the first validator having been rewarded before the index
+	// existed
+	if err := service.vm.DB.Delete(firstRewardStopKeyKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.vm.initializeRewardUTXOs(); err != nil {
+		t.Fatal(err)
+	}
+	reply := GetRewardUTXOsReply{}
+	if err := service.GetRewardUTXOs(nil, &api.GetTxArgs{TxID: rewardTx.TxID}, &reply); !errors.Is(err, errRewardUTXOsNotIndexed) {
+		t.Fatalf("expected %s but got %v", errRewardUTXOsNotIndexed, err)
+	}
+
+	// The next validator is rewarded after the index existed
+	nextStaker, err := service.vm.nextStakerStop(service.vm.DB, constants.PrimaryNetworkID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextTxID := nextStaker.Tx.ID()
+	if err := service.GetRewardUTXOs(nil, &api.GetTxArgs{TxID: nextTxID}, &reply); err != nil {
+		t.Fatal(err)
+	} else if reply.NumFetched != 0 {
+		t.Fatalf("validator hasn't been rewarded yet but got %d reward UTXOs", reply.NumFetched)
+	}
+	if rewardTx := acceptCommit().Tx.UnsignedTx.(*UnsignedRewardValidatorTx); rewardTx.TxID != nextTxID {
+		t.Fatalf("expected validator %s to be rewarded but %s was", nextTxID, rewardTx.TxID)
+	}
+	if err := service.GetRewardUTXOs(nil, &api.GetTxArgs{TxID: nextTxID}, &reply); err != nil {
+		t.Fatal(err)
+	} else if reply.NumFetched == 0 {
+		t.Fatal("validator should have been rewarded")
+	}
+}
@@ -290,13 +290,13 @@ func (vm *VM) authorize(
 	error,
 ) {
 	// Get information about the subnet we're authorizing the operation for
-	subnet, err := vm.getSubnet(db, subnetID)
+	ownerIntf, err := vm.getSubnetOwner(db, subnetID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("subnet %s doesn't exist", subnetID)
+		return nil, nil, fmt.Errorf("couldn't get owner of subnet %s: %w", subnetID, err)
 	}
 
 	// Make sure the owners of the subnet match the provided keys
-	owner, ok := subnet.UnsignedTx.(*UnsignedCreateSubnetTx).Owner.(*secp256k1fx.OutputOwners)
+	owner, ok := ownerIntf.(*secp256k1fx.OutputOwners)
 	if !ok {
 		return nil, nil, errUnknownOwners
 	}
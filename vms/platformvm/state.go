@@ -5,6 +5,7 @@ package platformvm
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/corpetty/avalanchego/utils/wrappers"
 	"github.com/corpetty/avalanchego/vms/components/avax"
 	"github.com/corpetty/avalanchego/vms/components/state"
+	"github.com/corpetty/avalanchego/vms/components/verify"
 
 	safemath "github.com/corpetty/avalanchego/utils/math"
 )
@@ -27,13 +29,20 @@ import (
 
 // TODO: Cache prefixed IDs or use different way of keying into database
 const (
-	startDBPrefix  = "start"
-	stopDBPrefix   = "stop"
-	uptimeDBPrefix = "uptime"
+	startDBPrefix             = "start"
+	stopDBPrefix              = "stop"
+	uptimeDBPrefix            = "uptime"
+	pendingWeightDiffDBPrefix = "pendingWeightDiff"
+	weightDiffDBPrefix        = "weightDiff"
+	subnetOwnerDBPrefix       = "subnetOwner"
+	rewardUTXOsDBPrefix       = "rewardUTXOs"
 )
 
 var (
-	errNoValidators = errors.New("there are no validators")
+	errNoValidators          = errors.New("there are no validators")
+	errHeightNotAccepted     = errors.New("height hasn't been accepted yet")
+	errHeightBeforeDiffIndex = errors.New("validator set history isn't available for this height")
+	errRewardUTXOsNotIndexed = errors.New("staking period was decided before reward UTXOs were indexed")
 )
 
 // persist a tx
@@ -168,17 +177,21 @@ func (vm *VM) dequeueStaker(db database.Database, subnetID ids.ID, stakerTx *Tx)
 func (vm *VM) addStaker(db database.Database, subnetID ids.ID, tx *rewardTx) error {
 	var (
 		staker   TimedTx
+		nodeID   ids.ShortID
 		priority byte
 	)
 	switch unsignedTx := tx.Tx.UnsignedTx.(type) {
 	case *UnsignedAddDelegatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 0
 	case *UnsignedAddSubnetValidatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 1
 	case *UnsignedAddValidatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 2
 	default:
 		return fmt.Errorf("staker is unexpected type %T", tx.Tx.UnsignedTx)
@@ -195,24 +208,26 @@ func (vm *VM) addStaker(db database.Database, subnetID ids.ID, tx *rewardTx) err
 	prefixStop := []byte(fmt.Sprintf("%s%s", subnetID, stopDBPrefix))
 	prefixStopDB := prefixdb.NewNested(prefixStop, db)
 
-	p := wrappers.Packer{MaxSize: wrappers.LongLen + wrappers.ByteLen + hashing.HashLen}
-	p.PackLong(uint64(staker.EndTime().Unix()))
-	p.PackByte(priority)
-	p.PackFixedBytes(txID[:])
-	if p.Err != nil {
+	stopKey, err := stakerStopKey(staker, priority, txID)
+	if err != nil {
 		// Close the DB, but ignore the error, as the parent error needs to be
 		// returned.
 		_ = prefixStopDB.Close()
-		return fmt.Errorf("couldn't serialize validator key: %w", p.Err)
+		return err
 	}
-	stopKey := p.Bytes
 
 	errs := wrappers.Errs{}
 	errs.Add(
 		prefixStopDB.Put(stopKey, txBytes),
 		prefixStopDB.Close(),
 	)
-	return errs.Err
+	if errs.Errored() {
+		return errs.Err
+	}
+	return vm.putPendingWeightDiff(db, subnetID, txID, &validatorWeightDiff{
+		NodeID: nodeID,
+		Amount: staker.Weight(),
+	})
 }
 
 // Remove a staker from subnet [subnetID]
@@ -220,17 +235,21 @@ func (vm *VM) addStaker(db database.Database, subnetID ids.ID, tx *rewardTx) err
 func (vm *VM) removeStaker(db database.Database, subnetID ids.ID, tx *rewardTx) error {
 	var (
 		staker   TimedTx
+		nodeID   ids.ShortID
 		priority byte
 	)
 	switch unsignedTx := tx.Tx.UnsignedTx.(type) {
 	case *UnsignedAddDelegatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 0
 	case *UnsignedAddSubnetValidatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 1
 	case *UnsignedAddValidatorTx:
 		staker = unsignedTx
+		nodeID = unsignedTx.Validator.NodeID
 		priority = 2
 	default:
 		return fmt.Errorf("staker is unexpected type %T", tx.Tx.UnsignedTx)
@@ -242,24 +261,42 @@ func (vm *VM) removeStaker(db database.Database, subnetID ids.ID, tx *rewardTx)
 	prefixStop := []byte(fmt.Sprintf("%s%s", subnetID, stopDBPrefix))
 	prefixStopDB := prefixdb.NewNested(prefixStop, db)
 
-	p := wrappers.Packer{MaxSize: wrappers.LongLen + wrappers.ByteLen + hashing.HashLen}
-	p.PackLong(uint64(staker.EndTime().Unix()))
-	p.PackByte(priority)
-	p.PackFixedBytes(txID[:])
-	if p.Err != nil {
+	stopKey, err := stakerStopKey(staker, priority, txID)
+	if err != nil {
 		// Close the DB, but ignore the error, as the parent error needs to be
 		// returned.
 		_ = prefixStopDB.Close()
-		return fmt.Errorf("couldn't serialize validator key: %w", p.Err)
+		return err
 	}
-	stopKey := p.Bytes
 
 	errs := wrappers.Errs{}
 	errs.Add(
 		prefixStopDB.Delete(stopKey),
 		prefixStopDB.Close(),
 	)
-	return errs.Err
+	if errs.Errored() {
+		return errs.Err
+	}
+	return vm.putPendingWeightDiff(db, subnetID, txID, &validatorWeightDiff{
+		NodeID:   nodeID,
+		Decrease: true,
+		Amount:   staker.Weight(),
+	})
+}
+
+// stakerStopKey returns the key [staker], the tx with ID [txID], is stored
+// under in its subnet's current stakers. Current stakers are sorted by stop
+// time, then by [priority], then by tx ID, which is also the order they are
+// removed in.
+func stakerStopKey(staker TimedTx, priority byte, txID ids.ID) ([]byte, error) {
+	p := wrappers.Packer{MaxSize: wrappers.LongLen + wrappers.ByteLen + hashing.HashLen}
+	p.PackLong(uint64(staker.EndTime().Unix()))
+	p.PackByte(priority)
+	p.PackFixedBytes(txID[:])
+	if p.Err != nil {
+		return nil, fmt.Errorf("couldn't serialize validator key: %w", p.Err)
+	}
+	return p.Bytes, nil
 }
 
 // Returns the pending staker that will start staking next
@@ -401,6 +438,93 @@ func (vm *VM) putUTXO(db database.Database, utxo *avax.UTXO) error {
 	return nil
 }
 
+// putRewardUTXO persists [utxo] as one of the rewards paid out for the staking
+// period started by the tx with ID [txID], and adds it to the UTXO set
+func (vm *VM) putRewardUTXO(db database.Database, txID ids.ID, utxo *avax.UTXO) error {
+	if err := vm.putUTXO(db, utxo); err != nil {
+		return err
+	}
+
+	utxoBytes, err := Codec.Marshal(codecVersion, utxo)
+	if err != nil {
+		return err
+	}
+
+	// Key by output index so that the rewards are iterated in the order they
+	// were created
+	key := make([]byte, wrappers.IntLen)
+	binary.BigEndian.PutUint32(key, utxo.OutputIndex)
+
+	rewardUTXOsDB := prefixdb.NewNested([]byte(fmt.Sprintf("%s%s", txID, rewardUTXOsDBPrefix)), db)
+	errs := wrappers.Errs{}
+	errs.Add(
+		rewardUTXOsDB.Put(key, utxoBytes),
+		rewardUTXOsDB.Close(),
+	)
+	return errs.Err
+}
+
+// getRewardUTXOs returns the rewards paid out for the staking period started
+// by the tx with ID [txID]. The returned UTXOs may have since been spent.
+func (vm *VM) getRewardUTXOs(db database.Database, txID ids.ID) ([]*avax.UTXO, error) {
+	rewardUTXOsDB := prefixdb.NewNested([]byte(fmt.Sprintf("%s%s", txID, rewardUTXOsDBPrefix)), db)
+	defer rewardUTXOsDB.Close()
+
+	iter := rewardUTXOsDB.NewIterator()
+	defer iter.Release()
+
+	utxos := []*avax.UTXO(nil)
+	for iter.Next() {
+		utxo := &avax.UTXO{}
+		if _, err := Codec.Unmarshal(iter.Value(), utxo); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal reward UTXO: %w", err)
+		}
+		utxos = append(utxos, utxo)
+	}
+	return utxos, iter.Error()
+}
+
+// getFirstRewardStopKey returns the stop key of the first staker whose reward
+// UTXOs are known. Databases that were created before reward UTXOs were
+// indexed don't have the rewards of the stakers removed before the upgrade.
+// Stakers are removed in the order of their stop keys, so these are the
+// stakers whose stop keys are less than the returned key.
+func (vm *VM) getFirstRewardStopKey(db database.Database) ([]byte, error) {
+	return db.Get(firstRewardStopKeyKey[:])
+}
+
+func (vm *VM) putFirstRewardStopKey(db database.Database, stopKey []byte) error {
+	return db.Put(firstRewardStopKeyKey[:], stopKey)
+}
+
+// rewardUTXOsIndexed returns an error if the staking period started by [tx]
+// was decided before reward UTXOs were indexed
+func (vm *VM) rewardUTXOsIndexed(db database.Database, tx *Tx) error {
+	var priority byte
+	switch tx.UnsignedTx.(type) {
+	case *UnsignedAddDelegatorTx:
+		priority = 0
+	case *UnsignedAddValidatorTx:
+		priority = 2
+	default:
+		// Only stakers of the Primary Network are rewarded
+		return nil
+	}
+
+	firstStopKey, err := vm.getFirstRewardStopKey(db)
+	if err != nil {
+		return err
+	}
+	stopKey, err := stakerStopKey(tx.UnsignedTx.(TimedTx), priority, tx.ID())
+	if err != nil {
+		return err
+	}
+	if bytes.Compare(stopKey, firstStopKey) < 0 {
+		return errRewardUTXOsNotIndexed
+	}
+	return nil
+}
+
 // removeUTXO removes the UTXO with the given ID
 // If the utxo doesn't exist, returns nil
 func (vm *VM) removeUTXO(db database.Database, utxoID ids.ID) error {
@@ -708,6 +832,62 @@ func (vm *VM) getSubnet(db database.Database, id ids.ID) (*Tx, TxError) {
 	return nil, permError{fmt.Errorf("couldn't find subnet with ID %s", id)}
 }
 
+// get the owner of the subnet with the specified ID. A subnet is owned by the
+// owner it was created with until its ownership is transferred.
+func (vm *VM) getSubnetOwner(db database.Database, id ids.ID) (verify.Verifiable, TxError) {
+	subnet, timedErr := vm.getSubnet(db, id)
+	if timedErr != nil {
+		return nil, timedErr
+	}
+	unsignedSubnet, ok := subnet.UnsignedTx.(*UnsignedCreateSubnetTx)
+	if !ok {
+		return nil, tempError{fmt.Errorf("expected subnet to be created by *UnsignedCreateSubnetTx but got %T", subnet.UnsignedTx)}
+	}
+
+	// Rule change for Apricot phase 1 hardfork. Before it, a subnet is always
+	// owned by the owner it was created with.
+	chainTime, err := vm.getTimestamp(db)
+	if err != nil {
+		return nil, tempError{fmt.Errorf("couldn't get chain timestamp: %w", err)}
+	}
+	if chainTime.Before(vm.apricotPhase1Time) {
+		return unsignedSubnet.Owner, nil
+	}
+
+	ownerDB := prefixdb.NewNested([]byte(subnetOwnerDBPrefix), db)
+	defer ownerDB.Close()
+
+	ownerBytes, err := ownerDB.Get(id[:])
+	if err == database.ErrNotFound {
+		return unsignedSubnet.Owner, nil
+	}
+	if err != nil {
+		return nil, tempError{err}
+	}
+
+	var owner verify.Verifiable
+	if _, err := Codec.Unmarshal(ownerBytes, &owner); err != nil {
+		return nil, tempError{fmt.Errorf("couldn't unmarshal subnet owner: %w", err)}
+	}
+	return owner, nil
+}
+
+// put the owner of the subnet with the specified ID to [db]
+func (vm *VM) putSubnetOwner(db database.Database, id ids.ID, owner verify.Verifiable) error {
+	ownerBytes, err := Codec.Marshal(codecVersion, &owner)
+	if err != nil {
+		return err
+	}
+
+	ownerDB := prefixdb.NewNested([]byte(subnetOwnerDBPrefix), db)
+	errs := wrappers.Errs{}
+	errs.Add(
+		ownerDB.Put(id[:], ownerBytes),
+		ownerDB.Close(),
+	)
+	return errs.Err
+}
+
 // Returns the height of the preferred block
 func (vm *VM) preferredHeight() (uint64, error) {
 	preferred, err := vm.getBlock(vm.Preferred())
@@ -914,6 +1094,203 @@ func (vm *VM) deleteUptime(db database.Database, nodeID ids.ShortID) error {
 	return errs.Err
 }
 
+// validatorWeightDiff is a change to the weight of a validator
+type validatorWeightDiff struct {
+	NodeID   ids.ShortID `serialize:"true"`
+	Decrease bool        `serialize:"true"`
+	Amount   uint64      `serialize:"true"`
+}
+
+// add the change [other] to this diff
+func (d *validatorWeightDiff) add(other *validatorWeightDiff) error {
+	if d.Decrease == other.Decrease {
+		amount, err := safemath.Add64(d.Amount, other.Amount)
+		d.Amount = amount
+		return err
+	}
+	if d.Amount >= other.Amount {
+		d.Amount -= other.Amount
+		return nil
+	}
+	d.Decrease = other.Decrease
+	d.Amount = other.Amount - d.Amount
+	return nil
+}
+
+// Changes to the validator weights are first written as pending diffs in the
+// database of the block that makes them. Because the height of that block isn't
+// known while its txs are being verified, the pending diffs are moved to the
+// height of the block when it's accepted. The pending diffs are keyed by the tx
+// that caused them, so that the diffs of a block are never merged with the diffs
+// of its ancestors.
+func (vm *VM) putPendingWeightDiff(db database.Database, subnetID ids.ID, txID ids.ID, diff *validatorWeightDiff) error {
+	diffBytes, err := Codec.Marshal(codecVersion, diff)
+	if err != nil {
+		return err
+	}
+
+	p := wrappers.Packer{MaxSize: 2*hashing.HashLen + wrappers.BoolLen}
+	p.PackFixedBytes(subnetID[:])
+	p.PackFixedBytes(txID[:])
+	p.PackBool(diff.Decrease)
+	if p.Err != nil {
+		return fmt.Errorf("couldn't serialize weight diff key: %w", p.Err)
+	}
+
+	pendingDB := prefixdb.NewNested([]byte(pendingWeightDiffDBPrefix), db)
+	errs := wrappers.Errs{}
+	errs.Add(
+		pendingDB.Put(p.Bytes, diffBytes),
+		pendingDB.Close(),
+	)
+	return errs.Err
+}
+
+// commitWeightDiffs moves the pending weight diffs in [db] to [height]
+func (vm *VM) commitWeightDiffs(db database.Database, height uint64) error {
+	pendingDB := prefixdb.NewNested([]byte(pendingWeightDiffDBPrefix), db)
+	errs := wrappers.Errs{}
+	errs.Add(
+		vm.movePendingWeightDiffs(db, pendingDB, height),
+		pendingDB.Close(),
+	)
+	return errs.Err
+}
+
+// movePendingWeightDiffs merges the diffs in [pendingDB] by validator and
+// writes them to [height] in [db]
+func (vm *VM) movePendingWeightDiffs(db, pendingDB database.Database, height uint64) error {
+	diffs := make(map[ids.ID]map[ids.ShortID]*validatorWeightDiff)
+	pendingKeys := [][]byte(nil)
+
+	iter := pendingDB.NewIterator()
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) < hashing.HashLen {
+			iter.Release()
+			return fmt.Errorf("weight diff key has unexpected length %d", len(key))
+		}
+		subnetID, err := ids.ToID(key[:hashing.HashLen])
+		if err != nil {
+			iter.Release()
+			return err
+		}
+
+		diff := &validatorWeightDiff{}
+		if _, err := Codec.Unmarshal(iter.Value(), diff); err != nil {
+			iter.Release()
+			return fmt.Errorf("couldn't unmarshal weight diff: %w", err)
+		}
+
+		subnetDiffs, ok := diffs[subnetID]
+		if !ok {
+			subnetDiffs = make(map[ids.ShortID]*validatorWeightDiff)
+			diffs[subnetID] = subnetDiffs
+		}
+		if nodeDiff, ok := subnetDiffs[diff.NodeID]; ok {
+			if err := nodeDiff.add(diff); err != nil {
+				iter.Release()
+				return err
+			}
+		} else {
+			subnetDiffs[diff.NodeID] = diff
+		}
+		// The iterator may reuse the key's memory
+		pendingKeys = append(pendingKeys, append([]byte(nil), key...))
+	}
+	err := iter.Error()
+	iter.Release()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pendingKeys {
+		if err := pendingDB.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	for subnetID, subnetDiffs := range diffs {
+		heightDB := vm.weightDiffDB(db, height, subnetID)
+		for nodeID, diff := range subnetDiffs {
+			if diff.Amount == 0 {
+				continue
+			}
+			diffBytes, err := Codec.Marshal(codecVersion, diff)
+			if err != nil {
+				_ = heightDB.Close()
+				return err
+			}
+			if err := heightDB.Put(nodeID.Bytes(), diffBytes); err != nil {
+				_ = heightDB.Close()
+				return err
+			}
+		}
+		if err := heightDB.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getWeightDiffs returns the changes to the validator weights of [subnetID]
+// that were made by the block at [height]
+func (vm *VM) getWeightDiffs(db database.Database, height uint64, subnetID ids.ID) ([]*validatorWeightDiff, error) {
+	heightDB := vm.weightDiffDB(db, height, subnetID)
+	defer heightDB.Close()
+
+	iter := heightDB.NewIterator()
+	defer iter.Release()
+
+	diffs := []*validatorWeightDiff(nil)
+	for iter.Next() {
+		diff := &validatorWeightDiff{}
+		if _, err := Codec.Unmarshal(iter.Value(), diff); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal weight diff: %w", err)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		iter.Error(),
+		heightDB.Close(),
+	)
+	return diffs, errs.Err
+}
+
+// weightDiffDB returns the database that holds the changes to the validator
+// weights of [subnetID] that were made by the block at [height]
+func (vm *VM) weightDiffDB(db database.Database, height uint64, subnetID ids.ID) database.Database {
+	p := wrappers.Packer{Bytes: make([]byte, wrappers.LongLen+hashing.HashLen)}
+	p.PackLong(height)
+	p.PackFixedBytes(subnetID[:])
+	diffDB := prefixdb.NewNested([]byte(weightDiffDBPrefix), db)
+	return prefixdb.NewNested(p.Bytes, diffDB)
+}
+
+// getFirstWeightDiffHeight returns the lowest height whose weight diffs are
+// known. Databases that were created before weight diffs were recorded don't
+// have the diffs of the blocks that were accepted before the upgrade.
+func (vm *VM) getFirstWeightDiffHeight(db database.Database) (uint64, error) {
+	heightBytes, err := db.Get(firstWeightDiffHeightKey[:])
+	if err != nil {
+		return 0, err
+	}
+	p := wrappers.Packer{Bytes: heightBytes}
+	height := p.UnpackLong()
+	return height, p.Err
+}
+
+func (vm *VM) putFirstWeightDiffHeight(db database.Database, height uint64) error {
+	p := wrappers.Packer{MaxSize: wrappers.LongLen}
+	p.PackLong(height)
+	if p.Err != nil {
+		return p.Err
+	}
+	return db.Put(firstWeightDiffHeightKey[:], p.Bytes)
+}
+
 // Unmarshal a Block from bytes and initialize it
 // The Block being unmarshaled must have had static type Block when it was marshaled
 // i.e. don't do:
@@ -79,6 +79,7 @@ type APIPrimaryValidator struct {
 	DelegationFee      json.Float32  `json:"delegationFee"`
 	ExactDelegationFee *json.Uint32  `json:"exactDelegationFee,omitempty"`
 	Uptime             *json.Float32 `json:"uptime,omitempty"`
+	RewardEligible     *bool         `json:"rewardEligible,omitempty"`
 	Connected          *bool         `json:"connected,omitempty"`
 	Staked             []APIUTXO     `json:"staked,omitempty"`
 	// The delegators delegating to this validator
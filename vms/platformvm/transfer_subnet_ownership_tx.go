@@ -0,0 +1,170 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/corpetty/avalanchego/codec"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/vms/components/avax"
+	"github.com/corpetty/avalanchego/vms/components/verify"
+	"github.com/corpetty/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	errTransferPrimaryNetwork      = errors.New("can't transfer ownership of the primary network")
+	errTransferSubnetOwnerInactive = errors.New("transferring subnet ownership isn't allowed until apricot phase 1")
+
+	_ UnsignedDecisionTx = &UnsignedTransferSubnetOwnershipTx{}
+)
+
+// UnsignedTransferSubnetOwnershipTx is an unsigned transferSubnetOwnershipTx
+type UnsignedTransferSubnetOwnershipTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the subnet whose ownership is being transferred
+	Subnet ids.ID `serialize:"true" json:"subnet"`
+	// Auth of the current owner of the subnet that is allowing the transfer
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+	// Who will be authorized to manage this subnet
+	Owner verify.Verifiable `serialize:"true" json:"owner"`
+}
+
+// Verify this transaction is well-formed
+func (tx *UnsignedTransferSubnetOwnershipTx) Verify(
+	ctx *snow.Context,
+	c codec.Manager,
+	feeAmount uint64,
+	feeAssetID ids.ID,
+) error {
+	switch {
+	case tx == nil:
+		return errNilTx
+	case tx.syntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return errTransferPrimaryNetwork
+	}
+
+	if err := tx.BaseTx.Verify(ctx, c); err != nil {
+		return err
+	}
+	if err := verify.All(tx.SubnetAuth, tx.Owner); err != nil {
+		return err
+	}
+
+	tx.syntacticallyVerified = true
+	return nil
+}
+
+// SemanticVerify returns nil if [tx] is valid given the state in [db]
+func (tx *UnsignedTransferSubnetOwnershipTx) SemanticVerify(
+	vm *VM,
+	db database.Database,
+	stx *Tx,
+) (
+	func() error,
+	TxError,
+) {
+	// Verify the tx is well-formed
+	if len(stx.Creds) == 0 {
+		return nil, permError{errWrongNumberOfCredentials}
+	}
+	if err := tx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		return nil, permError{err}
+	}
+
+	// Rule change for Apricot phase 1 hardfork
+	chainTime, err := vm.getTimestamp(db)
+	if err != nil {
+		return nil, tempError{fmt.Errorf("couldn't get chain timestamp: %w", err)}
+	}
+	if chainTime.Before(vm.apricotPhase1Time) {
+		return nil, permError{errTransferSubnetOwnerInactive}
+	}
+
+	baseTxCredsLen := len(stx.Creds) - 1
+	baseTxCreds := stx.Creds[:baseTxCredsLen]
+	subnetCred := stx.Creds[baseTxCredsLen]
+
+	// Verify that the transfer is authorized by the current owner
+	owner, timedErr := vm.getSubnetOwner(db, tx.Subnet)
+	if timedErr != nil {
+		return nil, timedErr
+	}
+	if err := vm.fx.VerifyPermission(tx, tx.SubnetAuth, subnetCred, owner); err != nil {
+		return nil, permError{err}
+	}
+
+	// Verify the flowcheck
+	if err := vm.semanticVerifySpend(db, tx, tx.Ins, tx.Outs, baseTxCreds, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		return nil, err
+	}
+
+	txID := tx.ID()
+
+	// Consume the UTXOS
+	if err := vm.consumeInputs(db, tx.Ins); err != nil {
+		return nil, tempError{err}
+	}
+	// Produce the UTXOS
+	if err := vm.produceOutputs(db, txID, tx.Outs); err != nil {
+		return nil, tempError{err}
+	}
+	// Replace the owner of the subnet
+	if err := vm.putSubnetOwner(db, tx.Subnet, tx.Owner); err != nil {
+		return nil, tempError{err}
+	}
+	return nil, nil
+}
+
+// [ownerAddrs] must be unique. They will be sorted by this method.
+func (vm *VM) newTransferSubnetOwnershipTx(
+	subnetID ids.ID, // ID of the subnet whose ownership is being transferred
+	threshold uint32, // [threshold] of [ownerAddrs] needed to manage this subnet
+	ownerAddrs []ids.ShortID, // new control addresses of the subnet
+	keys []*crypto.PrivateKeySECP256K1R, // Keys to use for the transfer
+	changeAddr ids.ShortID, // Address to send change to, if there is any
+) (*Tx, error) {
+	ins, outs, _, signers, err := vm.stake(vm.DB, keys, 0, vm.txFee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := vm.authorize(vm.DB, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Sort control addresses
+	ids.SortShortIDs(ownerAddrs)
+
+	// Create the tx
+	utx := &UnsignedTransferSubnetOwnershipTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    vm.Ctx.NetworkID,
+			BlockchainID: vm.Ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: threshold,
+			Addrs:     ownerAddrs,
+		},
+	}
+	tx := &Tx{UnsignedTx: utx}
+	if err := tx.Sign(vm.codec, signers); err != nil {
+		return nil, err
+	}
+	return tx, utx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID)
+}
@@ -0,0 +1,156 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/corpetty/avalanchego/database/versiondb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/crypto"
+	"github.com/corpetty/avalanchego/vms/secp256k1fx"
+)
+
+func TestTransferSubnetOwnershipTxSyntacticVerify(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	controlKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	newOwners := []ids.ShortID{keys[3].PublicKey().Address()}
+
+	// Case: tx is nil
+	var unsignedTx *UnsignedTransferSubnetOwnershipTx
+	if err := unsignedTx.Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err == nil {
+		t.Fatal("should have errored because tx is nil")
+	}
+
+	// Case: Wrong network ID
+	tx, err := vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwners, controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).NetworkID++
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).syntacticallyVerified = false
+	if err := tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err == nil {
+		t.Fatal("should have errored because the wrong network ID was used")
+	}
+
+	// Case: Primary network
+	tx, err = vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwners, controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).Subnet = constants.PrimaryNetworkID
+	// This tx was syntactically verified when it was created...pretend it wasn't so we don't use cache
+	tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).syntacticallyVerified = false
+	if err := tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != errTransferPrimaryNetwork {
+		t.Fatalf("expected error %q but got %v", errTransferPrimaryNetwork, err)
+	}
+
+	// Case: Invalid new owner
+	if _, err := vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 2, newOwners, controlKeys, ids.ShortEmpty); err == nil {
+		t.Fatal("should have errored because the threshold exceeds the number of owners")
+	}
+
+	// Case: Valid
+	tx, err = vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwners, controlKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.UnsignedTx.(*UnsignedTransferSubnetOwnershipTx).Verify(vm.Ctx, vm.codec, vm.txFee, vm.Ctx.AVAXAssetID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransferSubnetOwnershipTxSemanticVerify(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	oldKeys := []*crypto.PrivateKeySECP256K1R{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]}
+	newKeys := []*crypto.PrivateKeySECP256K1R{keys[3]}
+	newOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[3].PublicKey().Address()},
+	}
+
+	// Case: Signed by keys that don't control the subnet
+	if _, err := vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwner.Addrs, newKeys, ids.ShortEmpty); err == nil {
+		t.Fatal("should have failed because the keys don't control the subnet")
+	}
+
+	oldOwner, txErr := vm.getSubnetOwner(vm.DB, testSubnet1.ID())
+	if txErr != nil {
+		t.Fatal(txErr)
+	}
+
+	// Case: Before apricot phase 1
+	tx, err := vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwner.Addrs, oldKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm.apricotPhase1Time = defaultGenesisTime.Add(time.Second)
+	if _, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, versiondb.New(vm.DB), tx); err == nil {
+		t.Fatal("should have failed because apricot phase 1 isn't active")
+	}
+	vm.apricotPhase1Time = defaultGenesisTime
+
+	// Case: Signed by the current owner
+	db := versiondb.New(vm.DB)
+	if _, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, db, tx); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	owner, err := vm.getSubnetOwner(vm.DB, testSubnet1.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(owner, newOwner) {
+		t.Fatalf("expected owner %v but got %v", newOwner, owner)
+	}
+
+	// Before apricot phase 1 the subnet is owned by the owner it was created
+	// with
+	vm.apricotPhase1Time = defaultGenesisTime.Add(time.Second)
+	owner, err = vm.getSubnetOwner(vm.DB, testSubnet1.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(owner, oldOwner) {
+		t.Fatalf("expected owner %v but got %v", oldOwner, owner)
+	}
+	vm.apricotPhase1Time = defaultGenesisTime
+
+	// The previous owner can no longer manage the subnet
+	if _, err := vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwner.Addrs, oldKeys, ids.ShortEmpty); err == nil {
+		t.Fatal("should have failed because the previous owner no longer controls the subnet")
+	}
+
+	// The new owner can
+	tx, err = vm.newTransferSubnetOwnershipTx(testSubnet1.ID(), 1, newOwner.Addrs, newKeys, ids.ShortEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.UnsignedTx.(UnsignedDecisionTx).SemanticVerify(vm, versiondb.New(vm.DB), tx); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,214 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/constants"
+	"github.com/corpetty/avalanchego/utils/timer"
+)
+
+const (
+	// Number of connected validators that are asked for their view of this
+	// node's uptime in each round of sampling
+	uptimeSampleSize = 10
+
+	// Time between rounds of sampling
+	uptimeSampleFrequency = time.Minute
+)
+
+var errUptimeTooLarge = errors.New("reported uptime is larger than 100%")
+
+// uptimeRequest asks a validator for its view of the requester's uptime
+type uptimeRequest struct{}
+
+// uptimeResponse is a validator's view of the requester's uptime
+type uptimeResponse struct {
+	// Uptime of the requester as a fraction of [PercentDenominator]
+	Uptime uint64 `serialize:"true"`
+}
+
+// uptimeSampler periodically asks a sample of the connected validators for
+// their view of this node's uptime. Since a node is rewarded only if enough
+// validators think it was up, this lets a validator find out whether it will
+// be rewarded before its staking period ends.
+type uptimeSampler struct {
+	vm *VM
+
+	// Fires when it is time for the next round of sampling
+	timer *timer.Timer
+
+	// Used to tag outgoing requests
+	requestID uint32
+
+	// Request ID --> Validators that haven't answered that request yet
+	outstandingRequests map[uint32]ids.ShortSet
+
+	// Validator --> Most recent uptime of this node reported by that validator
+	peerUptimes map[ids.ShortID]float64
+}
+
+// Initialize this sampler. Sampling doesn't start until Start is called.
+func (us *uptimeSampler) Initialize(vm *VM) {
+	us.vm = vm
+	us.outstandingRequests = make(map[uint32]ids.ShortSet)
+	us.peerUptimes = make(map[ids.ShortID]float64)
+
+	us.timer = timer.NewTimer(func() {
+		us.vm.Ctx.Lock.Lock()
+		defer us.vm.Ctx.Lock.Unlock()
+
+		us.sample()
+	})
+	go us.vm.Ctx.Log.RecoverAndPanic(us.timer.Dispatch)
+}
+
+// Start sampling
+func (us *uptimeSampler) Start() { us.timer.SetTimeoutIn(0) }
+
+// sample sends an uptime request to a sample of the connected validators and
+// schedules the next round of sampling. Nothing is sent unless this node is a
+// primary network validator, as only their requests are answered.
+func (us *uptimeSampler) sample() {
+	defer us.timer.SetTimeoutIn(uptimeSampleFrequency)
+
+	if us.vm.appSender == nil {
+		return
+	}
+
+	_, isValidator, err := us.vm.isValidator(us.vm.DB, constants.PrimaryNetworkID, us.vm.Ctx.NodeID)
+	if err != nil {
+		us.vm.Ctx.Log.Error("failed to check whether this node is a validator due to %s", err)
+		return
+	}
+	if !isValidator {
+		return
+	}
+
+	nodeIDs, err := us.vm.sampleConnectedValidators(uptimeSampleSize)
+	if err != nil {
+		us.vm.Ctx.Log.Error("failed to sample validators to request uptime from due to %s", err)
+		return
+	}
+	if nodeIDs.Len() == 0 {
+		return
+	}
+
+	requestBytes, err := us.vm.codec.Marshal(codecVersion, &uptimeRequest{})
+	if err != nil {
+		us.vm.Ctx.Log.Error("failed to marshal uptime request due to %s", err)
+		return
+	}
+
+	// Copy [nodeIDs] so the sender can't modify the set of outstanding
+	// requests
+	outstanding := ids.ShortSet{}
+	outstanding.Union(nodeIDs)
+
+	us.requestID++
+	us.outstandingRequests[us.requestID] = outstanding
+	if err := us.vm.appSender.SendAppRequest(nodeIDs, us.requestID, requestBytes); err != nil {
+		us.vm.Ctx.Log.Error("failed to request uptime due to %s", err)
+	}
+}
+
+// AppRequest responds to [nodeID] with its uptime as observed by this node.
+// Requests from nodes that aren't primary network validators are dropped.
+func (us *uptimeSampler) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	if us.vm.appSender == nil {
+		return nil
+	}
+
+	req := uptimeRequest{}
+	if _, err := us.vm.codec.Unmarshal(request, &req); err != nil {
+		us.vm.Ctx.Log.Debug("dropping request %d from %s that couldn't be parsed due to %s", requestID, nodeID, err)
+		return nil
+	}
+
+	vdr, isValidator, err := us.vm.isValidator(us.vm.DB, constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return err
+	}
+	if !isValidator {
+		us.vm.Ctx.Log.Debug("dropping uptime request %d from %s because it isn't a validator", requestID, nodeID)
+		return nil
+	}
+
+	uptime, err := us.vm.calculateUptime(us.vm.DB, nodeID, vdr.StartTime())
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(uptime) {
+		// The validator only just started validating
+		us.vm.Ctx.Log.Debug("dropping uptime request %d from %s because its uptime isn't known yet", requestID, nodeID)
+		return nil
+	}
+	if uptime > 1 {
+		uptime = 1
+	}
+	responseBytes, err := us.vm.codec.Marshal(codecVersion, &uptimeResponse{
+		Uptime: uint64(uptime * PercentDenominator),
+	})
+	if err != nil {
+		return err
+	}
+	return us.vm.appSender.SendAppResponse(nodeID, requestID, responseBytes)
+}
+
+// AppResponse records the uptime of this node reported by [nodeID]
+func (us *uptimeSampler) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) {
+	if !us.markAnswered(nodeID, requestID) {
+		us.vm.Ctx.Log.Debug("dropping unexpected response %d from %s", requestID, nodeID)
+		return
+	}
+
+	resp := uptimeResponse{}
+	if _, err := us.vm.codec.Unmarshal(response, &resp); err != nil {
+		us.vm.Ctx.Log.Debug("dropping uptime response %d from %s that couldn't be parsed due to %s", requestID, nodeID, err)
+		return
+	}
+	if resp.Uptime > PercentDenominator {
+		us.vm.Ctx.Log.Debug("dropping uptime response %d from %s due to %s", requestID, nodeID, errUptimeTooLarge)
+		return
+	}
+	us.peerUptimes[nodeID] = float64(resp.Uptime) / PercentDenominator
+}
+
+// AppRequestFailed stops waiting for [nodeID] to answer request [requestID]
+func (us *uptimeSampler) AppRequestFailed(nodeID ids.ShortID, requestID uint32) {
+	us.markAnswered(nodeID, requestID)
+}
+
+// markAnswered returns true if [nodeID] was expected to answer [requestID]
+// and stops waiting for it to do so
+func (us *uptimeSampler) markAnswered(nodeID ids.ShortID, requestID uint32) bool {
+	nodeIDs, ok := us.outstandingRequests[requestID]
+	if !ok || !nodeIDs.Contains(nodeID) {
+		return false
+	}
+	nodeIDs.Remove(nodeID)
+	if nodeIDs.Len() == 0 {
+		delete(us.outstandingRequests, requestID)
+	} else {
+		us.outstandingRequests[requestID] = nodeIDs
+	}
+	return true
+}
+
+// Shutdown stops sampling
+func (us *uptimeSampler) Shutdown() {
+	if us.timer == nil {
+		return
+	}
+
+	// There is a potential deadlock if the timer is about to execute a timeout.
+	// So, the lock must be released before stopping the timer.
+	us.vm.Ctx.Lock.Unlock()
+	us.timer.Stop()
+	us.vm.Ctx.Lock.Lock()
+}
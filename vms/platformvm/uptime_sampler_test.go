@@ -0,0 +1,145 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/constants"
+)
+
+func TestUptimeSamplerAppRequest(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+	vm.clock.Set(defaultValidateStartTime.Add(time.Hour))
+
+	var (
+		respondedTo ids.ShortID
+		response    []byte
+	)
+	vm.appSender = &common.SenderTest{
+		T: t,
+		SendAppResponseF: func(nodeID ids.ShortID, requestID uint32, msg []byte) error {
+			respondedTo = nodeID
+			response = msg
+			return nil
+		},
+	}
+
+	request, err := vm.codec.Marshal(codecVersion, &uptimeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests from nodes that aren't validators are dropped
+	if err := vm.AppRequest(ids.GenerateTestShortID(), 1, request); err != nil {
+		t.Fatal(err)
+	}
+	if response != nil {
+		t.Fatal("shouldn't have responded to a node that isn't a validator")
+	}
+
+	// Validators are told their uptime
+	validatorID := keys[0].PublicKey().Address()
+	if err := vm.AppRequest(validatorID, 2, request); err != nil {
+		t.Fatal(err)
+	}
+	if respondedTo != validatorID {
+		t.Fatalf("should have responded to %s", validatorID)
+	}
+	resp := uptimeResponse{}
+	if _, err := vm.codec.Unmarshal(response, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Uptime > PercentDenominator {
+		t.Fatalf("reported uptime %d is larger than %d", resp.Uptime, PercentDenominator)
+	}
+}
+
+func TestGetUptime(t *testing.T) {
+	service := defaultService(t)
+	service.vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := service.vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		service.vm.Ctx.Lock.Unlock()
+	}()
+	vm := service.vm
+	vm.Ctx.NodeID = keys[0].PublicKey().Address()
+	vm.uptimePercentage = .8
+
+	peerID := keys[1].PublicKey().Address()
+	nonValidatorID := ids.GenerateTestShortID()
+	vm.Connected(peerID)
+
+	var (
+		requestedFrom ids.ShortSet
+		requestID     uint32
+	)
+	vm.appSender = &common.SenderTest{
+		T: t,
+		SendAppRequestF: func(nodeIDs ids.ShortSet, reqID uint32, msg []byte) error {
+			requestedFrom = nodeIDs
+			requestID = reqID
+			return nil
+		},
+	}
+
+	// Nodes that aren't validators don't ask for their uptime
+	vm.Ctx.NodeID = nonValidatorID
+	vm.uptimeSampler.sample()
+	if requestedFrom.Len() != 0 {
+		t.Fatalf("shouldn't have requested the uptime of a node that isn't a validator but requested it from %s", requestedFrom)
+	}
+
+	vm.Ctx.NodeID = keys[0].PublicKey().Address()
+	vm.uptimeSampler.sample()
+	if requestedFrom.Len() != 1 || !requestedFrom.Contains(peerID) {
+		t.Fatalf("should have only requested the uptime from %s but requested it from %s", peerID, requestedFrom)
+	}
+
+	response, err := vm.codec.Marshal(codecVersion, &uptimeResponse{Uptime: PercentDenominator / 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unsolicited responses are dropped
+	if err := vm.AppResponse(nonValidatorID, requestID, response); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.AppResponse(peerID, requestID, response); err != nil {
+		t.Fatal(err)
+	}
+
+	reply := GetUptimeReply{}
+	if err := service.GetUptime(nil, nil, &reply); err != nil {
+		t.Fatal(err)
+	}
+	peerIDStr := peerID.PrefixedString(constants.NodeIDPrefix)
+	switch {
+	case len(reply.PeerUptimes) != 1:
+		t.Fatalf("expected 1 peer uptime but got %d", len(reply.PeerUptimes))
+	case reply.PeerUptimes[peerIDStr] != 0.5:
+		t.Fatalf("expected %s to report an uptime of 0.5 but got %f", peerIDStr, reply.PeerUptimes[peerIDStr])
+	case reply.WeightedAveragePeerUptime != 0.5:
+		t.Fatalf("expected weighted uptime of 0.5 but got %f", reply.WeightedAveragePeerUptime)
+	case reply.RewardEligible:
+		t.Fatal("shouldn't be eligible for a reward with an uptime of 0.5")
+	}
+
+	// Nodes that aren't validators can't get their uptime
+	vm.Ctx.NodeID = nonValidatorID
+	if err := service.GetUptime(nil, nil, &reply); err != errNotPrimaryValidator {
+		t.Fatalf("expected error %q but got %v", errNotPrimaryValidator, err)
+	}
+}
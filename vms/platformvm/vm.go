@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/corpetty/avalanchego/api/events"
 	"github.com/corpetty/avalanchego/cache"
 	"github.com/corpetty/avalanchego/chains"
 	"github.com/corpetty/avalanchego/codec"
@@ -27,6 +28,7 @@ import (
 	"github.com/corpetty/avalanchego/utils/formatting"
 	"github.com/corpetty/avalanchego/utils/hashing"
 	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/utils/sampler"
 	"github.com/corpetty/avalanchego/utils/timer"
 	"github.com/corpetty/avalanchego/utils/units"
 	"github.com/corpetty/avalanchego/utils/wrappers"
@@ -80,6 +82,9 @@ var (
 	subnetsKey       = ids.ID{'s', 'u', 'b', 'n', 'e', 't', 's'}
 	currentSupplyKey = ids.ID{'c', 'u', 'r', 'r', 'e', 't', ' ', 's', 'u', 'p', 'p', 'l', 'y'}
 
+	firstWeightDiffHeightKey = ids.ID{'w', 'e', 'i', 'g', 'h', 't', ' ', 'd', 'i', 'f', 'f', ' ', 'h', 'e', 'i', 'g', 'h', 't'}
+	firstRewardStopKeyKey    = ids.ID{'f', 'i', 'r', 's', 't', ' ', 'r', 'e', 'w', 'a', 'r', 'd'}
+
 	errRegisteringType          = errors.New("error registering type with database")
 	errInvalidLastAcceptedBlock = errors.New("last accepted block must be a decision block")
 	errInvalidID                = errors.New("invalid ID")
@@ -90,6 +95,8 @@ var (
 
 	_ block.ChainVM        = &VM{}
 	_ validators.Connector = &VM{}
+	_ events.Decoder       = &VM{}
+	_ snow.ValidatorLookup = &VM{}
 )
 
 // VM implements the snowman.ChainVM interface
@@ -112,6 +119,15 @@ type VM struct {
 
 	mempool Mempool
 
+	// Asks connected validators for their view of this node's uptime
+	uptimeSampler uptimeSampler
+
+	// Sends application level messages to the platform chain on other nodes
+	appSender common.AppSender
+
+	// Bytes of the chain's genesis, which defines the genesis validators
+	genesisBytes []byte
+
 	// Used to create and use keys.
 	factory crypto.FactorySECP256K1R
 
@@ -154,6 +170,9 @@ type VM struct {
 	// Time of the apricot phase 0 rule change
 	apricotPhase0Time time.Time
 
+	// Time of the apricot phase 1 rule change
+	apricotPhase1Time time.Time
+
 	// Contains the IDs of transactions recently dropped because they failed verification.
 	// These txs may be re-issued and put into accepted blocks, so check the database
 	// to see if it was later committed/aborted before reporting that it's dropped.
@@ -175,8 +194,10 @@ func (vm *VM) Initialize(
 	ctx *snow.Context,
 	db database.Database,
 	genesisBytes []byte,
+	_ []byte,
 	msgs chan<- common.Message,
 	_ []*common.Fx,
+	appSender common.AppSender,
 ) error {
 	ctx.Log.Verbo("initializing platform chain")
 	// Initialize the inner VM, which has a lot of boiler-plate logic
@@ -185,6 +206,8 @@ func (vm *VM) Initialize(
 		return err
 	}
 	vm.fx = &secp256k1fx.Fx{}
+	vm.appSender = appSender
+	vm.genesisBytes = genesisBytes
 
 	vm.codec = Codec
 	vm.codecRegistry = linearcodec.NewDefault()
@@ -199,6 +222,7 @@ func (vm *VM) Initialize(
 	vm.registerDBTypes()
 
 	vm.mempool.Initialize(vm)
+	vm.uptimeSampler.Initialize(vm)
 
 	// If the database is empty, create the platform chain anew using
 	// the provided genesis state
@@ -254,6 +278,18 @@ func (vm *VM) Initialize(
 			}
 		}
 
+		// The genesis validators are the changes made by the genesis block
+		if err := vm.commitWeightDiffs(vm.DB, 0); err != nil {
+			return err
+		}
+		if err := vm.putFirstWeightDiffHeight(vm.DB, 0); err != nil {
+			return err
+		}
+		// Every staker's reward UTXOs are indexed
+		if err := vm.putFirstRewardStopKey(vm.DB, []byte{}); err != nil {
+			return err
+		}
+
 		// Persist the subnets that exist at genesis (none do)
 		if err := vm.putSubnets(vm.DB, []*Tx{}); err != nil {
 			return fmt.Errorf("error putting genesis subnets: %v", err)
@@ -305,6 +341,24 @@ func (vm *VM) Initialize(
 		}
 	}
 
+	// Index the accepted blocks by height if this database was created before
+	// the index existed
+	if err := vm.InitializeHeightIndex(); err != nil {
+		return err
+	}
+
+	// Validator weight diffs are only recorded from here on if this database
+	// was created before they were
+	if err := vm.initializeWeightDiffs(); err != nil {
+		return err
+	}
+
+	// Reward UTXOs are only indexed from here on if this database was created
+	// before they were
+	if err := vm.initializeRewardUTXOs(); err != nil {
+		return err
+	}
+
 	vm.currentBlocks = make(map[ids.ID]Block)
 
 	if err := vm.initSubnets(); err != nil {
@@ -467,7 +521,12 @@ func (vm *VM) Bootstrapped() error {
 		vm.DB.Commit(),
 		stopDB.Close(),
 	)
-	return errs.Err
+	if errs.Errored() {
+		return errs.Err
+	}
+
+	vm.uptimeSampler.Start()
+	return nil
 }
 
 // Shutdown this blockchain
@@ -477,6 +536,7 @@ func (vm *VM) Shutdown() error {
 	}
 
 	vm.mempool.Shutdown()
+	vm.uptimeSampler.Shutdown()
 
 	stopPrefix := []byte(fmt.Sprintf("%s%s", constants.PrimaryNetworkID, stopDBPrefix))
 	stopDB := prefixdb.NewNested(stopPrefix, vm.DB)
@@ -580,6 +640,167 @@ func (vm *VM) ParseBlock(bytes []byte) (snowman.Block, error) {
 // GetBlock implements the snowman.ChainVM interface
 func (vm *VM) GetBlock(blkID ids.ID) (snowman.Block, error) { return vm.getBlock(blkID) }
 
+// DecodeEvent implements the events.Decoder interface. The decoded form of a
+// block is the list of transactions it decided. The addresses and assets
+// reported are those of the UTXOs the transactions produced.
+func (vm *VM) DecodeEvent(blkBytes []byte) (*events.Decoded, error) {
+	blk, err := vm.unmarshalBlockFunc(blkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &events.Decoded{}
+	var txs []*Tx
+	switch blk := blk.(type) {
+	case *StandardBlock:
+		txs = blk.Txs
+		for _, tx := range txs {
+			if producer, ok := tx.UnsignedTx.(utxoProducer); ok {
+				decoded.AssetIDs.Union(producer.AssetIDs())
+				decoded.UTXOs = append(decoded.UTXOs, producer.UTXOs()...)
+			}
+		}
+	case *AtomicBlock:
+		txs = []*Tx{&blk.Tx}
+		if producer, ok := blk.Tx.UnsignedTx.(utxoProducer); ok {
+			decoded.AssetIDs.Union(producer.AssetIDs())
+			decoded.UTXOs = producer.UTXOs()
+		}
+	case *Commit, *Abort:
+		// The transaction decided by an option is the one proposed by its
+		// parent
+		parent, ok := blk.Parent().(*ProposalBlock)
+		if !ok {
+			break
+		}
+		txs = []*Tx{&parent.Tx}
+		if producer, ok := parent.Tx.UnsignedTx.(utxoProducer); ok {
+			decoded.AssetIDs.Union(producer.AssetIDs())
+		}
+		_, committed := blk.(*Commit)
+		decoded.UTXOs, err = vm.optionUTXOs(&parent.Tx, committed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decoded.Tx = txs
+	for _, utxo := range decoded.UTXOs {
+		decoded.AssetIDs.Add(utxo.AssetID())
+		addressable, ok := utxo.Out.(avax.Addressable)
+		if !ok {
+			continue
+		}
+		for _, addrBytes := range addressable.Addresses() {
+			if addr, err := ids.ToShortID(addrBytes); err == nil {
+				decoded.Addresses.Add(addr)
+			}
+		}
+	}
+	return decoded, nil
+}
+
+// optionUTXOs returns the UTXOs produced by deciding the proposal [tx] with a
+// commit, if [committed], or an abort
+func (vm *VM) optionUTXOs(tx *Tx, committed bool) ([]*avax.UTXO, error) {
+	switch utx := tx.UnsignedTx.(type) {
+	case *UnsignedAddValidatorTx:
+		// If the validator is aborted, the stake is returned immediately
+		if committed {
+			return utx.UTXOs(), nil
+		}
+		return append(utx.UTXOs(), vm.stakeUTXOs(utx.ID(), len(utx.Outs), utx.Stake)...), nil
+	case *UnsignedAddDelegatorTx:
+		// If the delegator is aborted, the stake is returned immediately
+		if committed {
+			return utx.UTXOs(), nil
+		}
+		return append(utx.UTXOs(), vm.stakeUTXOs(utx.ID(), len(utx.Outs), utx.Stake)...), nil
+	case *UnsignedRewardValidatorTx:
+		// The stake is returned whether or not the staker is rewarded
+		stakerTx, err := vm.getStakerTx(utx.TxID)
+		if err != nil {
+			return nil, err
+		}
+
+		var utxos []*avax.UTXO
+		switch staker := stakerTx.UnsignedTx.(type) {
+		case *UnsignedAddValidatorTx:
+			utxos = vm.stakeUTXOs(utx.TxID, len(staker.Outs), staker.Stake)
+		case *UnsignedAddDelegatorTx:
+			utxos = vm.stakeUTXOs(utx.TxID, len(staker.Outs), staker.Stake)
+		default:
+			return nil, errShouldBeDSValidator
+		}
+		if !committed {
+			return utxos, nil
+		}
+
+		rewardUTXOs, err := vm.getRewardUTXOs(vm.DB, utx.TxID)
+		if err != nil {
+			return nil, err
+		}
+		return append(utxos, rewardUTXOs...), nil
+	case utxoProducer:
+		return utx.UTXOs(), nil
+	default:
+		return nil, nil
+	}
+}
+
+// getStakerTx returns the tx that added the staker [txID]. The txs of genesis
+// validators aren't persisted, so they're found in the genesis.
+func (vm *VM) getStakerTx(txID ids.ID) (*Tx, error) {
+	txBytes, err := vm.getTx(vm.DB, txID)
+	if err == nil {
+		tx := &Tx{}
+		if _, err := vm.codec.Unmarshal(txBytes, tx); err != nil {
+			return nil, fmt.Errorf("couldn't parse staker tx %s: %w", txID, err)
+		}
+		return tx, nil
+	}
+	if err != database.ErrNotFound {
+		return nil, fmt.Errorf("couldn't get staker tx %s: %w", txID, err)
+	}
+
+	genesis := &Genesis{}
+	if _, err := GenesisCodec.Unmarshal(vm.genesisBytes, genesis); err != nil {
+		return nil, err
+	}
+	if err := genesis.Initialize(); err != nil {
+		return nil, err
+	}
+	for _, vdrTx := range genesis.Validators {
+		if vdrTx.ID() == txID {
+			return vdrTx, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't find staker tx %s", txID)
+}
+
+// stakeUTXOs returns the UTXOs that return [stake], the stake of the staker
+// tx [txID] that has [numOuts] outputs, to its owners
+func (vm *VM) stakeUTXOs(txID ids.ID, numOuts int, stake []*avax.TransferableOutput) []*avax.UTXO {
+	utxos := make([]*avax.UTXO, len(stake))
+	for i, out := range stake {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(numOuts + i),
+			},
+			Asset: avax.Asset{ID: vm.Ctx.AVAXAssetID},
+			Out:   out.Output(),
+		}
+	}
+	return utxos
+}
+
+// utxoProducer is implemented by transactions that embed a BaseTx
+type utxoProducer interface {
+	AssetIDs() ids.Set
+	UTXOs() []*avax.UTXO
+}
+
 func (vm *VM) getBlock(blkID ids.ID) (Block, error) {
 	// If block is in memory, return it.
 	if blk, exists := vm.currentBlocks[blkID]; exists {
@@ -630,6 +851,75 @@ func (vm *VM) Connected(vdrID ids.ShortID) {
 	vm.connections[vdrID] = time.Unix(vm.clock.Time().Unix(), 0)
 }
 
+// AppGossip implements the common.VM interface. Peers gossip the txs that were
+// issued to them so that they reach a validator that will put them into a
+// block.
+func (vm *VM) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	if !vm.bootstrapped {
+		// the mempool can't verify txs until the chain is bootstrapped
+		return nil
+	}
+	vm.mempool.AppGossip(nodeID, msg)
+	return nil
+}
+
+// sampleConnectedValidators returns up to [size] primary network validators,
+// other than this node, that this node is currently connected to
+func (vm *VM) sampleConnectedValidators(size int) (ids.ShortSet, error) {
+	nodeIDs := ids.ShortSet{}
+	vdrs, ok := vm.vdrMgr.GetValidators(constants.PrimaryNetworkID)
+	if !ok {
+		return nodeIDs, nil
+	}
+	connectedVdrs := make([]ids.ShortID, 0, len(vm.connections))
+	for nodeID := range vm.connections {
+		if nodeID != vm.Ctx.NodeID && vdrs.Contains(nodeID) {
+			connectedVdrs = append(connectedVdrs, nodeID)
+		}
+	}
+	if len(connectedVdrs) == 0 {
+		return nodeIDs, nil
+	}
+
+	if size > len(connectedVdrs) {
+		size = len(connectedVdrs)
+	}
+	s := sampler.NewUniform()
+	if err := s.Initialize(uint64(len(connectedVdrs))); err != nil {
+		return nil, err
+	}
+	indices, err := s.Sample(size)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		nodeIDs.Add(connectedVdrs[int(index)])
+	}
+	return nodeIDs, nil
+}
+
+// AppRequest implements the common.VM interface. Validators ask each other
+// for their view of the requester's uptime.
+func (vm *VM) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	if !vm.bootstrapped {
+		// uptimes aren't tracked until the chain is bootstrapped
+		return nil
+	}
+	return vm.uptimeSampler.AppRequest(nodeID, requestID, request)
+}
+
+// AppResponse implements the common.VM interface
+func (vm *VM) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	vm.uptimeSampler.AppResponse(nodeID, requestID, response)
+	return nil
+}
+
+// AppRequestFailed implements the common.VM interface
+func (vm *VM) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	vm.uptimeSampler.AppRequestFailed(nodeID, requestID)
+	return nil
+}
+
 // Disconnected implements validators.Connector
 func (vm *VM) Disconnected(vdrID ids.ShortID) {
 	timeConnected, ok := vm.connections[vdrID]
@@ -956,10 +1246,27 @@ func (vm *VM) updateVdrMgr(force bool) error {
 }
 
 func (vm *VM) updateVdrSet(subnetID ids.ID) error {
+	weights, err := vm.getCurrentValidatorWeights(vm.DB, subnetID)
+	if err != nil {
+		return err
+	}
+
 	vdrs := validators.NewSet()
+	for nodeID, weight := range weights {
+		if err := vdrs.AddWeight(nodeID, weight); err != nil {
+			return err
+		}
+	}
+	return vm.vdrMgr.Set(subnetID, vdrs)
+}
+
+// getCurrentValidatorWeights returns the weight of each current validator of
+// [subnetID] in [db], including the weight delegated to it
+func (vm *VM) getCurrentValidatorWeights(db database.Database, subnetID ids.ID) (map[ids.ShortID]uint64, error) {
+	weights := make(map[ids.ShortID]uint64)
 
 	stopPrefix := []byte(fmt.Sprintf("%s%s", subnetID, stopDBPrefix))
-	stopDB := prefixdb.NewNested(stopPrefix, vm.DB)
+	stopDB := prefixdb.NewNested(stopPrefix, db)
 	defer stopDB.Close()
 	stopIter := stopDB.NewIterator()
 	defer stopIter.Release()
@@ -969,35 +1276,159 @@ func (vm *VM) updateVdrSet(subnetID ids.ID) error {
 
 		tx := rewardTx{}
 		if _, err := vm.codec.Unmarshal(txBytes, &tx); err != nil {
-			return fmt.Errorf("couldn't unmarshal validator tx: %w", err)
+			return nil, fmt.Errorf("couldn't unmarshal validator tx: %w", err)
 		}
 		if err := tx.Tx.Sign(vm.codec, nil); err != nil {
-			return err
+			return nil, err
 		}
 
-		var err error
+		var validator Validator
 		switch staker := tx.Tx.UnsignedTx.(type) {
 		case *UnsignedAddDelegatorTx:
-			err = vdrs.AddWeight(staker.Validator.NodeID, staker.Validator.Weight())
+			validator = staker.Validator
 		case *UnsignedAddValidatorTx:
-			err = vdrs.AddWeight(staker.Validator.NodeID, staker.Validator.Weight())
+			validator = staker.Validator
 		case *UnsignedAddSubnetValidatorTx:
-			err = vdrs.AddWeight(staker.Validator.NodeID, staker.Validator.Weight())
+			validator = staker.Validator.Validator
 		default:
-			err = fmt.Errorf("expected validator but got %T", tx.Tx.UnsignedTx)
+			return nil, fmt.Errorf("expected validator but got %T", tx.Tx.UnsignedTx)
 		}
+
+		weight, err := safemath.Add64(weights[validator.NodeID], validator.Weight())
 		if err != nil {
-			return err
+			return nil, err
 		}
+		weights[validator.NodeID] = weight
 	}
 
 	errs := wrappers.Errs{}
 	errs.Add(
-		vm.vdrMgr.Set(subnetID, vdrs),
 		stopIter.Error(),
 		stopDB.Close(),
 	)
-	return errs.Err
+	return weights, errs.Err
+}
+
+// initializeWeightDiffs marks the last accepted block as the first block whose
+// validator weight diffs are known, if no such block has been marked yet
+func (vm *VM) initializeWeightDiffs() error {
+	if _, err := vm.getFirstWeightDiffHeight(vm.DB); err != database.ErrNotFound {
+		return err
+	}
+
+	lastAccepted, err := vm.getBlock(vm.LastAccepted())
+	if err != nil {
+		return err
+	}
+	if err := vm.putFirstWeightDiffHeight(vm.DB, lastAccepted.Height()); err != nil {
+		return err
+	}
+	return vm.DB.Commit()
+}
+
+// initializeRewardUTXOs marks the next staker to be removed from the Primary
+// Network as the first staker whose reward UTXOs are known, if no such staker
+// has been marked yet
+func (vm *VM) initializeRewardUTXOs() error {
+	if _, err := vm.getFirstRewardStopKey(vm.DB); err != database.ErrNotFound {
+		return err
+	}
+
+	// If there are no current stakers, every staker that will be removed from
+	// here on has a stop time after the current chain time
+	timestamp, err := vm.getTimestamp(vm.DB)
+	if err != nil {
+		return err
+	}
+	p := wrappers.Packer{MaxSize: wrappers.LongLen}
+	p.PackLong(uint64(timestamp.Unix() + 1))
+	stopKey := p.Bytes
+	if p.Err != nil {
+		return p.Err
+	}
+
+	staker, err := vm.nextStakerStop(vm.DB, constants.PrimaryNetworkID)
+	switch err {
+	case nil:
+		switch unsignedTx := staker.Tx.UnsignedTx.(type) {
+		case *UnsignedAddDelegatorTx:
+			stopKey, err = stakerStopKey(unsignedTx, 0, staker.Tx.ID())
+		case *UnsignedAddValidatorTx:
+			stopKey, err = stakerStopKey(unsignedTx, 2, staker.Tx.ID())
+		default:
+			err = fmt.Errorf("staker is unexpected type %T", staker.Tx.UnsignedTx)
+		}
+		if err != nil {
+			return err
+		}
+	case errNoValidators:
+	default:
+		return err
+	}
+
+	if err := vm.putFirstRewardStopKey(vm.DB, stopKey); err != nil {
+		return err
+	}
+	return vm.DB.Commit()
+}
+
+// GetCurrentHeight returns the height of the last accepted block
+func (vm *VM) GetCurrentHeight() (uint64, error) {
+	lastAccepted, err := vm.getBlock(vm.LastAccepted())
+	if err != nil {
+		return 0, err
+	}
+	return lastAccepted.Height(), nil
+}
+
+// GetValidatorSet returns the weight of each validator of [subnetID] as of the
+// block at [height]. The validator set is reconstructed by undoing, starting
+// from the current validator set, the changes made by each block accepted
+// after [height].
+func (vm *VM) GetValidatorSet(height uint64, subnetID ids.ID) (map[ids.ShortID]uint64, error) {
+	lastAcceptedHeight, err := vm.GetCurrentHeight()
+	if err != nil {
+		return nil, err
+	}
+	if height > lastAcceptedHeight {
+		return nil, errHeightNotAccepted
+	}
+	firstDiffHeight, err := vm.getFirstWeightDiffHeight(vm.DB)
+	if err != nil {
+		return nil, err
+	}
+	if height < firstDiffHeight {
+		return nil, errHeightBeforeDiffIndex
+	}
+
+	weights, err := vm.getCurrentValidatorWeights(vm.DB, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	for diffHeight := lastAcceptedHeight; diffHeight > height; diffHeight-- {
+		diffs, err := vm.getWeightDiffs(vm.DB, diffHeight, subnetID)
+		if err != nil {
+			return nil, err
+		}
+		for _, diff := range diffs {
+			weight := weights[diff.NodeID]
+			if diff.Decrease {
+				weight, err = safemath.Add64(weight, diff.Amount)
+			} else {
+				weight, err = safemath.Sub64(weight, diff.Amount)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if weight == 0 {
+				delete(weights, diff.NodeID)
+			} else {
+				weights[diff.NodeID] = weight
+			}
+		}
+	}
+	return weights, nil
 }
 
 // Codec ...
@@ -1126,6 +1557,24 @@ func (vm *VM) FormatAddress(chainID ids.ID, addr ids.ShortID) (string, error) {
 }
 
 func (vm *VM) calculateUptime(db database.Database, nodeID ids.ShortID, startTime time.Time) (float64, error) {
+	timeConnected, isConnected := vm.connections[nodeID]
+	return vm.calculateUptimeSince(db, nodeID, startTime, timeConnected, isConnected)
+}
+
+// calculateLocalUptime returns the uptime of this node since [startTime] as
+// observed by this node. This node considers itself connected since it
+// finished bootstrapping.
+func (vm *VM) calculateLocalUptime(db database.Database, startTime time.Time) (float64, error) {
+	return vm.calculateUptimeSince(db, vm.Ctx.NodeID, startTime, vm.bootstrappedTime, vm.bootstrapped)
+}
+
+func (vm *VM) calculateUptimeSince(
+	db database.Database,
+	nodeID ids.ShortID,
+	startTime time.Time,
+	timeConnected time.Time,
+	isConnected bool,
+) (float64, error) {
 	uptime, err := vm.uptime(db, nodeID)
 	switch {
 	case err == database.ErrNotFound:
@@ -1138,7 +1587,7 @@ func (vm *VM) calculateUptime(db database.Database, nodeID ids.ShortID, startTim
 
 	upDuration := uptime.UpDuration
 	currentLocalTime := vm.clock.Time()
-	if timeConnected, isConnected := vm.connections[nodeID]; isConnected {
+	if isConnected {
 		if timeConnected.Before(vm.bootstrappedTime) {
 			timeConnected = vm.bootstrappedTime
 		}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -319,7 +320,7 @@ func defaultVM() (*VM, database.Database) {
 	ctx.Lock.Lock()
 	defer ctx.Lock.Unlock()
 	_, genesisBytes := defaultGenesis()
-	if err := vm.Initialize(ctx, chainDB, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, chainDB, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		panic(err)
 	}
 	if err := vm.Bootstrapped(); err != nil {
@@ -396,7 +397,7 @@ func GenesisVMWithArgs(t *testing.T, args *BuildGenesisArgs) ([]byte, chan commo
 	ctx.Lock.Lock()
 	defer ctx.Lock.Unlock()
 	// _, genesisBytes := defaultGenesis()
-	if err := vm.Initialize(ctx, chainDB, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, chainDB, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 	if err := vm.Bootstrapped(); err != nil {
@@ -1199,6 +1200,96 @@ func TestRewardValidatorAccept(t *testing.T) {
 	}
 }
 
+// Test that validator sets of past heights are reconstructed from the weight
+// diffs of the blocks accepted since then
+func TestGetValidatorSet(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	genesisVdrs := make(map[ids.ShortID]uint64, len(keys))
+	for _, key := range keys {
+		genesisVdrs[key.PublicKey().Address()] = defaultWeight
+	}
+
+	// acceptProposal builds a proposal block and accepts it with its commit
+	acceptProposal := func() {
+		blk, err := vm.BuildBlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := blk.Verify(); err != nil {
+			t.Fatal(err)
+		}
+		block := blk.(*ProposalBlock)
+		options, err := block.Options()
+		if err != nil {
+			t.Fatal(err)
+		}
+		commit, ok := options[0].(*Commit)
+		if !ok {
+			t.Fatal(errShouldPrefCommit)
+		}
+		if err := block.Accept(); err != nil {
+			t.Fatal(err)
+		}
+		if err := commit.Verify(); err != nil {
+			t.Fatal(err)
+		}
+		if err := commit.Accept(); err != nil {
+			t.Fatal(err)
+		}
+		vm.SetPreference(commit.ID())
+	}
+
+	// Fast forward clock to time for genesis validators to leave
+	vm.clock.Set(defaultValidateEndTime)
+	acceptProposal() // advance the timestamp
+	acceptProposal() // reward a genesis validator
+
+	height, err := vm.GetCurrentHeight()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height != 4 {
+		t.Fatalf("expected height 4 but got %d", height)
+	}
+
+	for _, height := range []uint64{0, 1, 2, 3} {
+		vdrs, err := vm.GetValidatorSet(height, constants.PrimaryNetworkID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(vdrs, genesisVdrs) {
+			t.Fatalf("at height %d expected validators %v but got %v", height, genesisVdrs, vdrs)
+		}
+	}
+
+	vdrs, err := vm.GetValidatorSet(4, constants.PrimaryNetworkID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vdrs) != len(genesisVdrs)-1 {
+		t.Fatalf("expected %d validators but got %d", len(genesisVdrs)-1, len(vdrs))
+	}
+	currentVdrs, err := vm.getCurrentValidatorWeights(vm.DB, constants.PrimaryNetworkID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(vdrs, currentVdrs) {
+		t.Fatalf("expected validators %v but got %v", currentVdrs, vdrs)
+	}
+
+	if _, err := vm.GetValidatorSet(5, constants.PrimaryNetworkID); err != errHeightNotAccepted {
+		t.Fatalf("expected error %q but got %v", errHeightNotAccepted, err)
+	}
+}
+
 // Test case where primary network validator not rewarded
 func TestRewardValidatorReject(t *testing.T) {
 	vm, _ := defaultVM()
@@ -1828,7 +1919,7 @@ func TestRestartPartiallyAccepted(t *testing.T) {
 	firstCtx.Lock.Lock()
 
 	firstMsgChan := make(chan common.Message, 1)
-	if err := firstVM.Initialize(firstCtx, db, genesisBytes, firstMsgChan, nil); err != nil {
+	if err := firstVM.Initialize(firstCtx, db, genesisBytes, nil, firstMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1911,7 +2002,7 @@ func TestRestartPartiallyAccepted(t *testing.T) {
 	}()
 
 	secondMsgChan := make(chan common.Message, 1)
-	if err := secondVM.Initialize(secondCtx, db, genesisBytes, secondMsgChan, nil); err != nil {
+	if err := secondVM.Initialize(secondCtx, db, genesisBytes, nil, secondMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1941,7 +2032,7 @@ func TestRestartFullyAccepted(t *testing.T) {
 	firstCtx.Lock.Lock()
 
 	firstMsgChan := make(chan common.Message, 1)
-	if err := firstVM.Initialize(firstCtx, db, genesisBytes, firstMsgChan, nil); err != nil {
+	if err := firstVM.Initialize(firstCtx, db, genesisBytes, nil, firstMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2038,7 +2129,7 @@ func TestRestartFullyAccepted(t *testing.T) {
 	}()
 
 	secondMsgChan := make(chan common.Message, 1)
-	if err := secondVM.Initialize(secondCtx, db, genesisBytes, secondMsgChan, nil); err != nil {
+	if err := secondVM.Initialize(secondCtx, db, genesisBytes, nil, secondMsgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	} else if lastAccepted := secondVM.LastAccepted(); options[0].ID() != lastAccepted {
 		t.Fatalf("Should have changed the genesis")
@@ -2073,7 +2164,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 	ctx.Lock.Lock()
 
 	msgChan := make(chan common.Message, 1)
-	if err := vm.Initialize(ctx, vmDB, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, vmDB, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2255,7 +2346,7 @@ func TestUnverifiedParent(t *testing.T) {
 	}()
 
 	msgChan := make(chan common.Message, 1)
-	if err := vm.Initialize(ctx, db, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, db, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2526,7 +2617,7 @@ func TestUptimeReporting(t *testing.T) {
 	}()
 
 	msgChan := make(chan common.Message, 1)
-	if err := vm.Initialize(ctx, firstDB, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, firstDB, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2575,7 +2666,7 @@ func TestUptimeReporting(t *testing.T) {
 	vm.vdrMgr = validators.NewManager()
 	restartDB := prefixdb.New([]byte{0}, baseDB)
 
-	if err := vm.Initialize(ctx, restartDB, genesisBytes, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, restartDB, genesisBytes, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2591,3 +2682,213 @@ func TestUptimeReporting(t *testing.T) {
 	checkUptime(vm, nodeID1, .75, "peer connected after bootstrapping after restart")
 	checkUptime(vm, nodeID2, .75, "peer connected during bootstrapping and disconnected after bootstrapping after restart")
 }
+
+func TestDecodeEvent(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	changeAddr := keys[0].PublicKey().Address()
+	exportTx, err := vm.newExportTx(
+		units.MilliAvax,                         // amount
+		vm.Ctx.XChainID,                         // destination chain
+		keys[1].PublicKey().Address(),           // recipient
+		[]*crypto.PrivateKeySECP256K1R{keys[0]}, // payer
+		changeAddr,                              // change addr
+	)
+	if err != nil {
+		t.Fatal(err)
+	} else if err := vm.mempool.IssueTx(exportTx); err != nil {
+		t.Fatal(err)
+	}
+	blk, err := vm.BuildBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := vm.DecodeEvent(blk.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	txs, ok := decoded.Tx.([]*Tx)
+	if !ok || len(txs) != 1 || txs[0].ID() != exportTx.ID() {
+		t.Fatalf("decoded the wrong transactions")
+	}
+	if len(decoded.UTXOs) == 0 {
+		t.Fatalf("should have decoded the change UTXO")
+	}
+	if !decoded.Addresses.Contains(changeAddr) {
+		t.Fatalf("should have decoded the change address")
+	}
+	if !decoded.AssetIDs.Contains(vm.Ctx.AVAXAssetID) {
+		t.Fatalf("should have decoded the AVAX asset")
+	}
+}
+
+// Test that the UTXOs decoded from an option are the ones it produced
+func TestDecodeEventOptions(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	startTime := defaultGenesisTime.Add(syncBound).Add(1 * time.Second)
+	endTime := startTime.Add(defaultMinStakingDuration)
+	nodeID := ids.GenerateTestShortID()
+	changeAddr := keys[0].PublicKey().Address()
+	tx, err := vm.newAddValidatorTx(
+		vm.minValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(endTime.Unix()),
+		nodeID,
+		nodeID,
+		PercentDenominator,
+		[]*crypto.PrivateKeySECP256K1R{keys[0]},
+		changeAddr,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.mempool.IssueTx(tx); err != nil {
+		t.Fatal(err)
+	}
+	blk, err := vm.BuildBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	options, err := blk.(*ProposalBlock).Options()
+	if err != nil {
+		t.Fatal(err)
+	}
+	utx := tx.UnsignedTx.(*UnsignedAddValidatorTx)
+
+	// If the validator is committed, only the change is returned
+	decoded, err := vm.DecodeEvent(options[0].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.UTXOs) != len(utx.Outs) {
+		t.Fatalf("expected %d UTXOs from the commit but got %d", len(utx.Outs), len(decoded.UTXOs))
+	}
+
+	// If the validator is aborted, the stake is returned as well
+	decoded, err = vm.DecodeEvent(options[1].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := len(utx.Outs) + len(utx.Stake); len(decoded.UTXOs) != expected {
+		t.Fatalf("expected %d UTXOs from the abort but got %d", expected, len(decoded.UTXOs))
+	}
+	for i, out := range utx.Stake {
+		utxo := decoded.UTXOs[len(utx.Outs)+i]
+		if utxo.TxID != tx.ID() || utxo.OutputIndex != uint32(len(utx.Outs)+i) {
+			t.Fatalf("stake UTXO %d has the wrong ID", i)
+		}
+		if !reflect.DeepEqual(utxo.Out, out.Output()) {
+			t.Fatalf("stake UTXO %d has the wrong output", i)
+		}
+	}
+	if !decoded.Addresses.Contains(changeAddr) {
+		t.Fatalf("should have decoded the address the stake is returned to")
+	}
+}
+
+// Test that the UTXOs decoded from the options of a reward validator tx include
+// the returned stake and the reward
+func TestDecodeEventReward(t *testing.T) {
+	vm, _ := defaultVM()
+	vm.Ctx.Lock.Lock()
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+		vm.Ctx.Lock.Unlock()
+	}()
+
+	// Fast forward clock to time for genesis validators to leave
+	vm.clock.Set(defaultValidateEndTime)
+
+	acceptCommit := func() *ProposalBlock {
+		blk, err := vm.BuildBlock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := blk.Verify(); err != nil {
+			t.Fatal(err)
+		}
+		block := blk.(*ProposalBlock)
+		options, err := block.Options()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := block.Accept(); err != nil {
+			t.Fatal(err)
+		}
+		if err := options[0].Verify(); err != nil {
+			t.Fatal(err)
+		}
+		if err := options[0].Accept(); err != nil {
+			t.Fatal(err)
+		}
+		return block
+	}
+	acceptCommit() // advance the timestamp
+	block := acceptCommit()
+
+	rewardTx, ok := block.Tx.UnsignedTx.(*UnsignedRewardValidatorTx)
+	if !ok {
+		t.Fatalf("expected a reward validator tx but got %T", block.Tx.UnsignedTx)
+	}
+	stakerTx, err := vm.getStakerTx(rewardTx.TxID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	numStake := len(stakerTx.UnsignedTx.(*UnsignedAddValidatorTx).Stake)
+	rewardUTXOs, err := vm.getRewardUTXOs(vm.DB, rewardTx.TxID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rewardUTXOs) == 0 {
+		t.Fatal("genesis validator should have been rewarded")
+	}
+
+	options, err := block.Options()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// If the validator is rewarded, both the stake and the reward are paid out
+	decoded, err := vm.DecodeEvent(options[0].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := numStake + len(rewardUTXOs); len(decoded.UTXOs) != expected {
+		t.Fatalf("expected %d UTXOs from the commit but got %d", expected, len(decoded.UTXOs))
+	}
+	for _, utxo := range decoded.UTXOs {
+		if utxo.TxID != rewardTx.TxID {
+			t.Fatalf("expected UTXOs to be produced by the staker tx %s but got %s", rewardTx.TxID, utxo.TxID)
+		}
+	}
+
+	// If the validator isn't rewarded, only the stake is returned
+	decoded, err = vm.DecodeEvent(options[1].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.UTXOs) != numStake {
+		t.Fatalf("expected %d UTXOs from the abort but got %d", numStake, len(decoded.UTXOs))
+	}
+}
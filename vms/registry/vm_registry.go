@@ -0,0 +1,104 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package registry
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/vms"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm"
+)
+
+var (
+	errUnknownVM = errors.New("plugin isn't named after a VM ID or a VM alias")
+
+	_ VMRegistry = &vmRegistry{}
+)
+
+// VMRegistry registers the VMs in the plugin directory with the VM manager
+type VMRegistry interface {
+	// Reload registers every VM in the plugin directory that hasn't been
+	// registered yet. Returns the IDs of the newly registered VMs and, for
+	// each plugin that couldn't be registered, the reason why.
+	Reload() ([]ids.ID, map[string]error, error)
+}
+
+// vmRegistry registers each executable in [pluginDir] as an rpcchainvm. An
+// executable must be named after the ID of its VM or one of the VM's aliases.
+type vmRegistry struct {
+	// Prevents the same plugin from being registered concurrently
+	lock sync.Mutex
+
+	pluginDir string
+	vmManager vms.Manager
+	log       logging.Logger
+}
+
+// NewVMRegistry returns a VMRegistry that registers the plugins in
+// [pluginDir] with [vmManager]
+func NewVMRegistry(pluginDir string, vmManager vms.Manager, log logging.Logger) VMRegistry {
+	return &vmRegistry{
+		pluginDir: pluginDir,
+		vmManager: vmManager,
+		log:       log,
+	}
+}
+
+func (r *vmRegistry) Reload() ([]ids.ID, map[string]error, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	files, err := ioutil.ReadDir(r.pluginDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newVMs := []ids.ID(nil)
+	failedVMs := make(map[string]error)
+	for _, file := range files {
+		// Only executables are plugins
+		if !file.Mode().IsRegular() || file.Mode()&0111 == 0 {
+			continue
+		}
+
+		name := file.Name()
+		vmID, err := r.vmID(name)
+		if err != nil {
+			r.log.Warn("skipping plugin %s: %s", name, err)
+			failedVMs[name] = err
+			continue
+		}
+		if _, err := r.vmManager.GetVMFactory(vmID); err == nil {
+			// This VM is already registered
+			continue
+		}
+
+		r.log.Info("registering plugin %s as VM %s", name, vmID)
+		if err := r.vmManager.RegisterVMFactory(vmID, &rpcchainvm.Factory{
+			Path: filepath.Join(r.pluginDir, name),
+		}); err != nil {
+			r.log.Warn("failed to register plugin %s: %s", name, err)
+			failedVMs[name] = err
+			continue
+		}
+		newVMs = append(newVMs, vmID)
+	}
+	return newVMs, failedVMs, nil
+}
+
+// vmID returns the ID of the VM that the plugin named [name] runs
+func (r *vmRegistry) vmID(name string) (ids.ID, error) {
+	if vmID, err := ids.FromString(name); err == nil {
+		return vmID, nil
+	}
+	if vmID, err := r.vmManager.Lookup(name); err == nil {
+		return vmID, nil
+	}
+	return ids.ID{}, errUnknownVM
+}
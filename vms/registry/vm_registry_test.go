@@ -0,0 +1,113 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package registry
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/vms"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm"
+)
+
+// testManager is a vms.Manager that doesn't start VMs when they are
+// registered
+type testManager struct {
+	ids.Aliaser
+	factories map[ids.ID]vms.VMFactory
+}
+
+func newTestManager() *testManager {
+	m := &testManager{factories: make(map[ids.ID]vms.VMFactory)}
+	m.Initialize()
+	return m
+}
+
+func (m *testManager) GetVMFactory(vmID ids.ID) (vms.VMFactory, error) {
+	if factory, ok := m.factories[vmID]; ok {
+		return factory, nil
+	}
+	return nil, fmt.Errorf("no vm with ID '%v' has been registered", vmID)
+}
+
+func (m *testManager) RegisterVMFactory(vmID ids.ID, factory vms.VMFactory) error {
+	if _, exists := m.factories[vmID]; exists {
+		return fmt.Errorf("a vm with ID '%v' has already been registered", vmID)
+	}
+	m.factories[vmID] = factory
+	return nil
+}
+
+func TestVMRegistryReload(t *testing.T) {
+	pluginDir := t.TempDir()
+
+	idVMID := ids.ID{1}
+	aliasVMID := ids.ID{2}
+	registeredVMID := ids.ID{3}
+
+	files := map[string]os.FileMode{
+		idVMID.String():    0700, // named after its VM ID
+		"aliased":          0700, // named after an alias of its VM
+		"registered":       0700, // VM is already registered
+		"unknown":          0700, // isn't a VM ID or alias
+		ids.ID{4}.String(): 0600, // isn't executable
+	}
+	for name, mode := range files {
+		path := filepath.Join(pluginDir, name)
+		if err := ioutil.WriteFile(path, nil, mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manager := newTestManager()
+	if err := manager.Alias(aliasVMID, "aliased"); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.Alias(registeredVMID, "registered"); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.RegisterVMFactory(registeredVMID, &rpcchainvm.Factory{}); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewVMRegistry(pluginDir, manager, logging.NoLog{})
+	newVMs, failedVMs, err := registry.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newVMSet := ids.Set{}
+	newVMSet.Add(newVMs...)
+	if len(newVMs) != 2 || !newVMSet.Contains(idVMID) || !newVMSet.Contains(aliasVMID) {
+		t.Fatalf("Expected VMs %s and %s to be registered but got %v", idVMID, aliasVMID, newVMs)
+	}
+	if len(failedVMs) != 1 || failedVMs["unknown"] != errUnknownVM {
+		t.Fatalf("Expected only the unknown plugin to fail but got %v", failedVMs)
+	}
+
+	factory, err := manager.GetVMFactory(aliasVMID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path := factory.(*rpcchainvm.Factory).Path; path != filepath.Join(pluginDir, "aliased") {
+		t.Fatalf("Expected plugin path %s but got %s", filepath.Join(pluginDir, "aliased"), path)
+	}
+
+	// Reloading shouldn't register the VMs again
+	newVMs, _, err = registry.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(newVMs) != 0 {
+		t.Fatalf("Expected no VMs to be registered but got %v", newVMs)
+	}
+}
@@ -0,0 +1,86 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package appsender
+
+import (
+	"context"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/appsender/appsenderproto"
+)
+
+var _ common.AppSender = &Client{}
+
+// Client is an implementation of an AppSender that talks over RPC.
+type Client struct {
+	client appsenderproto.AppSenderClient
+}
+
+// NewClient returns a client that is connected to a remote AppSender.
+func NewClient(client appsenderproto.AppSenderClient) *Client {
+	return &Client{client: client}
+}
+
+// SendAppRequest ...
+func (c *Client) SendAppRequest(nodeIDs ids.ShortSet, requestID uint32, request []byte) error {
+	nodeIDsBytes := make([][]byte, nodeIDs.Len())
+	i := 0
+	for nodeID := range nodeIDs {
+		nodeID := nodeID // Prevent overwrite in next iteration
+		nodeIDsBytes[i] = nodeID[:]
+		i++
+	}
+	_, err := c.client.SendAppRequest(
+		context.Background(),
+		&appsenderproto.SendAppRequestMsg{
+			NodeIDs:   nodeIDsBytes,
+			RequestID: requestID,
+			Request:   request,
+		},
+	)
+	return err
+}
+
+// SendAppResponse ...
+func (c *Client) SendAppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	_, err := c.client.SendAppResponse(
+		context.Background(),
+		&appsenderproto.SendAppResponseMsg{
+			NodeID:    nodeID[:],
+			RequestID: requestID,
+			Response:  response,
+		},
+	)
+	return err
+}
+
+// SendAppGossip ...
+func (c *Client) SendAppGossip(msg []byte) error {
+	_, err := c.client.SendAppGossip(
+		context.Background(),
+		&appsenderproto.SendAppGossipMsg{
+			Msg: msg,
+		},
+	)
+	return err
+}
+
+func (c *Client) SendAppGossipSpecific(nodeIDs ids.ShortSet, msg []byte) error {
+	nodeIDsBytes := make([][]byte, nodeIDs.Len())
+	i := 0
+	for nodeID := range nodeIDs {
+		nodeID := nodeID // Prevent overwrite in next iteration
+		nodeIDsBytes[i] = nodeID[:]
+		i++
+	}
+	_, err := c.client.SendAppGossipSpecific(
+		context.Background(),
+		&appsenderproto.SendAppGossipSpecificMsg{
+			NodeIDs: nodeIDsBytes,
+			Msg:     msg,
+		},
+	)
+	return err
+}
@@ -0,0 +1,67 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package appsender
+
+import (
+	"context"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/appsender/appsenderproto"
+)
+
+var _ appsenderproto.AppSenderServer = &Server{}
+
+// Server is an AppSender that is managed over RPC.
+type Server struct {
+	appSender common.AppSender
+}
+
+// NewServer returns a server that forwards calls to [appSender].
+func NewServer(appSender common.AppSender) *Server {
+	return &Server{appSender: appSender}
+}
+
+// SendAppRequest ...
+func (s *Server) SendAppRequest(_ context.Context, req *appsenderproto.SendAppRequestMsg) (*appsenderproto.SendAppRequestResponse, error) {
+	nodeIDs := ids.ShortSet{}
+	for _, nodeIDBytes := range req.NodeIDs {
+		nodeID, err := ids.ToShortID(nodeIDBytes)
+		if err != nil {
+			return nil, err
+		}
+		nodeIDs.Add(nodeID)
+	}
+	err := s.appSender.SendAppRequest(nodeIDs, req.RequestID, req.Request)
+	return &appsenderproto.SendAppRequestResponse{}, err
+}
+
+// SendAppResponse ...
+func (s *Server) SendAppResponse(_ context.Context, req *appsenderproto.SendAppResponseMsg) (*appsenderproto.SendAppResponseResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.appSender.SendAppResponse(nodeID, req.RequestID, req.Response)
+	return &appsenderproto.SendAppResponseResponse{}, err
+}
+
+// SendAppGossip ...
+func (s *Server) SendAppGossip(_ context.Context, req *appsenderproto.SendAppGossipMsg) (*appsenderproto.SendAppGossipResponse, error) {
+	err := s.appSender.SendAppGossip(req.Msg)
+	return &appsenderproto.SendAppGossipResponse{}, err
+}
+
+func (s *Server) SendAppGossipSpecific(_ context.Context, req *appsenderproto.SendAppGossipSpecificMsg) (*appsenderproto.SendAppGossipSpecificResponse, error) {
+	nodeIDs := ids.ShortSet{}
+	for _, nodeIDBytes := range req.NodeIDs {
+		nodeID, err := ids.ToShortID(nodeIDBytes)
+		if err != nil {
+			return nil, err
+		}
+		nodeIDs.Add(nodeID)
+	}
+	err := s.appSender.SendAppGossipSpecific(nodeIDs, req.Msg)
+	return &appsenderproto.SendAppGossipSpecificResponse{}, err
+}
@@ -0,0 +1,519 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/corpetty/avalanchego/cache"
+	"github.com/corpetty/avalanchego/cache/metercacher"
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowstorm"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+	"github.com/corpetty/avalanchego/vms/components/missing"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/grpcutils"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
+)
+
+var (
+	errMissingTxResponse = errors.New("response is missing the tx")
+)
+
+// DAGVMClient is an implementation of DAGVM that talks over RPC.
+type DAGVMClient struct {
+	client vmproto.DAGVMClient
+	broker *plugin.GRPCBroker
+	proc   *plugin.Client
+
+	serverCloser grpcutils.ServerCloser
+	conns        []*grpc.ClientConn
+
+	host hostState
+
+	ctx *snow.Context
+	txs map[ids.ID]*TxClient
+
+	decidedTxs cache.Cacher
+}
+
+// NewDAGClient returns a DAG based vm instance connected to a remote vm
+// instance
+func NewDAGClient(client vmproto.DAGVMClient, broker *plugin.GRPCBroker) *DAGVMClient {
+	return &DAGVMClient{
+		client: client,
+		broker: broker,
+		txs:    make(map[ids.ID]*TxClient),
+	}
+}
+
+// SetProcess ...
+func (vm *DAGVMClient) SetProcess(proc *plugin.Client) {
+	vm.proc = proc
+}
+
+// initializeCaches creates a new [decidedTxs] cache. It wraps the cache in
+// metercacher so that we can get prometheus metrics about its performance.
+func (vm *DAGVMClient) initializeCaches(registerer prometheus.Registerer, namespace string) error {
+	decidedCache, err := metercacher.New(
+		fmt.Sprintf("%s_rpcchainvm_decided_cache", namespace),
+		registerer,
+		&cache.LRU{Size: decidedCacheSize},
+	)
+	if err != nil {
+		return fmt.Errorf("could not initialize decided txs cache: %w", err)
+	}
+	vm.decidedTxs = decidedCache
+
+	return nil
+}
+
+// Initialize ...
+func (vm *DAGVMClient) Initialize(
+	ctx *snow.Context,
+	db database.Database,
+	genesisBytes []byte,
+	configBytes []byte,
+	toEngine chan<- common.Message,
+	fxs []*common.Fx,
+	appSender common.AppSender,
+) error {
+	if len(fxs) != 0 {
+		return errUnsupportedFXs
+	}
+
+	vm.ctx = ctx
+	if err := vm.initializeCaches(ctx.Metrics, ctx.Namespace); err != nil {
+		return err
+	}
+
+	req, err := startHostServers(vm.broker, &vm.serverCloser, ctx, db, toEngine, appSender)
+	if err != nil {
+		return err
+	}
+	req.GenesisBytes = genesisBytes
+	req.ConfigBytes = configBytes
+
+	if _, err := vm.client.Initialize(context.Background(), req); err != nil {
+		return err
+	}
+
+	vm.host.initialize(db, genesisBytes, configBytes, toEngine, appSender)
+	return vm.host.supervise(ctx, vm, vm.proc)
+}
+
+// Bootstrapping ...
+func (vm *DAGVMClient) Bootstrapping() error {
+	req := &vmproto.BootstrappingRequest{}
+	_, err := vm.client.Bootstrapping(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.Bootstrapping(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	vm.host.bootstrapping = true
+	return nil
+}
+
+// Bootstrapped ...
+func (vm *DAGVMClient) Bootstrapped() error {
+	req := &vmproto.BootstrappedRequest{}
+	_, err := vm.client.Bootstrapped(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.Bootstrapped(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	vm.host.bootstrapped = true
+	return nil
+}
+
+// Shutdown ...
+func (vm *DAGVMClient) Shutdown() error {
+	vm.host.stop()
+
+	errs := wrappers.Errs{}
+	_, err := vm.client.Shutdown(context.Background(), &vmproto.ShutdownRequest{})
+	errs.Add(err)
+
+	vm.serverCloser.Stop()
+	for _, conn := range vm.conns {
+		errs.Add(conn.Close())
+	}
+
+	if vm.proc != nil {
+		vm.proc.Kill()
+	}
+	return errs.Err
+}
+
+// CreateHandlers ...
+func (vm *DAGVMClient) CreateHandlers() map[string]*common.HTTPHandler {
+	resp, err := vm.client.CreateHandlers(context.Background(), &vmproto.CreateHandlersRequest{})
+	vm.ctx.Log.AssertNoError(err)
+
+	handlers, err := dialHandlers(vm.broker, resp.Handlers, &vm.conns)
+	vm.ctx.Log.AssertNoError(err)
+	return vm.host.wrapHandlers(handlers)
+}
+
+// Pending ...
+func (vm *DAGVMClient) Pending() []snowstorm.Tx {
+	req := &vmproto.PendingTxsRequest{}
+	resp, err := vm.client.PendingTxs(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.PendingTxs(context.Background(), req)
+	}
+	if err != nil {
+		vm.ctx.Log.Error("failed to get pending txs: %s", err)
+		return nil
+	}
+
+	txs := make([]snowstorm.Tx, 0, len(resp.Txs))
+	for _, tx := range resp.Txs {
+		txClient, err := vm.newTx(tx)
+		if err != nil {
+			vm.ctx.Log.Error("failed to parse pending tx: %s", err)
+			continue
+		}
+		txs = append(txs, txClient)
+	}
+	return txs
+}
+
+// Parse ...
+func (vm *DAGVMClient) Parse(bytes []byte) (snowstorm.Tx, error) {
+	req := &vmproto.ParseTxRequest{
+		Bytes: bytes,
+	}
+	resp, err := vm.client.ParseTx(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.ParseTx(context.Background(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vm.newTx(resp.Tx)
+}
+
+// Get ...
+func (vm *DAGVMClient) Get(id ids.ID) (snowstorm.Tx, error) {
+	if tx, cached := vm.txs[id]; cached {
+		return tx, nil
+	}
+	if txIntf, cached := vm.decidedTxs.Get(id); cached {
+		return txIntf.(*TxClient), nil
+	}
+
+	req := &vmproto.GetTxRequest{
+		Id: id[:],
+	}
+	resp, err := vm.client.GetTx(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.GetTx(context.Background(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vm.newTx(resp.Tx)
+}
+
+// newTx returns the tx described by [tx]. If the tx is already known, the
+// known instance is returned so its status is shared.
+func (vm *DAGVMClient) newTx(tx *vmproto.Tx) (*TxClient, error) {
+	if tx == nil {
+		return nil, errMissingTxResponse
+	}
+
+	id, err := ids.ToID(tx.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if txClient, cached := vm.txs[id]; cached {
+		return txClient, nil
+	}
+	if txIntf, cached := vm.decidedTxs.Get(id); cached {
+		return txIntf.(*TxClient), nil
+	}
+
+	status := choices.Status(tx.Status)
+	if err := status.Valid(); err != nil {
+		return nil, err
+	}
+
+	dependencies := make([]ids.ID, len(tx.Dependencies))
+	for i, depBytes := range tx.Dependencies {
+		dependencies[i], err = ids.ToID(depBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputIDs := make([]ids.ID, len(tx.InputIDs))
+	for i, inputIDBytes := range tx.InputIDs {
+		inputIDs[i], err = ids.ToID(inputIDBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txClient := &TxClient{
+		vm:           vm,
+		id:           id,
+		bytes:        tx.Bytes,
+		status:       status,
+		dependencies: dependencies,
+		inputIDs:     inputIDs,
+	}
+
+	if status.Decided() {
+		vm.decidedTxs.Put(id, txClient)
+	}
+	return txClient, nil
+}
+
+// Health ...
+func (vm *DAGVMClient) Health() (interface{}, error) {
+	if err := vm.host.health(); err != nil {
+		return nil, err
+	}
+	return vm.client.Health(
+		context.Background(),
+		&vmproto.HealthRequest{},
+	)
+}
+
+// AppRequest ...
+func (vm *DAGVMClient) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	req := &vmproto.AppRequestMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+		Request:   request,
+	}
+	_, err := vm.client.AppRequest(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppRequest(context.Background(), req)
+	}
+	return err
+}
+
+// AppResponse ...
+func (vm *DAGVMClient) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	req := &vmproto.AppResponseMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+		Response:  response,
+	}
+	_, err := vm.client.AppResponse(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppResponse(context.Background(), req)
+	}
+	return err
+}
+
+// AppRequestFailed ...
+func (vm *DAGVMClient) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	req := &vmproto.AppRequestFailedMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+	}
+	_, err := vm.client.AppRequestFailed(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppRequestFailed(context.Background(), req)
+	}
+	return err
+}
+
+// AppGossip ...
+func (vm *DAGVMClient) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	req := &vmproto.AppGossipMsg{
+		NodeID: nodeID[:],
+		Msg:    msg,
+	}
+	_, err := vm.client.AppGossip(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppGossip(context.Background(), req)
+	}
+	return err
+}
+
+// resume implements the resumable interface
+func (vm *DAGVMClient) resume(proc *plugin.Client, raw interface{}) error {
+	newVM, ok := raw.(*DAGVMClient)
+	if !ok {
+		return errWrongVM
+	}
+
+	// Release the resources of the exited process. Closing errors are ignored
+	// because the process is already gone.
+	vm.serverCloser.Stop()
+	for _, conn := range vm.conns {
+		_ = conn.Close()
+	}
+	if vm.proc != nil {
+		vm.proc.Kill()
+	}
+
+	vm.client = newVM.client
+	vm.broker = newVM.broker
+	vm.proc = proc
+	vm.serverCloser = grpcutils.ServerCloser{}
+	vm.conns = nil
+
+	req, err := startHostServers(vm.broker, &vm.serverCloser, vm.ctx, vm.host.db, vm.host.toEngine, vm.host.appSender)
+	if err != nil {
+		return err
+	}
+	req.GenesisBytes = vm.host.genesisBytes
+	req.ConfigBytes = vm.host.configBytes
+
+	if _, err := vm.client.Initialize(context.Background(), req); err != nil {
+		return err
+	}
+	if err := vm.host.resume(vm.ctx, vm.client, vm.broker, &vm.conns); err != nil {
+		return err
+	}
+
+	// Verify the txs that were processing, dependencies first, so consensus
+	// on them can continue
+	verified := ids.Set{}
+	for _, tx := range vm.txs {
+		if err := vm.reverify(tx, verified); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reverify verifies the processing tx [tx] and its processing dependencies
+// with the plugin process, unless they are in [verified]
+func (vm *DAGVMClient) reverify(tx *TxClient, verified ids.Set) error {
+	if verified.Contains(tx.id) {
+		return nil
+	}
+	verified.Add(tx.id)
+
+	for _, depID := range tx.dependencies {
+		if dep, ok := vm.txs[depID]; ok {
+			if err := vm.reverify(dep, verified); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := vm.client.ParseTx(context.Background(), &vmproto.ParseTxRequest{
+		Bytes: tx.bytes,
+	}); err != nil {
+		return err
+	}
+	if _, err := vm.client.TxVerify(context.Background(), &vmproto.TxVerifyRequest{
+		Id: tx.id[:],
+	}); err != nil {
+		return fmt.Errorf("couldn't verify processing tx %s: %w", tx.id, err)
+	}
+	return nil
+}
+
+// TxClient is an implementation of Tx that talks over RPC.
+type TxClient struct {
+	vm *DAGVMClient
+
+	id           ids.ID
+	bytes        []byte
+	status       choices.Status
+	dependencies []ids.ID
+	inputIDs     []ids.ID
+}
+
+// ID ...
+func (tx *TxClient) ID() ids.ID { return tx.id }
+
+// Accept ...
+func (tx *TxClient) Accept() error {
+	// The tx is still processing until the plugin accepts it, so that it is
+	// verified again if the plugin process is restarted
+	req := &vmproto.TxAcceptRequest{
+		Id: tx.id[:],
+	}
+	_, err := tx.vm.client.TxAccept(context.Background(), req)
+	if tx.vm.host.retry(err) {
+		_, err = tx.vm.client.TxAccept(context.Background(), req)
+	}
+	delete(tx.vm.txs, tx.id)
+	tx.status = choices.Accepted
+	if err != nil {
+		return err
+	}
+
+	tx.vm.decidedTxs.Put(tx.id, tx)
+	return nil
+}
+
+// Reject ...
+func (tx *TxClient) Reject() error {
+	req := &vmproto.TxRejectRequest{
+		Id: tx.id[:],
+	}
+	_, err := tx.vm.client.TxReject(context.Background(), req)
+	if tx.vm.host.retry(err) {
+		_, err = tx.vm.client.TxReject(context.Background(), req)
+	}
+	delete(tx.vm.txs, tx.id)
+	tx.status = choices.Rejected
+
+	tx.vm.decidedTxs.Put(tx.id, tx)
+	return err
+}
+
+// Status ...
+func (tx *TxClient) Status() choices.Status { return tx.status }
+
+// Dependencies ...
+func (tx *TxClient) Dependencies() []snowstorm.Tx {
+	deps := make([]snowstorm.Tx, len(tx.dependencies))
+	for i, depID := range tx.dependencies {
+		dep, err := tx.vm.Get(depID)
+		if err != nil {
+			dep = &missing.Tx{TxID: depID}
+		}
+		deps[i] = dep
+	}
+	return deps
+}
+
+// InputIDs ...
+func (tx *TxClient) InputIDs() []ids.ID { return tx.inputIDs }
+
+// Verify ...
+func (tx *TxClient) Verify() error {
+	req := &vmproto.TxVerifyRequest{
+		Id: tx.id[:],
+	}
+	_, err := tx.vm.client.TxVerify(context.Background(), req)
+	if tx.vm.host.retry(err) {
+		_, err = tx.vm.client.TxVerify(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+
+	tx.vm.txs[tx.id] = tx
+	return nil
+}
+
+// Bytes ...
+func (tx *TxClient) Bytes() []byte { return tx.bytes }
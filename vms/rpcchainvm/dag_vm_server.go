@@ -0,0 +1,305 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/corpetty/avalanchego/cache"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/consensus/snowstorm"
+	"github.com/corpetty/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/grpcutils"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
+)
+
+const (
+	servedTxsCacheSize = 2048
+)
+
+// DAGVMServer is a DAG based VM that is managed over RPC.
+type DAGVMServer struct {
+	vm     vertex.DAGVM
+	broker *plugin.GRPCBroker
+
+	serverCloser grpcutils.ServerCloser
+	clients      *hostClients
+
+	ctx *snow.Context
+
+	// The host refers to txs by ID once it has been given them, but a VM may
+	// not be able to Get a tx it hasn't persisted yet. So, the txs the host
+	// has verified are held until they are decided, and the other txs given to
+	// the host are cached.
+	txsLock   sync.Mutex
+	txs       map[ids.ID]snowstorm.Tx
+	servedTxs cache.Cacher
+}
+
+// NewDAGServer returns a DAG based vm instance connected to a remote vm
+// instance
+func NewDAGServer(vm vertex.DAGVM, broker *plugin.GRPCBroker) *DAGVMServer {
+	return &DAGVMServer{
+		vm:        vm,
+		broker:    broker,
+		txs:       make(map[ids.ID]snowstorm.Tx),
+		servedTxs: &cache.LRU{Size: servedTxsCacheSize},
+	}
+}
+
+// Initialize ...
+func (vm *DAGVMServer) Initialize(_ context.Context, req *vmproto.InitializeRequest) (*vmproto.InitializeResponse, error) {
+	clients, err := dialHostServers(vm.broker, req)
+	if err != nil {
+		return nil, err
+	}
+
+	vm.ctx = clients.ctx
+	if err := vm.vm.Initialize(vm.ctx, clients.db, req.GenesisBytes, req.ConfigBytes, clients.toEngine, nil, clients.appSender); err != nil {
+		// Ignore errors closing resources to return the original error
+		_ = clients.close()
+		return nil, err
+	}
+
+	vm.clients = clients
+	return &vmproto.InitializeResponse{}, nil
+}
+
+// Bootstrapping ...
+func (vm *DAGVMServer) Bootstrapping(context.Context, *vmproto.BootstrappingRequest) (*vmproto.BootstrappingResponse, error) {
+	return &vmproto.BootstrappingResponse{}, vm.vm.Bootstrapping()
+}
+
+// Bootstrapped ...
+func (vm *DAGVMServer) Bootstrapped(context.Context, *vmproto.BootstrappedRequest) (*vmproto.BootstrappedResponse, error) {
+	vm.ctx.Bootstrapped()
+	return &vmproto.BootstrappedResponse{}, vm.vm.Bootstrapped()
+}
+
+// Shutdown ...
+func (vm *DAGVMServer) Shutdown(context.Context, *vmproto.ShutdownRequest) (*vmproto.ShutdownResponse, error) {
+	if vm.clients == nil {
+		return &vmproto.ShutdownResponse{}, nil
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(vm.vm.Shutdown())
+
+	vm.serverCloser.Stop()
+	errs.Add(vm.clients.close())
+	vm.clients = nil
+
+	return &vmproto.ShutdownResponse{}, errs.Err
+}
+
+// CreateHandlers ...
+func (vm *DAGVMServer) CreateHandlers(_ context.Context, req *vmproto.CreateHandlersRequest) (*vmproto.CreateHandlersResponse, error) {
+	handlers := vm.vm.CreateHandlers()
+	return &vmproto.CreateHandlersResponse{
+		Handlers: serveHandlers(vm.broker, &vm.serverCloser, handlers),
+	}, nil
+}
+
+// PendingTxs ...
+func (vm *DAGVMServer) PendingTxs(context.Context, *vmproto.PendingTxsRequest) (*vmproto.PendingTxsResponse, error) {
+	txs := vm.vm.Pending()
+	resp := &vmproto.PendingTxsResponse{
+		Txs: make([]*vmproto.Tx, len(txs)),
+	}
+	for i, tx := range txs {
+		resp.Txs[i] = vm.serveTx(tx)
+	}
+	return resp, nil
+}
+
+// ParseTx ...
+func (vm *DAGVMServer) ParseTx(_ context.Context, req *vmproto.ParseTxRequest) (*vmproto.ParseTxResponse, error) {
+	tx, err := vm.vm.Parse(req.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.ParseTxResponse{
+		Tx: vm.serveTx(tx),
+	}, nil
+}
+
+// GetTx ...
+func (vm *DAGVMServer) GetTx(_ context.Context, req *vmproto.GetTxRequest) (*vmproto.GetTxResponse, error) {
+	id, err := ids.ToID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := vm.getTx(id)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.GetTxResponse{
+		Tx: vm.serveTx(tx),
+	}, nil
+}
+
+// Health ...
+func (vm *DAGVMServer) Health(_ context.Context, req *vmproto.HealthRequest) (*vmproto.HealthResponse, error) {
+	details, err := vm.vm.Health()
+	if err != nil {
+		return &vmproto.HealthResponse{}, err
+	}
+	return &vmproto.HealthResponse{
+		Details: healthDetails(details),
+	}, nil
+}
+
+// AppRequest ...
+func (vm *DAGVMServer) AppRequest(_ context.Context, req *vmproto.AppRequestMsg) (*vmproto.AppRequestResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppRequestResponse{}, vm.vm.AppRequest(nodeID, req.RequestID, req.Request)
+}
+
+// AppRequestFailed ...
+func (vm *DAGVMServer) AppRequestFailed(_ context.Context, req *vmproto.AppRequestFailedMsg) (*vmproto.AppRequestFailedResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppRequestFailedResponse{}, vm.vm.AppRequestFailed(nodeID, req.RequestID)
+}
+
+// AppResponse ...
+func (vm *DAGVMServer) AppResponse(_ context.Context, req *vmproto.AppResponseMsg) (*vmproto.AppResponseResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppResponseResponse{}, vm.vm.AppResponse(nodeID, req.RequestID, req.Response)
+}
+
+// AppGossip ...
+func (vm *DAGVMServer) AppGossip(_ context.Context, req *vmproto.AppGossipMsg) (*vmproto.AppGossipResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppGossipResponse{}, vm.vm.AppGossip(nodeID, req.Msg)
+}
+
+// TxVerify ...
+func (vm *DAGVMServer) TxVerify(_ context.Context, req *vmproto.TxVerifyRequest) (*vmproto.TxVerifyResponse, error) {
+	id, err := ids.ToID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := vm.getTx(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Verify(); err != nil {
+		return nil, err
+	}
+
+	vm.txsLock.Lock()
+	vm.txs[id] = tx
+	vm.txsLock.Unlock()
+	return &vmproto.TxVerifyResponse{}, nil
+}
+
+// TxAccept ...
+func (vm *DAGVMServer) TxAccept(_ context.Context, req *vmproto.TxAcceptRequest) (*vmproto.TxAcceptResponse, error) {
+	id, err := ids.ToID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := vm.getTx(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Accept(); err != nil {
+		return nil, err
+	}
+	vm.forgetTx(id)
+	return &vmproto.TxAcceptResponse{}, nil
+}
+
+// TxReject ...
+func (vm *DAGVMServer) TxReject(_ context.Context, req *vmproto.TxRejectRequest) (*vmproto.TxRejectResponse, error) {
+	id, err := ids.ToID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := vm.getTx(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Reject(); err != nil {
+		return nil, err
+	}
+	vm.forgetTx(id)
+	return &vmproto.TxRejectResponse{}, nil
+}
+
+// getTx returns the tx with ID [id], preferring the instance that was given to
+// the host
+func (vm *DAGVMServer) getTx(id ids.ID) (snowstorm.Tx, error) {
+	vm.txsLock.Lock()
+	defer vm.txsLock.Unlock()
+
+	if tx, ok := vm.txs[id]; ok {
+		return tx, nil
+	}
+	if tx, ok := vm.servedTxs.Get(id); ok {
+		return tx.(snowstorm.Tx), nil
+	}
+	return vm.vm.Get(id)
+}
+
+// serveTx remembers [tx] and returns it in the form it is sent to the host
+func (vm *DAGVMServer) serveTx(tx snowstorm.Tx) *vmproto.Tx {
+	txID := tx.ID()
+	status := tx.Status()
+	if !status.Decided() {
+		vm.txsLock.Lock()
+		if _, ok := vm.txs[txID]; !ok {
+			vm.servedTxs.Put(txID, tx)
+		}
+		vm.txsLock.Unlock()
+	}
+
+	deps := tx.Dependencies()
+	depIDs := make([][]byte, len(deps))
+	for i, dep := range deps {
+		depID := dep.ID()
+		depIDs[i] = depID[:]
+	}
+
+	inputIDs := tx.InputIDs()
+	inputIDBytes := make([][]byte, len(inputIDs))
+	for i, inputID := range inputIDs {
+		inputID := inputID
+		inputIDBytes[i] = inputID[:]
+	}
+
+	return &vmproto.Tx{
+		Id:           txID[:],
+		Bytes:        tx.Bytes(),
+		Status:       uint32(status),
+		Dependencies: depIDs,
+		InputIDs:     inputIDBytes,
+	}
+}
+
+// forgetTx stops holding the tx with ID [id] once it has been decided
+func (vm *DAGVMServer) forgetTx(id ids.ID) {
+	vm.txsLock.Lock()
+	defer vm.txsLock.Unlock()
+
+	delete(vm.txs, id)
+	vm.servedTxs.Evict(id)
+}
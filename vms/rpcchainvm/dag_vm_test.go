@@ -0,0 +1,207 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowstorm"
+	"github.com/corpetty/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+)
+
+// newTestDAGVM serves [vm] as a plugin in process and returns the host's
+// client for it
+func newTestDAGVM(t *testing.T, vm vertex.DAGVM) *DAGVMClient {
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		"vm": NewDAG(vm),
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	raw, err := client.Dispense("vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vmClient, ok := raw.(*DAGVMClient)
+	if !ok {
+		t.Fatalf("expected a *DAGVMClient but got %T", raw)
+	}
+	return vmClient
+}
+
+func TestDAGVMInitialize(t *testing.T) {
+	genesis := []byte{1, 2, 3}
+	config := []byte{4, 5, 6}
+	ctx := snow.DefaultContextTest()
+	ctx.NetworkID = 5
+	ctx.ChainID = ids.ID{1}
+
+	vm := &vertex.TestVM{}
+	vm.T = t
+	initialized := false
+	vm.InitializeF = func(pluginCtx *snow.Context, db database.Database, genesisBytes, configBytes []byte, _ chan<- common.Message, _ []*common.Fx, _ common.AppSender) error {
+		initialized = true
+		switch {
+		case pluginCtx.NetworkID != ctx.NetworkID:
+			t.Fatalf("plugin got network ID %d, expected %d", pluginCtx.NetworkID, ctx.NetworkID)
+		case pluginCtx.ChainID != ctx.ChainID:
+			t.Fatalf("plugin got chain ID %s, expected %s", pluginCtx.ChainID, ctx.ChainID)
+		case !bytes.Equal(genesisBytes, genesis):
+			t.Fatalf("plugin got genesis %v, expected %v", genesisBytes, genesis)
+		case !bytes.Equal(configBytes, config):
+			t.Fatalf("plugin got config %v, expected %v", configBytes, config)
+		}
+		// The plugin's database should be the host's database
+		return db.Put([]byte("key"), []byte("value"))
+	}
+	vm.ShutdownF = func() error { return nil }
+
+	client := newTestDAGVM(t, vm)
+	db := memdb.New()
+	if err := client.Initialize(ctx, db, genesis, config, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !initialized {
+		t.Fatalf("plugin wasn't initialized")
+	}
+	if value, err := db.Get([]byte("key")); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(value, []byte("value")) {
+		t.Fatalf("plugin wrote %v, expected %v", value, []byte("value"))
+	}
+
+	if err := client.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDAGVMTxs(t *testing.T) {
+	dep := &snowstorm.TestTx{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+	tx := &snowstorm.TestTx{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{2},
+			StatusV: choices.Processing,
+		},
+		DependenciesV: []snowstorm.Tx{dep},
+		InputIDsV:     []ids.ID{{3}, {4}},
+		BytesV:        []byte{2},
+	}
+	conflict := &snowstorm.TestTx{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{5},
+			StatusV: choices.Processing,
+		},
+		InputIDsV: []ids.ID{{3}},
+		BytesV:    []byte{5},
+	}
+	errUnknownTx := errors.New("unknown tx")
+
+	vm := &vertex.TestVM{}
+	vm.T = t
+	vm.InitializeF = func(*snow.Context, database.Database, []byte, []byte, chan<- common.Message, []*common.Fx, common.AppSender) error {
+		return nil
+	}
+	vm.ShutdownF = func() error { return nil }
+	vm.PendingF = func() []snowstorm.Tx { return []snowstorm.Tx{conflict} }
+	vm.ParseF = func(b []byte) (snowstorm.Tx, error) {
+		if bytes.Equal(b, tx.Bytes()) {
+			return tx, nil
+		}
+		return nil, errUnknownTx
+	}
+	vm.GetF = func(txID ids.ID) (snowstorm.Tx, error) {
+		if txID == dep.ID() {
+			return dep, nil
+		}
+		return nil, errUnknownTx
+	}
+
+	client := newTestDAGVM(t, vm)
+	if err := client.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := client.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	parsed, err := client.Parse(tx.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case parsed.ID() != tx.ID():
+		t.Fatalf("parsed tx %s, expected %s", parsed.ID(), tx.ID())
+	case parsed.Status() != choices.Processing:
+		t.Fatalf("parsed tx has status %s, expected %s", parsed.Status(), choices.Processing)
+	case !bytes.Equal(parsed.Bytes(), tx.Bytes()):
+		t.Fatalf("parsed tx has bytes %v, expected %v", parsed.Bytes(), tx.Bytes())
+	case len(parsed.InputIDs()) != 2 || parsed.InputIDs()[0] != tx.InputIDsV[0] || parsed.InputIDs()[1] != tx.InputIDsV[1]:
+		t.Fatalf("parsed tx has inputs %v, expected %v", parsed.InputIDs(), tx.InputIDsV)
+	}
+	if _, err := client.Parse([]byte{0}); err == nil {
+		t.Fatalf("should have failed to parse unknown tx")
+	}
+
+	deps := parsed.Dependencies()
+	switch {
+	case len(deps) != 1:
+		t.Fatalf("parsed tx has %d dependencies, expected 1", len(deps))
+	case deps[0].ID() != dep.ID():
+		t.Fatalf("parsed tx depends on %s, expected %s", deps[0].ID(), dep.ID())
+	case deps[0].Status() != choices.Accepted:
+		t.Fatalf("dependency has status %s, expected %s", deps[0].Status(), choices.Accepted)
+	}
+
+	// The plugin should be able to find txs it has given to the host, even
+	// if it can't Get them
+	if err := parsed.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := client.Get(tx.ID()); err != nil {
+		t.Fatal(err)
+	} else if got != parsed {
+		t.Fatalf("processing tx should be shared")
+	}
+	if err := parsed.Accept(); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Status() != choices.Accepted {
+		t.Fatalf("plugin's tx has status %s, expected %s", tx.Status(), choices.Accepted)
+	}
+	if parsed.Status() != choices.Accepted {
+		t.Fatalf("host's tx has status %s, expected %s", parsed.Status(), choices.Accepted)
+	}
+
+	pending := client.Pending()
+	if len(pending) != 1 || pending[0].ID() != conflict.ID() {
+		t.Fatalf("got pending txs %v, expected only %s", pending, conflict.ID())
+	}
+	if err := pending[0].Reject(); err != nil {
+		t.Fatal(err)
+	}
+	if conflict.Status() != choices.Rejected {
+		t.Fatalf("plugin's tx has status %s, expected %s", conflict.Status(), choices.Rejected)
+	}
+
+	if _, err := client.Get(ids.ID{6}); err == nil {
+		t.Fatalf("should have failed to get unknown tx")
+	}
+}
@@ -27,13 +27,40 @@ type Factory struct {
 
 // New ...
 func (f *Factory) New(ctx *snow.Context) (interface{}, error) {
+	client, raw, err := f.launch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch vm := raw.(type) {
+	case *VMClient:
+		vm.SetProcess(client)
+		vm.ctx = ctx
+		vm.host.factory = f
+		return vm, nil
+	case *DAGVMClient:
+		vm.SetProcess(client)
+		vm.ctx = ctx
+		vm.host.factory = f
+		return vm, nil
+	default:
+		client.Kill()
+		return nil, errWrongVM
+	}
+}
+
+// launch starts a plugin process and returns the VM it serves
+func (f *Factory) launch(ctx *snow.Context) (*plugin.Client, interface{}, error) {
 	// Ignore warning from launching an executable with a variable command
 	// because the command is a controlled and required input
 	// #nosec G204
 	config := &plugin.ClientConfig{
 		HandshakeConfig: Handshake,
-		Plugins:         PluginMap,
-		Cmd:             exec.Command(f.Path, fmt.Sprintf("--config=%s", f.Config)),
+		VersionedPlugins: map[int]plugin.PluginSet{
+			int(Handshake.ProtocolVersion):    PluginMap,
+			int(DAGHandshake.ProtocolVersion): DAGPluginMap,
+		},
+		Cmd: exec.Command(f.Path, fmt.Sprintf("--config=%s", f.Config)),
 		AllowedProtocols: []plugin.Protocol{
 			plugin.ProtocolNetRPC,
 			plugin.ProtocolGRPC,
@@ -58,22 +85,13 @@ func (f *Factory) New(ctx *snow.Context) (interface{}, error) {
 	rpcClient, err := client.Client()
 	if err != nil {
 		client.Kill()
-		return nil, err
+		return nil, nil, err
 	}
 
 	raw, err := rpcClient.Dispense("vm")
 	if err != nil {
 		client.Kill()
-		return nil, err
-	}
-
-	vm, ok := raw.(*VMClient)
-	if !ok {
-		client.Kill()
-		return nil, errWrongVM
+		return nil, nil, err
 	}
-
-	vm.SetProcess(client)
-	vm.ctx = ctx
-	return vm, nil
+	return client, raw, nil
 }
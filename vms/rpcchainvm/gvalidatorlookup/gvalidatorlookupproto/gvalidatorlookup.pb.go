@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0-devel
+// 	protoc        v3.6.1
+// source: gvalidatorlookup.proto
+
+package gvalidatorlookupproto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetCurrentHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCurrentHeightRequest) Reset() {
+	*x = GetCurrentHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gvalidatorlookup_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentHeightRequest) ProtoMessage() {}
+
+func (x *GetCurrentHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gvalidatorlookup_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentHeightRequest) Descriptor() ([]byte, []int) {
+	return file_gvalidatorlookup_proto_rawDescGZIP(), []int{0}
+}
+
+type GetCurrentHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GetCurrentHeightResponse) Reset() {
+	*x = GetCurrentHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gvalidatorlookup_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentHeightResponse) ProtoMessage() {}
+
+func (x *GetCurrentHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gvalidatorlookup_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentHeightResponse) Descriptor() ([]byte, []int) {
+	return file_gvalidatorlookup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCurrentHeightResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetValidatorSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SubnetID []byte `protobuf:"bytes,2,opt,name=subnetID,proto3" json:"subnetID,omitempty"`
+}
+
+func (x *GetValidatorSetRequest) Reset() {
+	*x = GetValidatorSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gvalidatorlookup_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetRequest) ProtoMessage() {}
+
+func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gvalidatorlookup_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return file_gvalidatorlookup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetValidatorSetRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetValidatorSetRequest) GetSubnetID() []byte {
+	if x != nil {
+		return x.SubnetID
+	}
+	return nil
+}
+
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID []byte `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gvalidatorlookup_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_gvalidatorlookup_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_gvalidatorlookup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Validator) GetNodeID() []byte {
+	if x != nil {
+		return x.NodeID
+	}
+	return nil
+}
+
+func (x *Validator) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GetValidatorSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *GetValidatorSetResponse) Reset() {
+	*x = GetValidatorSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gvalidatorlookup_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetResponse) ProtoMessage() {}
+
+func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gvalidatorlookup_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return file_gvalidatorlookup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetValidatorSetResponse) GetValidators() []*Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+var File_gvalidatorlookup_proto protoreflect.FileDescriptor
+
+var file_gvalidatorlookup_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4c,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x09,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x32, 0xf8, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x73, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e,
+	0x2e, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x74, 0x12, 0x2d, 0x2e, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gvalidatorlookup_proto_rawDescOnce sync.Once
+	file_gvalidatorlookup_proto_rawDescData = file_gvalidatorlookup_proto_rawDesc
+)
+
+func file_gvalidatorlookup_proto_rawDescGZIP() []byte {
+	file_gvalidatorlookup_proto_rawDescOnce.Do(func() {
+		file_gvalidatorlookup_proto_rawDescData = protoimpl.X.CompressGZIP(file_gvalidatorlookup_proto_rawDescData)
+	})
+	return file_gvalidatorlookup_proto_rawDescData
+}
+
+var file_gvalidatorlookup_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gvalidatorlookup_proto_goTypes = []interface{}{
+	(*GetCurrentHeightRequest)(nil),  // 0: gvalidatorlookupproto.GetCurrentHeightRequest
+	(*GetCurrentHeightResponse)(nil), // 1: gvalidatorlookupproto.GetCurrentHeightResponse
+	(*GetValidatorSetRequest)(nil),   // 2: gvalidatorlookupproto.GetValidatorSetRequest
+	(*Validator)(nil),                // 3: gvalidatorlookupproto.Validator
+	(*GetValidatorSetResponse)(nil),  // 4: gvalidatorlookupproto.GetValidatorSetResponse
+}
+var file_gvalidatorlookup_proto_depIdxs = []int32{
+	3, // 0: gvalidatorlookupproto.GetValidatorSetResponse.validators:type_name -> gvalidatorlookupproto.Validator
+	0, // 1: gvalidatorlookupproto.ValidatorLookup.GetCurrentHeight:input_type -> gvalidatorlookupproto.GetCurrentHeightRequest
+	2, // 2: gvalidatorlookupproto.ValidatorLookup.GetValidatorSet:input_type -> gvalidatorlookupproto.GetValidatorSetRequest
+	1, // 3: gvalidatorlookupproto.ValidatorLookup.GetCurrentHeight:output_type -> gvalidatorlookupproto.GetCurrentHeightResponse
+	4, // 4: gvalidatorlookupproto.ValidatorLookup.GetValidatorSet:output_type -> gvalidatorlookupproto.GetValidatorSetResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gvalidatorlookup_proto_init() }
+func file_gvalidatorlookup_proto_init() {
+	if File_gvalidatorlookup_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gvalidatorlookup_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gvalidatorlookup_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gvalidatorlookup_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gvalidatorlookup_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gvalidatorlookup_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gvalidatorlookup_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gvalidatorlookup_proto_goTypes,
+		DependencyIndexes: file_gvalidatorlookup_proto_depIdxs,
+		MessageInfos:      file_gvalidatorlookup_proto_msgTypes,
+	}.Build()
+	File_gvalidatorlookup_proto = out.File
+	file_gvalidatorlookup_proto_rawDesc = nil
+	file_gvalidatorlookup_proto_goTypes = nil
+	file_gvalidatorlookup_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ValidatorLookupClient is the client API for ValidatorLookup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorLookupClient interface {
+	GetCurrentHeight(ctx context.Context, in *GetCurrentHeightRequest, opts ...grpc.CallOption) (*GetCurrentHeightResponse, error)
+	GetValidatorSet(ctx context.Context, in *GetValidatorSetRequest, opts ...grpc.CallOption) (*GetValidatorSetResponse, error)
+}
+
+type validatorLookupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorLookupClient(cc grpc.ClientConnInterface) ValidatorLookupClient {
+	return &validatorLookupClient{cc}
+}
+
+func (c *validatorLookupClient) GetCurrentHeight(ctx context.Context, in *GetCurrentHeightRequest, opts ...grpc.CallOption) (*GetCurrentHeightResponse, error) {
+	out := new(GetCurrentHeightResponse)
+	err := c.cc.Invoke(ctx, "/gvalidatorlookupproto.ValidatorLookup/GetCurrentHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorLookupClient) GetValidatorSet(ctx context.Context, in *GetValidatorSetRequest, opts ...grpc.CallOption) (*GetValidatorSetResponse, error) {
+	out := new(GetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/gvalidatorlookupproto.ValidatorLookup/GetValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorLookupServer is the server API for ValidatorLookup service.
+type ValidatorLookupServer interface {
+	GetCurrentHeight(context.Context, *GetCurrentHeightRequest) (*GetCurrentHeightResponse, error)
+	GetValidatorSet(context.Context, *GetValidatorSetRequest) (*GetValidatorSetResponse, error)
+}
+
+// UnimplementedValidatorLookupServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorLookupServer struct {
+}
+
+func (*UnimplementedValidatorLookupServer) GetCurrentHeight(context.Context, *GetCurrentHeightRequest) (*GetCurrentHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentHeight not implemented")
+}
+func (*UnimplementedValidatorLookupServer) GetValidatorSet(context.Context, *GetValidatorSetRequest) (*GetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSet not implemented")
+}
+
+func RegisterValidatorLookupServer(s *grpc.Server, srv ValidatorLookupServer) {
+	s.RegisterService(&_ValidatorLookup_serviceDesc, srv)
+}
+
+func _ValidatorLookup_GetCurrentHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorLookupServer).GetCurrentHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gvalidatorlookupproto.ValidatorLookup/GetCurrentHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorLookupServer).GetCurrentHeight(ctx, req.(*GetCurrentHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorLookup_GetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorLookupServer).GetValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gvalidatorlookupproto.ValidatorLookup/GetValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorLookupServer).GetValidatorSet(ctx, req.(*GetValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorLookup_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gvalidatorlookupproto.ValidatorLookup",
+	HandlerType: (*ValidatorLookupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrentHeight",
+			Handler:    _ValidatorLookup_GetCurrentHeight_Handler,
+		},
+		{
+			MethodName: "GetValidatorSet",
+			Handler:    _ValidatorLookup_GetValidatorSet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gvalidatorlookup.proto",
+}
@@ -0,0 +1,57 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gvalidatorlookup
+
+import (
+	"context"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gvalidatorlookup/gvalidatorlookupproto"
+)
+
+var (
+	_ snow.ValidatorLookup = &Client{}
+)
+
+// Client is an implementation of a validator lookup that talks over RPC.
+type Client struct {
+	client gvalidatorlookupproto.ValidatorLookupClient
+}
+
+// NewClient returns a validator lookup instance connected to a remote
+// validator lookup instance
+func NewClient(client gvalidatorlookupproto.ValidatorLookupClient) *Client {
+	return &Client{client: client}
+}
+
+// GetCurrentHeight ...
+func (c *Client) GetCurrentHeight() (uint64, error) {
+	resp, err := c.client.GetCurrentHeight(context.Background(), &gvalidatorlookupproto.GetCurrentHeightRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Height, nil
+}
+
+// GetValidatorSet ...
+func (c *Client) GetValidatorSet(height uint64, subnetID ids.ID) (map[ids.ShortID]uint64, error) {
+	resp, err := c.client.GetValidatorSet(context.Background(), &gvalidatorlookupproto.GetValidatorSetRequest{
+		Height:   height,
+		SubnetID: subnetID[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vdrs := make(map[ids.ShortID]uint64, len(resp.Validators))
+	for _, vdr := range resp.Validators {
+		nodeID, err := ids.ToShortID(vdr.NodeID)
+		if err != nil {
+			return nil, err
+		}
+		vdrs[nodeID] = vdr.Weight
+	}
+	return vdrs, nil
+}
@@ -0,0 +1,64 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gvalidatorlookup
+
+import (
+	"context"
+
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gvalidatorlookup/gvalidatorlookupproto"
+)
+
+// Server is a validator lookup that is managed over RPC.
+type Server struct {
+	lookup snow.ValidatorLookup
+}
+
+// NewServer returns a validator lookup instance connected to a local
+// validator lookup instance
+func NewServer(lookup snow.ValidatorLookup) *Server {
+	return &Server{lookup: lookup}
+}
+
+// GetCurrentHeight ...
+func (s *Server) GetCurrentHeight(
+	_ context.Context,
+	_ *gvalidatorlookupproto.GetCurrentHeightRequest,
+) (*gvalidatorlookupproto.GetCurrentHeightResponse, error) {
+	height, err := s.lookup.GetCurrentHeight()
+	if err != nil {
+		return nil, err
+	}
+	return &gvalidatorlookupproto.GetCurrentHeightResponse{
+		Height: height,
+	}, nil
+}
+
+// GetValidatorSet ...
+func (s *Server) GetValidatorSet(
+	_ context.Context,
+	req *gvalidatorlookupproto.GetValidatorSetRequest,
+) (*gvalidatorlookupproto.GetValidatorSetResponse, error) {
+	subnetID, err := ids.ToID(req.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+	vdrs, err := s.lookup.GetValidatorSet(req.Height, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gvalidatorlookupproto.GetValidatorSetResponse{
+		Validators: make([]*gvalidatorlookupproto.Validator, 0, len(vdrs)),
+	}
+	for nodeID, weight := range vdrs {
+		nodeID := nodeID
+		resp.Validators = append(resp.Validators, &gvalidatorlookupproto.Validator{
+			NodeID: nodeID[:],
+			Weight: weight,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,311 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/rpcdb"
+	"github.com/corpetty/avalanchego/database/rpcdb/rpcdbproto"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/logging"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/appsender"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/appsender/appsenderproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup/galiaslookupproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp/ghttpproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore/gkeystoreproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/grpcutils"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory/gsharedmemoryproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup/gsubnetlookupproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gvalidatorlookup"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/gvalidatorlookup/gvalidatorlookupproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger/messengerproto"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
+)
+
+// startHostServers starts the servers that give a VM plugin access to the
+// node's database, keystore, shared memory, etc. Returns a request to
+// initialize the plugin, which has everything but the genesis bytes set.
+func startHostServers(
+	broker *plugin.GRPCBroker,
+	serverCloser *grpcutils.ServerCloser,
+	ctx *snow.Context,
+	db database.Database,
+	toEngine chan<- common.Message,
+	appSender common.AppSender,
+) (*vmproto.InitializeRequest, error) {
+	epochFirstTransitionBytes, err := ctx.EpochFirstTransition.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	dbServer := rpcdb.NewServer(db)
+	messengerServer := messenger.NewServer(toEngine)
+	keystoreServer := gkeystore.NewServer(ctx.Keystore, broker)
+	sharedMemoryServer := gsharedmemory.NewServer(ctx.SharedMemory, db)
+	bcLookupServer := galiaslookup.NewServer(ctx.BCLookup)
+	snLookupServer := gsubnetlookup.NewServer(ctx.SNLookup)
+	vdrLookupServer := gvalidatorlookup.NewServer(ctx.VDRLookup)
+	appSenderServer := appsender.NewServer(appSender)
+
+	// serve starts a server that [register] registers the services of
+	// and returns the ID the plugin can dial it at
+	serve := func(register func(*grpc.Server)) uint32 {
+		brokerID := broker.NextId()
+		go broker.AcceptAndServe(brokerID, func(opts []grpc.ServerOption) *grpc.Server {
+			server := grpc.NewServer(opts...)
+			serverCloser.Add(server)
+			register(server)
+			return server
+		})
+		return brokerID
+	}
+
+	return &vmproto.InitializeRequest{
+		NetworkID:   ctx.NetworkID,
+		SubnetID:    ctx.SubnetID[:],
+		ChainID:     ctx.ChainID[:],
+		NodeID:      ctx.NodeID.Bytes(),
+		XChainID:    ctx.XChainID[:],
+		AvaxAssetID: ctx.AVAXAssetID[:],
+		DbServer: serve(func(server *grpc.Server) {
+			rpcdbproto.RegisterDatabaseServer(server, dbServer)
+		}),
+		EngineServer: serve(func(server *grpc.Server) {
+			messengerproto.RegisterMessengerServer(server, messengerServer)
+		}),
+		KeystoreServer: serve(func(server *grpc.Server) {
+			gkeystoreproto.RegisterKeystoreServer(server, keystoreServer)
+		}),
+		SharedMemoryServer: serve(func(server *grpc.Server) {
+			gsharedmemoryproto.RegisterSharedMemoryServer(server, sharedMemoryServer)
+		}),
+		BcLookupServer: serve(func(server *grpc.Server) {
+			galiaslookupproto.RegisterAliasLookupServer(server, bcLookupServer)
+		}),
+		SnLookupServer: serve(func(server *grpc.Server) {
+			gsubnetlookupproto.RegisterSubnetLookupServer(server, snLookupServer)
+		}),
+		EpochFirstTransition: epochFirstTransitionBytes,
+		EpochDuration:        uint64(ctx.EpochDuration),
+		AppSenderServer: serve(func(server *grpc.Server) {
+			appsenderproto.RegisterAppSenderServer(server, appSenderServer)
+		}),
+		VdrLookupServer: serve(func(server *grpc.Server) {
+			gvalidatorlookupproto.RegisterValidatorLookupServer(server, vdrLookupServer)
+		}),
+	}, nil
+}
+
+// hostClients are the clients a VM plugin uses to access the servers started
+// by startHostServers
+type hostClients struct {
+	ctx       *snow.Context
+	db        database.Database
+	toEngine  chan common.Message
+	appSender common.AppSender
+
+	// Connections to the node, which must be closed when the VM shuts down
+	conns []*grpc.ClientConn
+}
+
+// dialHostServers connects to the servers described by [req]
+func dialHostServers(broker *plugin.GRPCBroker, req *vmproto.InitializeRequest) (*hostClients, error) {
+	subnetID, err := ids.ToID(req.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := ids.ToID(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	xChainID, err := ids.ToID(req.XChainID)
+	if err != nil {
+		return nil, err
+	}
+	avaxAssetID, err := ids.ToID(req.AvaxAssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	epochFirstTransition := time.Time{}
+	if err := epochFirstTransition.UnmarshalBinary(req.EpochFirstTransition); err != nil {
+		return nil, err
+	}
+
+	clients := &hostClients{}
+	dial := func(brokerID uint32) (*grpc.ClientConn, error) {
+		conn, err := broker.Dial(brokerID)
+		if err != nil {
+			return nil, err
+		}
+		clients.conns = append(clients.conns, conn)
+		return conn, nil
+	}
+
+	brokerIDs := []uint32{
+		req.DbServer,
+		req.EngineServer,
+		req.KeystoreServer,
+		req.SharedMemoryServer,
+		req.BcLookupServer,
+		req.SnLookupServer,
+		req.AppSenderServer,
+		req.VdrLookupServer,
+	}
+	conns := make([]*grpc.ClientConn, len(brokerIDs))
+	for i, brokerID := range brokerIDs {
+		conns[i], err = dial(brokerID)
+		if err != nil {
+			// Ignore closing errors to return the original error
+			_ = clients.close()
+			return nil, err
+		}
+	}
+	dbConn, msgConn, keystoreConn, sharedMemoryConn, bcLookupConn, snLookupConn, appSenderConn, vdrLookupConn :=
+		conns[0], conns[1], conns[2], conns[3], conns[4], conns[5], conns[6], conns[7]
+
+	clients.db = rpcdb.NewClient(rpcdbproto.NewDatabaseClient(dbConn))
+	msgClient := messenger.NewClient(messengerproto.NewMessengerClient(msgConn))
+	keystoreClient := gkeystore.NewClient(gkeystoreproto.NewKeystoreClient(keystoreConn), broker)
+	sharedMemoryClient := gsharedmemory.NewClient(gsharedmemoryproto.NewSharedMemoryClient(sharedMemoryConn))
+	bcLookupClient := galiaslookup.NewClient(galiaslookupproto.NewAliasLookupClient(bcLookupConn))
+	snLookupClient := gsubnetlookup.NewClient(gsubnetlookupproto.NewSubnetLookupClient(snLookupConn))
+	vdrLookupClient := gvalidatorlookup.NewClient(gvalidatorlookupproto.NewValidatorLookupClient(vdrLookupConn))
+	clients.appSender = appsender.NewClient(appsenderproto.NewAppSenderClient(appSenderConn))
+
+	toEngine := make(chan common.Message, 1)
+	go func() {
+		for msg := range toEngine {
+			// Nothing to do with the error within the goroutine
+			_ = msgClient.Notify(msg)
+		}
+	}()
+	clients.toEngine = toEngine
+
+	clients.ctx = &snow.Context{
+		NetworkID:            req.NetworkID,
+		SubnetID:             subnetID,
+		ChainID:              chainID,
+		NodeID:               nodeID,
+		XChainID:             xChainID,
+		AVAXAssetID:          avaxAssetID,
+		Log:                  logging.NoLog{},
+		DecisionDispatcher:   nil,
+		ConsensusDispatcher:  nil,
+		Keystore:             keystoreClient,
+		SharedMemory:         sharedMemoryClient,
+		BCLookup:             bcLookupClient,
+		SNLookup:             snLookupClient,
+		VDRLookup:            vdrLookupClient,
+		EpochFirstTransition: epochFirstTransition,
+		EpochDuration:        time.Duration(req.EpochDuration),
+	}
+	return clients, nil
+}
+
+// close the connections to the node and stop notifying the engine
+func (c *hostClients) close() error {
+	if c.toEngine != nil {
+		close(c.toEngine)
+		c.toEngine = nil
+	}
+	var err error
+	for _, conn := range c.conns {
+		if closeErr := conn.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	c.conns = nil
+	return err
+}
+
+// serveHandlers starts a server for each of [handlers] and returns the
+// handlers as they are described to the node
+func serveHandlers(
+	broker *plugin.GRPCBroker,
+	serverCloser *grpcutils.ServerCloser,
+	handlers map[string]*common.HTTPHandler,
+) []*vmproto.Handler {
+	described := []*vmproto.Handler(nil)
+	for prefix, h := range handlers {
+		handler := h
+
+		// start the http server
+		serverID := broker.NextId()
+		go broker.AcceptAndServe(serverID, func(opts []grpc.ServerOption) *grpc.Server {
+			server := grpc.NewServer(opts...)
+			serverCloser.Add(server)
+			ghttpproto.RegisterHTTPServer(server, ghttp.NewServer(handler.Handler, broker))
+			return server
+		})
+
+		described = append(described, &vmproto.Handler{
+			Prefix:      prefix,
+			LockOptions: uint32(handler.LockOptions),
+			Server:      serverID,
+		})
+	}
+	return described
+}
+
+// dialHandlers connects to the handlers served by serveHandlers. The
+// connections are appended to [conns].
+func dialHandlers(
+	broker *plugin.GRPCBroker,
+	described []*vmproto.Handler,
+	conns *[]*grpc.ClientConn,
+) (map[string]*common.HTTPHandler, error) {
+	handlers := make(map[string]*common.HTTPHandler, len(described))
+	for _, handler := range described {
+		conn, err := broker.Dial(handler.Server)
+		if err != nil {
+			return nil, err
+		}
+
+		*conns = append(*conns, conn)
+		handlers[handler.Prefix] = &common.HTTPHandler{
+			LockOptions: common.LockOption(handler.LockOptions),
+			Handler:     ghttp.NewClient(ghttpproto.NewHTTPClient(conn), broker),
+		}
+	}
+	return handlers, nil
+}
+
+// healthDetails tries to stringify the details of a health check
+func healthDetails(details interface{}) string {
+	detailsStr := "couldn't parse health check details to string"
+	switch details := details.(type) {
+	case nil:
+		detailsStr = ""
+	case string:
+		detailsStr = details
+	case map[string]string:
+		asJSON, err := json.Marshal(details)
+		if err != nil {
+			detailsStr = string(asJSON)
+		}
+	case []byte:
+		detailsStr = string(details)
+	}
+	return detailsStr
+}
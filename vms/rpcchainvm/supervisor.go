@@ -0,0 +1,435 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/utils/wrappers"
+	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
+)
+
+const (
+	// How often the supervisor checks whether the plugin process is running
+	supervisorPollFrequency = time.Second
+
+	// Bounds on how long to wait before restarting the plugin process. The
+	// wait doubles after each failed restart.
+	initialRestartBackoff = time.Second
+	maxRestartBackoff     = time.Minute
+
+	// How long a call into the VM waits for the plugin process to be
+	// restarted before failing
+	restartTimeout = time.Minute
+)
+
+var (
+	errPluginExited  = errors.New("plugin process exited")
+	errPluginStopped = errors.New("plugin process is no longer supervised")
+)
+
+// resumable is a VM client whose plugin process can be replaced
+type resumable interface {
+	// resume switches to [raw], the VM served by the plugin process [proc],
+	// and brings it to the state the exited plugin process was in. Called
+	// with the chain's lock held.
+	resume(proc *plugin.Client, raw interface{}) error
+}
+
+// supervisor restarts a VM's plugin process if it exits before the VM is
+// shut down
+type supervisor struct {
+	factory *Factory
+	ctx     *snow.Context
+	vm      resumable
+
+	restarts, failedRestarts prometheus.Counter
+
+	lock sync.Mutex
+	proc *plugin.Client
+	// The reason the plugin process isn't running, or nil if it is
+	err error
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+func newSupervisor(factory *Factory, ctx *snow.Context, vm resumable, proc *plugin.Client) (*supervisor, error) {
+	s := &supervisor{
+		factory: factory,
+		ctx:     ctx,
+		vm:      vm,
+		restarts: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: ctx.Namespace,
+			Name:      "rpcchainvm_plugin_restarts",
+			Help:      "Number of times the VM plugin process was restarted after exiting",
+		}),
+		failedRestarts: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: ctx.Namespace,
+			Name:      "rpcchainvm_plugin_failed_restarts",
+			Help:      "Number of times the VM plugin process failed to restart",
+		}),
+		proc:    proc,
+		stopped: make(chan struct{}),
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		ctx.Metrics.Register(s.restarts),
+		ctx.Metrics.Register(s.failedRestarts),
+	)
+	return s, errs.Err
+}
+
+// run watches the plugin process until the supervisor is stopped
+func (s *supervisor) run() {
+	ticker := time.NewTicker(supervisorPollFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopped:
+			return
+		case <-ticker.C:
+		}
+
+		if !s.exited() {
+			continue
+		}
+		s.ctx.Log.Error("VM plugin process exited unexpectedly. Restarting it")
+		s.restart()
+	}
+}
+
+// exited returns true if the plugin process has exited
+func (s *supervisor) exited() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.proc.Exited() {
+		return false
+	}
+	if s.err == nil {
+		s.err = errPluginExited
+	}
+	return true
+}
+
+// restart launches plugin processes until one is resumed or the supervisor
+// is stopped
+func (s *supervisor) restart() {
+	backoff := initialRestartBackoff
+	for {
+		select {
+		case <-s.stopped:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+
+		switch err := s.launch(); err {
+		case nil, errPluginStopped:
+			return
+		default:
+			s.restartFailed(err)
+		}
+	}
+}
+
+// launch starts a new plugin process and resumes the VM with it, unless a
+// call into the VM restarted the plugin process in the meantime
+func (s *supervisor) launch() error {
+	proc, raw, err := s.factory.launch(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	s.ctx.Lock.Lock()
+	defer s.ctx.Lock.Unlock()
+
+	if !s.exited() {
+		proc.Kill()
+		return nil
+	}
+	return s.replace(proc, raw)
+}
+
+// restartNow restarts the plugin process so that a call into the VM that
+// failed because the process is unreachable can be retried. Returns false if
+// the plugin process couldn't be restarted within [restartTimeout]. Called
+// with the chain's lock held, so the chain waits for the restart.
+func (s *supervisor) restartNow() bool {
+	s.ctx.Log.Error("VM plugin process is unreachable. Restarting it")
+
+	s.lock.Lock()
+	if s.err == nil {
+		s.err = errPluginExited
+	}
+	s.lock.Unlock()
+
+	deadline := time.Now().Add(restartTimeout)
+	backoff := initialRestartBackoff
+	for {
+		proc, raw, err := s.factory.launch(s.ctx)
+		if err == nil {
+			err = s.replace(proc, raw)
+		}
+		switch err {
+		case nil:
+			return true
+		case errPluginStopped:
+			return false
+		}
+		s.restartFailed(err)
+
+		if time.Now().Add(backoff).After(deadline) {
+			return false
+		}
+		select {
+		case <-s.stopped:
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+	}
+}
+
+// replace resumes the VM with the plugin process [proc], which serves [raw].
+// Called with the chain's lock held.
+func (s *supervisor) replace(proc *plugin.Client, raw interface{}) error {
+	select {
+	case <-s.stopped:
+		// The VM was shut down while the process was starting
+		proc.Kill()
+		return errPluginStopped
+	default:
+	}
+
+	if err := s.vm.resume(proc, raw); err != nil {
+		proc.Kill()
+		return err
+	}
+
+	s.lock.Lock()
+	s.proc = proc
+	s.err = nil
+	s.lock.Unlock()
+
+	s.ctx.Log.Info("restarted VM plugin process")
+	s.restarts.Inc()
+	return nil
+}
+
+// restartFailed records that the plugin process couldn't be restarted
+// because of [err]
+func (s *supervisor) restartFailed(err error) {
+	s.ctx.Log.Error("failed to restart VM plugin process: %s", err)
+	s.failedRestarts.Inc()
+
+	s.lock.Lock()
+	s.err = fmt.Errorf("%w and couldn't be restarted: %s", errPluginExited, err)
+	s.lock.Unlock()
+}
+
+// health returns an error if the plugin process isn't running
+func (s *supervisor) health() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.err
+}
+
+// stop supervising the plugin process. Must be called before the VM is shut
+// down so that the process exiting isn't mistaken for a crash.
+func (s *supervisor) stop() {
+	s.stopOnce.Do(func() { close(s.stopped) })
+}
+
+// pluginClient is the part of the VM and DAGVM protocols that is used to
+// resume a restarted plugin process
+type pluginClient interface {
+	Bootstrapping(context.Context, *vmproto.BootstrappingRequest, ...grpc.CallOption) (*vmproto.BootstrappingResponse, error)
+	Bootstrapped(context.Context, *vmproto.BootstrappedRequest, ...grpc.CallOption) (*vmproto.BootstrappedResponse, error)
+	CreateHandlers(context.Context, *vmproto.CreateHandlersRequest, ...grpc.CallOption) (*vmproto.CreateHandlersResponse, error)
+}
+
+// hostState is what the host has given to a VM plugin, which is given to the
+// plugin process again if it is restarted
+type hostState struct {
+	factory    *Factory
+	supervisor *supervisor
+
+	db           database.Database
+	genesisBytes []byte
+	configBytes  []byte
+	toEngine     chan<- common.Message
+	appSender    common.AppSender
+
+	bootstrapping, bootstrapped bool
+	handlers                    map[string]*pluginHandler
+}
+
+// initialize records the arguments the VM was initialized with
+func (h *hostState) initialize(
+	db database.Database,
+	genesisBytes []byte,
+	configBytes []byte,
+	toEngine chan<- common.Message,
+	appSender common.AppSender,
+) {
+	h.db = db
+	h.genesisBytes = genesisBytes
+	h.configBytes = configBytes
+	h.toEngine = toEngine
+	h.appSender = appSender
+}
+
+// supervise restarts the VM's plugin process [proc] if it exits. Does nothing
+// if the VM wasn't created by a Factory.
+func (h *hostState) supervise(ctx *snow.Context, vm resumable, proc *plugin.Client) error {
+	if h.factory == nil || proc == nil {
+		return nil
+	}
+
+	s, err := newSupervisor(h.factory, ctx, vm, proc)
+	if err != nil {
+		return err
+	}
+	h.supervisor = s
+	go s.run()
+	return nil
+}
+
+// health returns an error if the VM's plugin process isn't running
+func (h *hostState) health() error {
+	if h.supervisor == nil {
+		return nil
+	}
+	return h.supervisor.health()
+}
+
+// retry returns true if a call into the VM's plugin process returned [err]
+// because the process exited or is unreachable, and the process has since been
+// restarted. The call should then be made again. Called with the chain's lock
+// held.
+func (h *hostState) retry(err error) bool {
+	if h.supervisor == nil || err == nil {
+		return false
+	}
+	if status.Code(err) != codes.Unavailable && !h.supervisor.exited() {
+		return false
+	}
+	return h.supervisor.restartNow()
+}
+
+// stop supervising the VM's plugin process
+func (h *hostState) stop() {
+	if h.supervisor != nil {
+		h.supervisor.stop()
+	}
+}
+
+// wrapHandlers returns [handlers] such that they keep working if the plugin
+// process is restarted
+func (h *hostState) wrapHandlers(handlers map[string]*common.HTTPHandler) map[string]*common.HTTPHandler {
+	h.handlers = make(map[string]*pluginHandler, len(handlers))
+	wrapped := make(map[string]*common.HTTPHandler, len(handlers))
+	for prefix, handler := range handlers {
+		pluginHandler := &pluginHandler{handler: handler.Handler}
+		h.handlers[prefix] = pluginHandler
+		wrapped[prefix] = &common.HTTPHandler{
+			LockOptions: handler.LockOptions,
+			Handler:     pluginHandler,
+		}
+	}
+	return wrapped
+}
+
+// resume brings the restarted plugin process, which has been initialized, to
+// the bootstrapping state of the exited process and points the VM's handlers
+// at it
+func (h *hostState) resume(
+	ctx *snow.Context,
+	client pluginClient,
+	broker *plugin.GRPCBroker,
+	conns *[]*grpc.ClientConn,
+) error {
+	if h.bootstrapping {
+		if _, err := client.Bootstrapping(context.Background(), &vmproto.BootstrappingRequest{}); err != nil {
+			return err
+		}
+	}
+	if h.bootstrapped {
+		if _, err := client.Bootstrapped(context.Background(), &vmproto.BootstrappedRequest{}); err != nil {
+			return err
+		}
+	}
+	if h.handlers == nil {
+		return nil
+	}
+
+	resp, err := client.CreateHandlers(context.Background(), &vmproto.CreateHandlersRequest{})
+	if err != nil {
+		return err
+	}
+	handlers, err := dialHandlers(broker, resp.Handlers, conns)
+	if err != nil {
+		return err
+	}
+	for prefix, handler := range handlers {
+		pluginHandler, ok := h.handlers[prefix]
+		if !ok {
+			ctx.Log.Warn("restarted VM plugin process created the new handler %q, which won't be served", prefix)
+			continue
+		}
+		pluginHandler.set(handler.Handler)
+	}
+	return nil
+}
+
+// pluginHandler serves requests with the handler of the current plugin
+// process
+type pluginHandler struct {
+	lock    sync.RWMutex
+	handler http.Handler
+}
+
+// ServeHTTP ...
+func (h *pluginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.lock.RLock()
+	handler := h.handler
+	h.lock.RUnlock()
+
+	handler.ServeHTTP(w, r)
+}
+
+func (h *pluginHandler) set(handler http.Handler) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.handler = handler
+}
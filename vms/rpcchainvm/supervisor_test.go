@@ -0,0 +1,387 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/corpetty/avalanchego/database"
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowman"
+	"github.com/corpetty/avalanchego/snow/consensus/snowstorm"
+	"github.com/corpetty/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
+)
+
+// testPluginEnv is set when the test binary is launched as a VM plugin
+const testPluginEnv = "RPCCHAINVM_TEST_PLUGIN"
+
+var errUnknownBlock = errors.New("unknown block")
+
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		genesis, child := newTestPluginBlocks()
+		plugin.Serve(&plugin.ServeConfig{
+			HandshakeConfig: Handshake,
+			Plugins: map[string]plugin.Plugin{
+				"vm": New(newTestChainVM(nil, genesis, child)),
+			},
+			GRPCServer: plugin.DefaultGRPCServer,
+		})
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// newTestPluginBlocks returns the blocks known to the VM served when the test
+// binary is launched as a VM plugin
+func newTestPluginBlocks() (*snowman.TestBlock, *snowman.TestBlock) {
+	genesis := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+	child := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{2},
+			StatusV: choices.Processing,
+		},
+		ParentV: genesis,
+		HeightV: 1,
+		BytesV:  []byte{2},
+	}
+	return genesis, child
+}
+
+// dispenseTestVM serves [vm] as a plugin in process and returns what the host
+// is given for it
+func dispenseTestVM(t *testing.T, vm plugin.Plugin) interface{} {
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		"vm": vm,
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	raw, err := client.Dispense("vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+// newTestChainVM returns a VM that knows about [blks], where blks[0] is the
+// last accepted block
+func newTestChainVM(t *testing.T, blks ...*snowman.TestBlock) *block.TestVM {
+	vm := &block.TestVM{}
+	vm.T = t
+	vm.InitializeF = func(*snow.Context, database.Database, []byte, []byte, chan<- common.Message, []*common.Fx, common.AppSender) error {
+		return nil
+	}
+	vm.ShutdownF = func() error { return nil }
+	vm.LastAcceptedF = func() ids.ID { return blks[0].ID() }
+	vm.ParseBlockF = func(b []byte) (snowman.Block, error) {
+		for _, blk := range blks {
+			if bytes.Equal(b, blk.Bytes()) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+	vm.GetBlockF = func(blkID ids.ID) (snowman.Block, error) {
+		for _, blk := range blks {
+			if blkID == blk.ID() {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+	return vm
+}
+
+func TestVMClientResume(t *testing.T) {
+	genesis := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+	child := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{2},
+			StatusV: choices.Processing,
+		},
+		ParentV: genesis,
+		HeightV: 1,
+		BytesV:  []byte{2},
+	}
+	grandChild := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{3},
+			StatusV: choices.Processing,
+		},
+		ParentV: child,
+		HeightV: 2,
+		BytesV:  []byte{3},
+	}
+
+	vm := dispenseTestVM(t, New(newTestChainVM(t, genesis, child, grandChild))).(*VMClient)
+	if err := vm.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Bootstrapping(); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Bootstrapped(); err != nil {
+		t.Fatal(err)
+	}
+	for _, blk := range []*snowman.TestBlock{child, grandChild} {
+		parsed, err := vm.ParseBlock(blk.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := parsed.Verify(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vm.SetPreference(grandChild.ID())
+
+	// The restarted plugin has to be told everything the exited plugin was
+	restartedVM := newTestChainVM(t, genesis, child, grandChild)
+	bootstrapped := false
+	restartedVM.BootstrappedF = func() error {
+		bootstrapped = true
+		return nil
+	}
+	verified := []ids.ID(nil)
+	getBlock := restartedVM.GetBlockF
+	restartedVM.GetBlockF = func(blkID ids.ID) (snowman.Block, error) {
+		verified = append(verified, blkID)
+		return getBlock(blkID)
+	}
+	preference := ids.Empty
+	restartedVM.SetPreferenceF = func(blkID ids.ID) { preference = blkID }
+
+	if err := vm.resume(nil, dispenseTestVM(t, New(restartedVM))); err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case !bootstrapped:
+		t.Fatalf("restarted plugin wasn't bootstrapped")
+	case len(verified) != 2 || verified[0] != child.ID() || verified[1] != grandChild.ID():
+		t.Fatalf("restarted plugin verified %v, expected %v", verified, []ids.ID{child.ID(), grandChild.ID()})
+	case preference != grandChild.ID():
+		t.Fatalf("restarted plugin prefers %s, expected %s", preference, grandChild.ID())
+	}
+
+	if err := vm.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVMClientResumeLastAcceptedMismatch(t *testing.T) {
+	genesis := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+	child := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{2},
+			StatusV: choices.Accepted,
+		},
+		ParentV: genesis,
+		HeightV: 1,
+		BytesV:  []byte{2},
+	}
+
+	vm := dispenseTestVM(t, New(newTestChainVM(t, child, genesis))).(*VMClient)
+	if err := vm.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The restarted plugin lost the last accepted block
+	err := vm.resume(nil, dispenseTestVM(t, New(newTestChainVM(t, genesis))))
+	if !errors.Is(err, errLastAcceptedMismatch) {
+		t.Fatalf("expected %s but got %v", errLastAcceptedMismatch, err)
+	}
+}
+
+func TestDAGVMClientResume(t *testing.T) {
+	dep := &snowstorm.TestTx{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Processing,
+		},
+		BytesV: []byte{1},
+	}
+	tx := &snowstorm.TestTx{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{2},
+			StatusV: choices.Processing,
+		},
+		DependenciesV: []snowstorm.Tx{dep},
+		BytesV:        []byte{2},
+	}
+
+	newVM := func() *vertex.TestVM {
+		vm := &vertex.TestVM{}
+		vm.T = t
+		vm.InitializeF = func(*snow.Context, database.Database, []byte, []byte, chan<- common.Message, []*common.Fx, common.AppSender) error {
+			return nil
+		}
+		vm.ShutdownF = func() error { return nil }
+		vm.ParseF = func(b []byte) (snowstorm.Tx, error) {
+			switch {
+			case bytes.Equal(b, dep.Bytes()):
+				return dep, nil
+			case bytes.Equal(b, tx.Bytes()):
+				return tx, nil
+			}
+			return nil, errors.New("unknown tx")
+		}
+		return vm
+	}
+
+	vm := dispenseTestVM(t, NewDAG(newVM())).(*DAGVMClient)
+	if err := vm.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, txBytes := range [][]byte{dep.Bytes(), tx.Bytes()} {
+		parsed, err := vm.Parse(txBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := parsed.Verify(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	restartedVM := newVM()
+	parsed := []ids.ID(nil)
+	parse := restartedVM.ParseF
+	restartedVM.ParseF = func(b []byte) (snowstorm.Tx, error) {
+		tx, err := parse(b)
+		if err == nil {
+			parsed = append(parsed, tx.ID())
+		}
+		return tx, err
+	}
+
+	if err := vm.resume(nil, dispenseTestVM(t, NewDAG(restartedVM))); err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != 2 || parsed[0] != dep.ID() || parsed[1] != tx.ID() {
+		t.Fatalf("restarted plugin verified %v, expected %v", parsed, []ids.ID{dep.ID(), tx.ID()})
+	}
+
+	// The processing txs should be decidable by the restarted plugin
+	processing, err := vm.Get(dep.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := processing.Accept(); err != nil {
+		t.Fatal(err)
+	}
+	if dep.Status() != choices.Accepted {
+		t.Fatalf("restarted plugin's tx has status %s, expected %s", dep.Status(), choices.Accepted)
+	}
+}
+
+func TestVMClientCallsDuringRestart(t *testing.T) {
+	if err := os.Setenv(testPluginEnv, "1"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv(testPluginEnv)
+
+	ctx := snow.DefaultContextTest()
+	vmIntf, err := (&Factory{Path: os.Args[0]}).New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := vmIntf.(*VMClient)
+	if err := vm.Initialize(ctx, memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := vm.Shutdown(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := vm.Bootstrapped(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, child := newTestPluginBlocks()
+	blk, err := vm.ParseBlock(child.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Crash the plugin process
+	exitedProc := vm.proc
+	if err := killProcess(exitedProc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Engine messages sent while the plugin process is down should be
+	// handled by the restarted plugin process rather than failing
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	if err := vm.AppGossip(ids.ShortEmpty, []byte{1}); err != nil {
+		t.Fatalf("AppGossip failed during the outage: %s", err)
+	}
+	if vm.proc == exitedProc {
+		t.Fatal("plugin process should have been restarted")
+	}
+	if err := vm.host.health(); err != nil {
+		t.Fatalf("restarted plugin process should be healthy but got %s", err)
+	}
+
+	// The restarted plugin process should be able to decide the block that
+	// was processing when the plugin process crashed
+	vm.SetPreference(blk.ID())
+	if _, err := vm.GetBlock(blk.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.Accept(); err != nil {
+		t.Fatal(err)
+	}
+	if vm.LastAccepted() != blk.ID() {
+		t.Fatalf("expected %s to be accepted but %s is", blk.ID(), vm.LastAccepted())
+	}
+}
+
+// killProcess kills the plugin process [proc] without closing the host's
+// connection to it, and waits for it to exit
+func killProcess(proc *plugin.Client) error {
+	osProc, err := os.FindProcess(proc.ReattachConfig().Pid)
+	if err != nil {
+		return err
+	}
+	if err := osProc.Kill(); err != nil {
+		return err
+	}
+	for !proc.Exited() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 
+	"github.com/corpetty/avalanchego/snow/engine/avalanche/vertex"
 	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
 )
@@ -21,11 +22,25 @@ var Handshake = plugin.HandshakeConfig{
 	MagicCookieValue: "dynamic",
 }
 
+// DAGHandshake is the handshake that is shared by the host and plugins that
+// serve a DAG based VM. It differs from Handshake only in its protocol version,
+// which is how the host knows which kind of VM a plugin serves.
+var DAGHandshake = plugin.HandshakeConfig{
+	ProtocolVersion:  2,
+	MagicCookieKey:   Handshake.MagicCookieKey,
+	MagicCookieValue: Handshake.MagicCookieValue,
+}
+
 // PluginMap is the map of plugins we can dispense.
 var PluginMap = map[string]plugin.Plugin{
 	"vm": &Plugin{},
 }
 
+// DAGPluginMap is the map of plugins we can dispense to serve a DAG based VM.
+var DAGPluginMap = map[string]plugin.Plugin{
+	"vm": &DAGPlugin{},
+}
+
 // Plugin is the implementation of plugin.Plugin so we can serve/consume this.
 // We also implement GRPCPlugin so that this plugin can be served over gRPC.
 type Plugin struct {
@@ -48,3 +63,25 @@ func (p *Plugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
 func (p *Plugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
 	return NewClient(vmproto.NewVMClient(c), broker), nil
 }
+
+// DAGPlugin is the implementation of plugin.Plugin for DAG based VMs.
+type DAGPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	// Concrete implementation, written in Go. This is only used for plugins
+	// that are written in Go.
+	vm vertex.DAGVM
+}
+
+// NewDAG ...
+func NewDAG(vm vertex.DAGVM) *DAGPlugin { return &DAGPlugin{vm: vm} }
+
+// GRPCServer ...
+func (p *DAGPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	vmproto.RegisterDAGVMServer(s, NewDAGServer(p.vm, broker))
+	return nil
+}
+
+// GRPCClient ...
+func (p *DAGPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return NewDAGClient(vmproto.NewDAGVMClient(c), broker), nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"google.golang.org/grpc"
 
@@ -16,33 +17,24 @@ import (
 	"github.com/corpetty/avalanchego/cache"
 	"github.com/corpetty/avalanchego/cache/metercacher"
 	"github.com/corpetty/avalanchego/database"
-	"github.com/corpetty/avalanchego/database/rpcdb"
-	"github.com/corpetty/avalanchego/database/rpcdb/rpcdbproto"
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow"
 	"github.com/corpetty/avalanchego/snow/choices"
 	"github.com/corpetty/avalanchego/snow/consensus/snowman"
 	"github.com/corpetty/avalanchego/snow/engine/common"
+	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
 	"github.com/corpetty/avalanchego/utils/wrappers"
 	"github.com/corpetty/avalanchego/vms/components/missing"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup/galiaslookupproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp/ghttpproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore/gkeystoreproto"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm/grpcutils"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory/gsharedmemoryproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup/gsubnetlookupproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger/messengerproto"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
 )
 
 var (
-	errUnsupportedFXs = errors.New("unsupported feature extensions")
+	errUnsupportedFXs       = errors.New("unsupported feature extensions")
+	errLastAcceptedMismatch = errors.New("last accepted mismatch")
+	errMissingSummary       = errors.New("response is missing the state summary")
+
+	_ block.StateSyncableVM = &VMClient{}
 )
 
 const (
@@ -55,22 +47,18 @@ type VMClient struct {
 	broker *plugin.GRPCBroker
 	proc   *plugin.Client
 
-	db           *rpcdb.DatabaseServer
-	messenger    *messenger.Server
-	keystore     *gkeystore.Server
-	sharedMemory *gsharedmemory.Server
-	bcLookup     *galiaslookup.Server
-	snLookup     *gsubnetlookup.Server
-
 	serverCloser grpcutils.ServerCloser
 	conns        []*grpc.ClientConn
 
+	host hostState
+
 	ctx  *snow.Context
 	blks map[ids.ID]*BlockClient
 
 	decidedBlocks cache.Cacher
 
 	lastAccepted ids.ID
+	preference   ids.ID
 }
 
 // NewClient returns a database instance connected to a remote database instance
@@ -109,72 +97,28 @@ func (vm *VMClient) Initialize(
 	ctx *snow.Context,
 	db database.Database,
 	genesisBytes []byte,
+	configBytes []byte,
 	toEngine chan<- common.Message,
 	fxs []*common.Fx,
+	appSender common.AppSender,
 ) error {
 	if len(fxs) != 0 {
 		return errUnsupportedFXs
 	}
 
-	epochFirstTransitionBytes, err := ctx.EpochFirstTransition.MarshalBinary()
-	if err != nil {
+	vm.ctx = ctx
+	if err := vm.initializeCaches(ctx.Metrics, ctx.Namespace); err != nil {
 		return err
 	}
 
-	vm.ctx = ctx
-
-	vm.db = rpcdb.NewServer(db)
-	vm.messenger = messenger.NewServer(toEngine)
-	vm.keystore = gkeystore.NewServer(ctx.Keystore, vm.broker)
-	vm.sharedMemory = gsharedmemory.NewServer(ctx.SharedMemory, db)
-	vm.bcLookup = galiaslookup.NewServer(ctx.BCLookup)
-	vm.snLookup = gsubnetlookup.NewServer(ctx.SNLookup)
-
-	if err := vm.initializeCaches(ctx.Metrics, ctx.Namespace); err != nil {
+	req, err := startHostServers(vm.broker, &vm.serverCloser, ctx, db, toEngine, appSender)
+	if err != nil {
 		return err
 	}
+	req.GenesisBytes = genesisBytes
+	req.ConfigBytes = configBytes
 
-	// start the db server
-	dbBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(dbBrokerID, vm.startDBServer)
-
-	// start the messenger server
-	messengerBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(messengerBrokerID, vm.startMessengerServer)
-
-	// start the keystore server
-	keystoreBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(keystoreBrokerID, vm.startKeystoreServer)
-
-	// start the shared memory server
-	sharedMemoryBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(sharedMemoryBrokerID, vm.startSharedMemoryServer)
-
-	// start the blockchain alias server
-	bcLookupBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(bcLookupBrokerID, vm.startBCLookupServer)
-
-	// start the subnet alias server
-	snLookupBrokerID := vm.broker.NextId()
-	go vm.broker.AcceptAndServe(snLookupBrokerID, vm.startSNLookupServer)
-
-	resp, err := vm.client.Initialize(context.Background(), &vmproto.InitializeRequest{
-		NetworkID:            ctx.NetworkID,
-		SubnetID:             ctx.SubnetID[:],
-		ChainID:              ctx.ChainID[:],
-		NodeID:               ctx.NodeID.Bytes(),
-		XChainID:             ctx.XChainID[:],
-		AvaxAssetID:          ctx.AVAXAssetID[:],
-		GenesisBytes:         genesisBytes,
-		DbServer:             dbBrokerID,
-		EngineServer:         messengerBrokerID,
-		KeystoreServer:       keystoreBrokerID,
-		SharedMemoryServer:   sharedMemoryBrokerID,
-		BcLookupServer:       bcLookupBrokerID,
-		SnLookupServer:       snLookupBrokerID,
-		EpochFirstTransition: epochFirstTransitionBytes,
-		EpochDuration:        uint64(ctx.EpochDuration),
-	})
+	resp, err := vm.client.Initialize(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -185,65 +129,42 @@ func (vm *VMClient) Initialize(
 	}
 
 	vm.lastAccepted = lastAccepted
-	return nil
-}
-
-func (vm *VMClient) startDBServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	rpcdbproto.RegisterDatabaseServer(server, vm.db)
-	return server
-}
-
-func (vm *VMClient) startMessengerServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	messengerproto.RegisterMessengerServer(server, vm.messenger)
-	return server
-}
-
-func (vm *VMClient) startKeystoreServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	gkeystoreproto.RegisterKeystoreServer(server, vm.keystore)
-	return server
-}
-
-func (vm *VMClient) startSharedMemoryServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	gsharedmemoryproto.RegisterSharedMemoryServer(server, vm.sharedMemory)
-	return server
-}
-
-func (vm *VMClient) startBCLookupServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	galiaslookupproto.RegisterAliasLookupServer(server, vm.bcLookup)
-	return server
-}
-
-func (vm *VMClient) startSNLookupServer(opts []grpc.ServerOption) *grpc.Server {
-	server := grpc.NewServer(opts...)
-	vm.serverCloser.Add(server)
-	gsubnetlookupproto.RegisterSubnetLookupServer(server, vm.snLookup)
-	return server
+	vm.host.initialize(db, genesisBytes, configBytes, toEngine, appSender)
+	return vm.host.supervise(ctx, vm, vm.proc)
 }
 
 // Bootstrapping ...
 func (vm *VMClient) Bootstrapping() error {
-	_, err := vm.client.Bootstrapping(context.Background(), &vmproto.BootstrappingRequest{})
-	return err
+	req := &vmproto.BootstrappingRequest{}
+	_, err := vm.client.Bootstrapping(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.Bootstrapping(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	vm.host.bootstrapping = true
+	return nil
 }
 
 // Bootstrapped ...
 func (vm *VMClient) Bootstrapped() error {
-	_, err := vm.client.Bootstrapped(context.Background(), &vmproto.BootstrappedRequest{})
-	return err
+	req := &vmproto.BootstrappedRequest{}
+	_, err := vm.client.Bootstrapped(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.Bootstrapped(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	vm.host.bootstrapped = true
+	return nil
 }
 
 // Shutdown ...
 func (vm *VMClient) Shutdown() error {
+	vm.host.stop()
+
 	errs := wrappers.Errs{}
 	_, err := vm.client.Shutdown(context.Background(), &vmproto.ShutdownRequest{})
 	errs.Add(err)
@@ -253,7 +174,9 @@ func (vm *VMClient) Shutdown() error {
 		errs.Add(conn.Close())
 	}
 
-	vm.proc.Kill()
+	if vm.proc != nil {
+		vm.proc.Kill()
+	}
 	return errs.Err
 }
 
@@ -262,23 +185,18 @@ func (vm *VMClient) CreateHandlers() map[string]*common.HTTPHandler {
 	resp, err := vm.client.CreateHandlers(context.Background(), &vmproto.CreateHandlersRequest{})
 	vm.ctx.Log.AssertNoError(err)
 
-	handlers := make(map[string]*common.HTTPHandler, len(resp.Handlers))
-	for _, handler := range resp.Handlers {
-		conn, err := vm.broker.Dial(handler.Server)
-		vm.ctx.Log.AssertNoError(err)
-
-		vm.conns = append(vm.conns, conn)
-		handlers[handler.Prefix] = &common.HTTPHandler{
-			LockOptions: common.LockOption(handler.LockOptions),
-			Handler:     ghttp.NewClient(ghttpproto.NewHTTPClient(conn), vm.broker),
-		}
-	}
-	return handlers
+	handlers, err := dialHandlers(vm.broker, resp.Handlers, &vm.conns)
+	vm.ctx.Log.AssertNoError(err)
+	return vm.host.wrapHandlers(handlers)
 }
 
 // BuildBlock ...
 func (vm *VMClient) BuildBlock() (snowman.Block, error) {
-	resp, err := vm.client.BuildBlock(context.Background(), &vmproto.BuildBlockRequest{})
+	req := &vmproto.BuildBlockRequest{}
+	resp, err := vm.client.BuildBlock(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.BuildBlock(context.Background(), req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -301,9 +219,13 @@ func (vm *VMClient) BuildBlock() (snowman.Block, error) {
 
 // ParseBlock ...
 func (vm *VMClient) ParseBlock(bytes []byte) (snowman.Block, error) {
-	resp, err := vm.client.ParseBlock(context.Background(), &vmproto.ParseBlockRequest{
+	req := &vmproto.ParseBlockRequest{
 		Bytes: bytes,
-	})
+	}
+	resp, err := vm.client.ParseBlock(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.ParseBlock(context.Background(), req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -348,9 +270,13 @@ func (vm *VMClient) GetBlock(id ids.ID) (snowman.Block, error) {
 		return blkIntf.(*BlockClient), nil
 	}
 
-	resp, err := vm.client.GetBlock(context.Background(), &vmproto.GetBlockRequest{
+	req := &vmproto.GetBlockRequest{
 		Id: id[:],
-	})
+	}
+	resp, err := vm.client.GetBlock(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.GetBlock(context.Background(), req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -377,10 +303,15 @@ func (vm *VMClient) GetBlock(id ids.ID) (snowman.Block, error) {
 
 // SetPreference ...
 func (vm *VMClient) SetPreference(id ids.ID) {
-	_, err := vm.client.SetPreference(context.Background(), &vmproto.SetPreferenceRequest{
+	req := &vmproto.SetPreferenceRequest{
 		Id: id[:],
-	})
+	}
+	_, err := vm.client.SetPreference(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.SetPreference(context.Background(), req)
+	}
 	vm.ctx.Log.AssertNoError(err)
+	vm.preference = id
 }
 
 // LastAccepted ...
@@ -388,12 +319,217 @@ func (vm *VMClient) LastAccepted() ids.ID { return vm.lastAccepted }
 
 // Health ...
 func (vm *VMClient) Health() (interface{}, error) {
+	if err := vm.host.health(); err != nil {
+		return nil, err
+	}
 	return vm.client.Health(
 		context.Background(),
 		&vmproto.HealthRequest{},
 	)
 }
 
+// AppRequest ...
+func (vm *VMClient) AppRequest(nodeID ids.ShortID, requestID uint32, request []byte) error {
+	req := &vmproto.AppRequestMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+		Request:   request,
+	}
+	_, err := vm.client.AppRequest(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppRequest(context.Background(), req)
+	}
+	return err
+}
+
+// AppResponse ...
+func (vm *VMClient) AppResponse(nodeID ids.ShortID, requestID uint32, response []byte) error {
+	req := &vmproto.AppResponseMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+		Response:  response,
+	}
+	_, err := vm.client.AppResponse(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppResponse(context.Background(), req)
+	}
+	return err
+}
+
+// AppRequestFailed ...
+func (vm *VMClient) AppRequestFailed(nodeID ids.ShortID, requestID uint32) error {
+	req := &vmproto.AppRequestFailedMsg{
+		NodeID:    nodeID[:],
+		RequestID: requestID,
+	}
+	_, err := vm.client.AppRequestFailed(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppRequestFailed(context.Background(), req)
+	}
+	return err
+}
+
+// AppGossip ...
+func (vm *VMClient) AppGossip(nodeID ids.ShortID, msg []byte) error {
+	req := &vmproto.AppGossipMsg{
+		NodeID: nodeID[:],
+		Msg:    msg,
+	}
+	_, err := vm.client.AppGossip(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.AppGossip(context.Background(), req)
+	}
+	return err
+}
+
+// StateSyncEnabled ...
+func (vm *VMClient) StateSyncEnabled() (bool, error) {
+	req := &vmproto.StateSyncEnabledRequest{}
+	resp, err := vm.client.StateSyncEnabled(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.StateSyncEnabled(context.Background(), req)
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.Enabled, nil
+}
+
+// GetLastStateSummary ...
+func (vm *VMClient) GetLastStateSummary() (block.Summary, error) {
+	req := &vmproto.GetLastStateSummaryRequest{}
+	resp, err := vm.client.GetLastStateSummary(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.GetLastStateSummary(context.Background(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return summaryFromProto(resp.Summary)
+}
+
+// ParseStateSummary ...
+func (vm *VMClient) ParseStateSummary(summaryBytes []byte) (block.Summary, error) {
+	req := &vmproto.ParseStateSummaryRequest{
+		Bytes: summaryBytes,
+	}
+	resp, err := vm.client.ParseStateSummary(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.ParseStateSummary(context.Background(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return summaryFromProto(resp.Summary)
+}
+
+// GetStateSummary ...
+func (vm *VMClient) GetStateSummary(height uint64) (block.Summary, error) {
+	req := &vmproto.GetStateSummaryRequest{
+		Height: height,
+	}
+	resp, err := vm.client.GetStateSummary(context.Background(), req)
+	if vm.host.retry(err) {
+		resp, err = vm.client.GetStateSummary(context.Background(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return summaryFromProto(resp.Summary)
+}
+
+// StateSync ...
+func (vm *VMClient) StateSync(summaries []block.Summary) error {
+	summaryBytes := make([][]byte, len(summaries))
+	for i, summary := range summaries {
+		summaryBytes[i] = summary.Bytes()
+	}
+	req := &vmproto.StateSyncRequest{
+		Summaries: summaryBytes,
+	}
+	_, err := vm.client.StateSync(context.Background(), req)
+	if vm.host.retry(err) {
+		_, err = vm.client.StateSync(context.Background(), req)
+	}
+	return err
+}
+
+// resume implements the resumable interface
+func (vm *VMClient) resume(proc *plugin.Client, raw interface{}) error {
+	newVM, ok := raw.(*VMClient)
+	if !ok {
+		return errWrongVM
+	}
+
+	// Release the resources of the exited process. Closing errors are ignored
+	// because the process is already gone.
+	vm.serverCloser.Stop()
+	for _, conn := range vm.conns {
+		_ = conn.Close()
+	}
+	if vm.proc != nil {
+		vm.proc.Kill()
+	}
+
+	vm.client = newVM.client
+	vm.broker = newVM.broker
+	vm.proc = proc
+	vm.serverCloser = grpcutils.ServerCloser{}
+	vm.conns = nil
+
+	req, err := startHostServers(vm.broker, &vm.serverCloser, vm.ctx, vm.host.db, vm.host.toEngine, vm.host.appSender)
+	if err != nil {
+		return err
+	}
+	req.GenesisBytes = vm.host.genesisBytes
+	req.ConfigBytes = vm.host.configBytes
+
+	resp, err := vm.client.Initialize(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	lastAccepted, err := ids.ToID(resp.LastAcceptedID)
+	if err != nil {
+		return err
+	}
+	if lastAccepted != vm.lastAccepted {
+		return fmt.Errorf("%w: restarted plugin has %s accepted rather than %s",
+			errLastAcceptedMismatch, lastAccepted, vm.lastAccepted)
+	}
+
+	if err := vm.host.resume(vm.ctx, vm.client, vm.broker, &vm.conns); err != nil {
+		return err
+	}
+
+	// Verify the blocks that were processing, parents first, so consensus on
+	// them can continue from the last accepted block
+	blks := make([]*BlockClient, 0, len(vm.blks))
+	for _, blk := range vm.blks {
+		blks = append(blks, blk)
+	}
+	sort.Slice(blks, func(i, j int) bool { return blks[i].height < blks[j].height })
+	for _, blk := range blks {
+		if _, err := vm.client.ParseBlock(context.Background(), &vmproto.ParseBlockRequest{
+			Bytes: blk.bytes,
+		}); err != nil {
+			return err
+		}
+		if _, err := vm.client.BlockVerify(context.Background(), &vmproto.BlockVerifyRequest{
+			Id: blk.id[:],
+		}); err != nil {
+			return fmt.Errorf("couldn't verify processing block %s: %w", blk.id, err)
+		}
+	}
+
+	if vm.preference == ids.Empty {
+		return nil
+	}
+	_, err = vm.client.SetPreference(context.Background(), &vmproto.SetPreferenceRequest{
+		Id: vm.preference[:],
+	})
+	return err
+}
+
 // BlockClient is an implementation of Block that talks over RPC.
 type BlockClient struct {
 	vm *VMClient
@@ -410,11 +546,17 @@ func (b *BlockClient) ID() ids.ID { return b.id }
 
 // Accept ...
 func (b *BlockClient) Accept() error {
+	// The block is still processing until the plugin accepts it, so that it
+	// is verified again if the plugin process is restarted
+	req := &vmproto.BlockAcceptRequest{
+		Id: b.id[:],
+	}
+	_, err := b.vm.client.BlockAccept(context.Background(), req)
+	if b.vm.host.retry(err) {
+		_, err = b.vm.client.BlockAccept(context.Background(), req)
+	}
 	delete(b.vm.blks, b.id)
 	b.status = choices.Accepted
-	_, err := b.vm.client.BlockAccept(context.Background(), &vmproto.BlockAcceptRequest{
-		Id: b.id[:],
-	})
 	if err != nil {
 		return err
 	}
@@ -426,11 +568,15 @@ func (b *BlockClient) Accept() error {
 
 // Reject ...
 func (b *BlockClient) Reject() error {
+	req := &vmproto.BlockRejectRequest{
+		Id: b.id[:],
+	}
+	_, err := b.vm.client.BlockReject(context.Background(), req)
+	if b.vm.host.retry(err) {
+		_, err = b.vm.client.BlockReject(context.Background(), req)
+	}
 	delete(b.vm.blks, b.id)
 	b.status = choices.Rejected
-	_, err := b.vm.client.BlockReject(context.Background(), &vmproto.BlockRejectRequest{
-		Id: b.id[:],
-	})
 
 	b.vm.decidedBlocks.Put(b.id, b)
 	return err
@@ -449,9 +595,13 @@ func (b *BlockClient) Parent() snowman.Block {
 
 // Verify ...
 func (b *BlockClient) Verify() error {
-	_, err := b.vm.client.BlockVerify(context.Background(), &vmproto.BlockVerifyRequest{
+	req := &vmproto.BlockVerifyRequest{
 		Id: b.id[:],
-	})
+	}
+	_, err := b.vm.client.BlockVerify(context.Background(), req)
+	if b.vm.host.retry(err) {
+		_, err = b.vm.client.BlockVerify(context.Background(), req)
+	}
 	if err != nil {
 		return err
 	}
@@ -465,3 +615,34 @@ func (b *BlockClient) Bytes() []byte { return b.bytes }
 
 // Height ...
 func (b *BlockClient) Height() uint64 { return b.height }
+
+// SummaryClient is a state summary that was returned over RPC
+type SummaryClient struct {
+	id     ids.ID
+	height uint64
+	bytes  []byte
+}
+
+func summaryFromProto(summary *vmproto.StateSummary) (block.Summary, error) {
+	if summary == nil {
+		return nil, errMissingSummary
+	}
+	summaryID, err := ids.ToID(summary.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &SummaryClient{
+		id:     summaryID,
+		height: summary.Height,
+		bytes:  summary.Bytes,
+	}, nil
+}
+
+// ID ...
+func (s *SummaryClient) ID() ids.ID { return s.id }
+
+// Height ...
+func (s *SummaryClient) Height() uint64 { return s.height }
+
+// Bytes ...
+func (s *SummaryClient) Bytes() []byte { return s.bytes }
@@ -5,47 +5,29 @@ package rpcchainvm
 
 import (
 	"context"
-	"encoding/json"
-	"time"
-
-	"google.golang.org/grpc"
+	"errors"
 
 	"github.com/hashicorp/go-plugin"
 
-	"github.com/corpetty/avalanchego/database/rpcdb"
-	"github.com/corpetty/avalanchego/database/rpcdb/rpcdbproto"
 	"github.com/corpetty/avalanchego/ids"
 	"github.com/corpetty/avalanchego/snow"
-	"github.com/corpetty/avalanchego/snow/engine/common"
 	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
-	"github.com/corpetty/avalanchego/utils/logging"
 	"github.com/corpetty/avalanchego/utils/wrappers"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/galiaslookup/galiaslookupproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/ghttp/ghttpproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gkeystore/gkeystoreproto"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm/grpcutils"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsharedmemory/gsharedmemoryproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/gsubnetlookup/gsubnetlookupproto"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger"
-	"github.com/corpetty/avalanchego/vms/rpcchainvm/messenger/messengerproto"
 	"github.com/corpetty/avalanchego/vms/rpcchainvm/vmproto"
 )
 
+var errStateSyncNotSupported = errors.New("vm doesn't support state sync")
+
 // VMServer is a VM that is managed over RPC.
 type VMServer struct {
 	vm     block.ChainVM
 	broker *plugin.GRPCBroker
 
 	serverCloser grpcutils.ServerCloser
-	conns        []*grpc.ClientConn
+	clients      *hostClients
 
-	ctx      *snow.Context
-	toEngine chan common.Message
+	ctx *snow.Context
 }
 
 // NewServer returns a vm instance connected to a remote vm instance
@@ -58,125 +40,19 @@ func NewServer(vm block.ChainVM, broker *plugin.GRPCBroker) *VMServer {
 
 // Initialize ...
 func (vm *VMServer) Initialize(_ context.Context, req *vmproto.InitializeRequest) (*vmproto.InitializeResponse, error) {
-	subnetID, err := ids.ToID(req.SubnetID)
-	if err != nil {
-		return nil, err
-	}
-	chainID, err := ids.ToID(req.ChainID)
-	if err != nil {
-		return nil, err
-	}
-	nodeID, err := ids.ToShortID(req.NodeID)
+	clients, err := dialHostServers(vm.broker, req)
 	if err != nil {
 		return nil, err
 	}
-	xChainID, err := ids.ToID(req.XChainID)
-	if err != nil {
-		return nil, err
-	}
-	avaxAssetID, err := ids.ToID(req.AvaxAssetID)
-	if err != nil {
-		return nil, err
-	}
-
-	epochFirstTransition := time.Time{}
-	if err := epochFirstTransition.UnmarshalBinary(req.EpochFirstTransition); err != nil {
-		return nil, err
-	}
 
-	dbConn, err := vm.broker.Dial(req.DbServer)
-	if err != nil {
-		return nil, err
-	}
-	msgConn, err := vm.broker.Dial(req.EngineServer)
-	if err != nil {
-		// Ignore DB closing error to return the original error
-		_ = dbConn.Close()
-		return nil, err
-	}
-	keystoreConn, err := vm.broker.Dial(req.KeystoreServer)
-	if err != nil {
-		// Ignore closing error to return the original error
-		_ = dbConn.Close()
-		_ = msgConn.Close()
-		return nil, err
-	}
-	sharedMemoryConn, err := vm.broker.Dial(req.SharedMemoryServer)
-	if err != nil {
-		// Ignore closing error to return the original error
-		_ = dbConn.Close()
-		_ = msgConn.Close()
-		_ = keystoreConn.Close()
-		return nil, err
-	}
-	bcLookupConn, err := vm.broker.Dial(req.BcLookupServer)
-	if err != nil {
-		// Ignore closing error to return the original error
-		_ = dbConn.Close()
-		_ = msgConn.Close()
-		_ = keystoreConn.Close()
-		_ = sharedMemoryConn.Close()
-		return nil, err
-	}
-	snLookupConn, err := vm.broker.Dial(req.SnLookupServer)
-	if err != nil {
-		// Ignore closing error to return the original error
-		_ = dbConn.Close()
-		_ = msgConn.Close()
-		_ = keystoreConn.Close()
-		_ = sharedMemoryConn.Close()
-		_ = bcLookupConn.Close()
-		return nil, err
-	}
-
-	dbClient := rpcdb.NewClient(rpcdbproto.NewDatabaseClient(dbConn))
-	msgClient := messenger.NewClient(messengerproto.NewMessengerClient(msgConn))
-	keystoreClient := gkeystore.NewClient(gkeystoreproto.NewKeystoreClient(keystoreConn), vm.broker)
-	sharedMemoryClient := gsharedmemory.NewClient(gsharedmemoryproto.NewSharedMemoryClient(sharedMemoryConn))
-	bcLookupClient := galiaslookup.NewClient(galiaslookupproto.NewAliasLookupClient(bcLookupConn))
-	snLookupClient := gsubnetlookup.NewClient(gsubnetlookupproto.NewSubnetLookupClient(snLookupConn))
-
-	toEngine := make(chan common.Message, 1)
-	go func() {
-		for msg := range toEngine {
-			// Nothing to do with the error within the goroutine
-			_ = msgClient.Notify(msg)
-		}
-	}()
-
-	vm.ctx = &snow.Context{
-		NetworkID:            req.NetworkID,
-		SubnetID:             subnetID,
-		ChainID:              chainID,
-		NodeID:               nodeID,
-		XChainID:             xChainID,
-		AVAXAssetID:          avaxAssetID,
-		Log:                  logging.NoLog{},
-		DecisionDispatcher:   nil,
-		ConsensusDispatcher:  nil,
-		Keystore:             keystoreClient,
-		SharedMemory:         sharedMemoryClient,
-		BCLookup:             bcLookupClient,
-		SNLookup:             snLookupClient,
-		EpochFirstTransition: epochFirstTransition,
-		EpochDuration:        time.Duration(req.EpochDuration),
-	}
-
-	if err := vm.vm.Initialize(vm.ctx, dbClient, req.GenesisBytes, toEngine, nil); err != nil {
+	vm.ctx = clients.ctx
+	if err := vm.vm.Initialize(vm.ctx, clients.db, req.GenesisBytes, req.ConfigBytes, clients.toEngine, nil, clients.appSender); err != nil {
 		// Ignore errors closing resources to return the original error
-		_ = dbConn.Close()
-		_ = msgConn.Close()
-		_ = keystoreConn.Close()
-		_ = sharedMemoryConn.Close()
-		_ = bcLookupConn.Close()
-		_ = snLookupConn.Close()
-		close(toEngine)
+		_ = clients.close()
 		return nil, err
 	}
 
-	vm.conns = append(vm.conns, dbConn)
-	vm.conns = append(vm.conns, msgConn)
-	vm.toEngine = toEngine
+	vm.clients = clients
 	lastAccepted := vm.vm.LastAccepted()
 	return &vmproto.InitializeResponse{
 		LastAcceptedID: lastAccepted[:],
@@ -196,18 +72,16 @@ func (vm *VMServer) Bootstrapped(context.Context, *vmproto.BootstrappedRequest)
 
 // Shutdown ...
 func (vm *VMServer) Shutdown(context.Context, *vmproto.ShutdownRequest) (*vmproto.ShutdownResponse, error) {
-	if vm.toEngine == nil {
+	if vm.clients == nil {
 		return &vmproto.ShutdownResponse{}, nil
 	}
 
 	errs := wrappers.Errs{}
 	errs.Add(vm.vm.Shutdown())
-	close(vm.toEngine)
 
 	vm.serverCloser.Stop()
-	for _, conn := range vm.conns {
-		errs.Add(conn.Close())
-	}
+	errs.Add(vm.clients.close())
+	vm.clients = nil
 
 	return &vmproto.ShutdownResponse{}, errs.Err
 }
@@ -215,26 +89,9 @@ func (vm *VMServer) Shutdown(context.Context, *vmproto.ShutdownRequest) (*vmprot
 // CreateHandlers ...
 func (vm *VMServer) CreateHandlers(_ context.Context, req *vmproto.CreateHandlersRequest) (*vmproto.CreateHandlersResponse, error) {
 	handlers := vm.vm.CreateHandlers()
-	resp := &vmproto.CreateHandlersResponse{}
-	for prefix, h := range handlers {
-		handler := h
-
-		// start the messenger server
-		serverID := vm.broker.NextId()
-		go vm.broker.AcceptAndServe(serverID, func(opts []grpc.ServerOption) *grpc.Server {
-			server := grpc.NewServer(opts...)
-			vm.serverCloser.Add(server)
-			ghttpproto.RegisterHTTPServer(server, ghttp.NewServer(handler.Handler, vm.broker))
-			return server
-		})
-
-		resp.Handlers = append(resp.Handlers, &vmproto.Handler{
-			Prefix:      prefix,
-			LockOptions: uint32(handler.LockOptions),
-			Server:      serverID,
-		})
-	}
-	return resp, nil
+	return &vmproto.CreateHandlersResponse{
+		Handlers: serveHandlers(vm.broker, &vm.serverCloser, handlers),
+	}, nil
 }
 
 // BuildBlock ...
@@ -305,27 +162,47 @@ func (vm *VMServer) Health(_ context.Context, req *vmproto.HealthRequest) (*vmpr
 		return &vmproto.HealthResponse{}, err
 	}
 
-	// Try to stringify the details
-	detailsStr := "couldn't parse health check details to string"
-	switch details := details.(type) {
-	case nil:
-		detailsStr = ""
-	case string:
-		detailsStr = details
-	case map[string]string:
-		asJSON, err := json.Marshal(details)
-		if err != nil {
-			detailsStr = string(asJSON)
-		}
-	case []byte:
-		detailsStr = string(details)
-	}
-
 	return &vmproto.HealthResponse{
-		Details: detailsStr,
+		Details: healthDetails(details),
 	}, nil
 }
 
+// AppRequest ...
+func (vm *VMServer) AppRequest(_ context.Context, req *vmproto.AppRequestMsg) (*vmproto.AppRequestResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppRequestResponse{}, vm.vm.AppRequest(nodeID, req.RequestID, req.Request)
+}
+
+// AppRequestFailed ...
+func (vm *VMServer) AppRequestFailed(_ context.Context, req *vmproto.AppRequestFailedMsg) (*vmproto.AppRequestFailedResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppRequestFailedResponse{}, vm.vm.AppRequestFailed(nodeID, req.RequestID)
+}
+
+// AppResponse ...
+func (vm *VMServer) AppResponse(_ context.Context, req *vmproto.AppResponseMsg) (*vmproto.AppResponseResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppResponseResponse{}, vm.vm.AppResponse(nodeID, req.RequestID, req.Response)
+}
+
+// AppGossip ...
+func (vm *VMServer) AppGossip(_ context.Context, req *vmproto.AppGossipMsg) (*vmproto.AppGossipResponse, error) {
+	nodeID, err := ids.ToShortID(req.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.AppGossipResponse{}, vm.vm.AppGossip(nodeID, req.Msg)
+}
+
 // BlockVerify ...
 func (vm *VMServer) BlockVerify(_ context.Context, req *vmproto.BlockVerifyRequest) (*vmproto.BlockVerifyResponse, error) {
 	id, err := ids.ToID(req.Id)
@@ -370,3 +247,92 @@ func (vm *VMServer) BlockReject(_ context.Context, req *vmproto.BlockRejectReque
 	}
 	return &vmproto.BlockRejectResponse{}, nil
 }
+
+// StateSyncEnabled ...
+func (vm *VMServer) StateSyncEnabled(context.Context, *vmproto.StateSyncEnabledRequest) (*vmproto.StateSyncEnabledResponse, error) {
+	ssVM, ok := vm.vm.(block.StateSyncableVM)
+	if !ok {
+		// A VM that can't state sync is reported as not wanting to
+		return &vmproto.StateSyncEnabledResponse{}, nil
+	}
+	enabled, err := ssVM.StateSyncEnabled()
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.StateSyncEnabledResponse{
+		Enabled: enabled,
+	}, nil
+}
+
+// GetLastStateSummary ...
+func (vm *VMServer) GetLastStateSummary(context.Context, *vmproto.GetLastStateSummaryRequest) (*vmproto.GetLastStateSummaryResponse, error) {
+	ssVM, ok := vm.vm.(block.StateSyncableVM)
+	if !ok {
+		return nil, errStateSyncNotSupported
+	}
+	summary, err := ssVM.GetLastStateSummary()
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.GetLastStateSummaryResponse{
+		Summary: summaryToProto(summary),
+	}, nil
+}
+
+// ParseStateSummary ...
+func (vm *VMServer) ParseStateSummary(_ context.Context, req *vmproto.ParseStateSummaryRequest) (*vmproto.ParseStateSummaryResponse, error) {
+	ssVM, ok := vm.vm.(block.StateSyncableVM)
+	if !ok {
+		return nil, errStateSyncNotSupported
+	}
+	summary, err := ssVM.ParseStateSummary(req.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.ParseStateSummaryResponse{
+		Summary: summaryToProto(summary),
+	}, nil
+}
+
+// GetStateSummary ...
+func (vm *VMServer) GetStateSummary(_ context.Context, req *vmproto.GetStateSummaryRequest) (*vmproto.GetStateSummaryResponse, error) {
+	ssVM, ok := vm.vm.(block.StateSyncableVM)
+	if !ok {
+		return nil, errStateSyncNotSupported
+	}
+	summary, err := ssVM.GetStateSummary(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &vmproto.GetStateSummaryResponse{
+		Summary: summaryToProto(summary),
+	}, nil
+}
+
+// StateSync ...
+func (vm *VMServer) StateSync(_ context.Context, req *vmproto.StateSyncRequest) (*vmproto.StateSyncResponse, error) {
+	ssVM, ok := vm.vm.(block.StateSyncableVM)
+	if !ok {
+		return nil, errStateSyncNotSupported
+	}
+	// The summaries are sent as bytes, so they are parsed back into the VM's
+	// own summary type before being handed to it
+	summaries := make([]block.Summary, len(req.Summaries))
+	for i, summaryBytes := range req.Summaries {
+		summary, err := ssVM.ParseStateSummary(summaryBytes)
+		if err != nil {
+			return nil, err
+		}
+		summaries[i] = summary
+	}
+	return &vmproto.StateSyncResponse{}, ssVM.StateSync(summaries)
+}
+
+func summaryToProto(summary block.Summary) *vmproto.StateSummary {
+	summaryID := summary.ID()
+	return &vmproto.StateSummary{
+		Id:     summaryID[:],
+		Height: summary.Height(),
+		Bytes:  summary.Bytes(),
+	}
+}
@@ -0,0 +1,160 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/corpetty/avalanchego/database/memdb"
+	"github.com/corpetty/avalanchego/ids"
+	"github.com/corpetty/avalanchego/snow"
+	"github.com/corpetty/avalanchego/snow/choices"
+	"github.com/corpetty/avalanchego/snow/consensus/snowman"
+	"github.com/corpetty/avalanchego/snow/engine/snowman/block"
+)
+
+func TestVMStateSync(t *testing.T) {
+	genesis := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+	summaries := []*block.TestSummary{
+		{
+			IDV:     ids.ID{2},
+			HeightV: 100,
+			BytesV:  []byte{2},
+		},
+		{
+			IDV:     ids.ID{3},
+			HeightV: 200,
+			BytesV:  []byte{3},
+		},
+	}
+	errUnknownSummary := errors.New("unknown summary")
+
+	chainVM := &block.TestStateSyncableVM{TestVM: *newTestChainVM(t, genesis)}
+	chainVM.Default(true)
+	chainVM.StateSyncEnabledF = func() (bool, error) { return true, nil }
+	chainVM.GetLastStateSummaryF = func() (block.Summary, error) { return summaries[1], nil }
+	chainVM.ParseStateSummaryF = func(b []byte) (block.Summary, error) {
+		for _, summary := range summaries {
+			if bytes.Equal(b, summary.Bytes()) {
+				return summary, nil
+			}
+		}
+		return nil, errUnknownSummary
+	}
+	chainVM.GetStateSummaryF = func(height uint64) (block.Summary, error) {
+		for _, summary := range summaries {
+			if height == summary.Height() {
+				return summary, nil
+			}
+		}
+		return nil, errUnknownSummary
+	}
+	synced := []block.Summary(nil)
+	chainVM.StateSyncF = func(s []block.Summary) error {
+		synced = s
+		return nil
+	}
+
+	vm := dispenseTestVM(t, New(chainVM)).(*VMClient)
+	if err := vm.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	enabled, err := vm.StateSyncEnabled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enabled {
+		t.Fatalf("state sync should have been enabled")
+	}
+
+	checkSummary := func(summary block.Summary, expected *block.TestSummary) {
+		t.Helper()
+		switch {
+		case summary.ID() != expected.ID():
+			t.Fatalf("summary has ID %s, expected %s", summary.ID(), expected.ID())
+		case summary.Height() != expected.Height():
+			t.Fatalf("summary has height %d, expected %d", summary.Height(), expected.Height())
+		case !bytes.Equal(summary.Bytes(), expected.Bytes()):
+			t.Fatalf("summary has bytes %v, expected %v", summary.Bytes(), expected.Bytes())
+		}
+	}
+
+	lastSummary, err := vm.GetLastStateSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSummary(lastSummary, summaries[1])
+
+	parsedSummary, err := vm.ParseStateSummary(summaries[0].Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSummary(parsedSummary, summaries[0])
+
+	if _, err := vm.ParseStateSummary([]byte{4}); err == nil {
+		t.Fatalf("parsing an unknown summary should have errored")
+	}
+
+	heightSummary, err := vm.GetStateSummary(summaries[0].Height())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSummary(heightSummary, summaries[0])
+
+	if _, err := vm.GetStateSummary(300); err == nil {
+		t.Fatalf("getting a summary at an unknown height should have errored")
+	}
+
+	if err := vm.StateSync([]block.Summary{lastSummary, parsedSummary}); err != nil {
+		t.Fatal(err)
+	}
+	if len(synced) != 2 {
+		t.Fatalf("synced to %d summaries, expected 2", len(synced))
+	}
+	checkSummary(synced[0], summaries[1])
+	checkSummary(synced[1], summaries[0])
+
+	if err := vm.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVMStateSyncNotSupported(t *testing.T) {
+	genesis := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.ID{1},
+			StatusV: choices.Accepted,
+		},
+		BytesV: []byte{1},
+	}
+
+	vm := dispenseTestVM(t, New(newTestChainVM(t, genesis))).(*VMClient)
+	if err := vm.Initialize(snow.DefaultContextTest(), memdb.New(), nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	enabled, err := vm.StateSyncEnabled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enabled {
+		t.Fatalf("state sync shouldn't be enabled for a VM that doesn't support it")
+	}
+	if _, err := vm.GetLastStateSummary(); err == nil {
+		t.Fatalf("getting a summary from a VM that doesn't support state sync should have errored")
+	}
+
+	if err := vm.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -28,7 +28,8 @@ var (
 	errNoPendingBlocks = errors.New("there is no block to propose")
 	errBadGenesisBytes = errors.New("genesis data should be bytes (max length 32)")
 
-	_ block.ChainVM = &VM{}
+	_ block.ChainVM              = &VM{}
+	_ block.HeightIndexedChainVM = &VM{}
 )
 
 // VM implements the snowman.VM interface
@@ -51,8 +52,10 @@ func (vm *VM) Initialize(
 	ctx *snow.Context,
 	db database.Database,
 	genesisData []byte,
+	_ []byte,
 	toEngine chan<- common.Message,
 	_ []*common.Fx,
+	_ common.AppSender,
 ) error {
 	if err := vm.SnowmanVM.Initialize(ctx, db, vm.ParseBlock, toEngine); err != nil {
 		ctx.Log.Error("error initializing SnowmanVM: %v", err)
@@ -105,7 +108,10 @@ func (vm *VM) Initialize(
 			return err
 		}
 	}
-	return nil
+
+	// Index the accepted blocks by height if this database was created before
+	// the index existed
+	return vm.InitializeHeightIndex()
 }
 
 // CreateHandlers returns a map where:
@@ -44,7 +44,7 @@ func TestGenesis(t *testing.T) {
 	ctx := snow.DefaultContextTest()
 	ctx.ChainID = blockchainID
 
-	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -83,7 +83,7 @@ func TestHappyPath(t *testing.T) {
 	vm := &VM{}
 	ctx := snow.DefaultContextTest()
 	ctx.ChainID = blockchainID
-	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 
@@ -198,7 +198,7 @@ func TestService(t *testing.T) {
 	vm := &VM{}
 	ctx := snow.DefaultContextTest()
 	ctx.ChainID = blockchainID
-	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, msgChan, nil); err != nil {
+	if err := vm.Initialize(ctx, db, []byte{0, 0, 0, 0, 0}, nil, msgChan, nil, nil); err != nil {
 		t.Fatal(err)
 	}
 